@@ -6,6 +6,7 @@ import (
 
 	"github.com/gin-gonic/gin"
 
+	"pets-server/internal/domain/shared"
 	"pets-server/internal/pkg/config"
 )
 
@@ -24,5 +25,12 @@ func ProvideConfig() (*config.Config, error) {
 	// 设置 Gin 模式
 	gin.SetMode(string(cfg.Server.Mode))
 
+	// 设置游戏时区
+	loc, err := cfg.Game.Location()
+	if err != nil {
+		return nil, err
+	}
+	shared.SetGameLocation(loc)
+
 	return cfg, nil
 }
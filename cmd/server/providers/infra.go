@@ -127,6 +127,11 @@ func ProvideRankingStore(client *goredis.Client) *redis.RankingStore {
 	return redis.NewRankingStore(client)
 }
 
+// ProvideStatusBuffer 提供宠物状态缓冲区
+func ProvideStatusBuffer(client *goredis.Client) *redis.StatusBuffer {
+	return redis.NewStatusBuffer(client)
+}
+
 // ProvideAuthSessionStore 提供认证会话存储
 func ProvideAuthSessionStore(client *goredis.Client) authApp.SessionStore {
 	return redis.NewAuthSessionStore(client)
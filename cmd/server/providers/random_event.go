@@ -0,0 +1,99 @@
+package providers
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"pets-server/internal/domain/pet"
+	"pets-server/internal/pkg/config"
+)
+
+// ProvideRandomEventTable 加载随机事件配置并构建随机事件表
+// 启动时校验道具奖励引用的道具都已定义，避免触发事件时才发现发不出道具
+func ProvideRandomEventTable(repos *RepoSet) (*pet.RandomEventTable, error) {
+	path := "configs/random_events.yaml"
+	if envPath := os.Getenv("RANDOM_EVENTS_CONFIG_PATH"); envPath != "" {
+		path = envPath
+	}
+
+	cfg, err := config.LoadRandomEvents(path)
+	if err != nil {
+		return nil, err
+	}
+	table, err := buildRandomEventTable(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	defs, err := repos.Item.GetAllDefinitions(context.Background())
+	if err != nil {
+		return nil, err
+	}
+	defined := make(map[int]bool, len(defs))
+	for _, def := range defs {
+		defined[def.ID] = true
+	}
+	for _, id := range table.ItemIDs() {
+		if !defined[id] {
+			return nil, fmt.Errorf("random event reward item %d is not defined", id)
+		}
+	}
+
+	return table, nil
+}
+
+// buildRandomEventTable 从配置构建随机事件表
+func buildRandomEventTable(cfg *config.RandomEventConfig) (*pet.RandomEventTable, error) {
+	events := make([]pet.RandomEventDef, 0, len(cfg.Events))
+	seen := make(map[string]bool, len(cfg.Events))
+	for _, entry := range cfg.Events {
+		if entry.ID == "" {
+			return nil, fmt.Errorf("random event without id")
+		}
+		if seen[entry.ID] {
+			return nil, fmt.Errorf("duplicate random event: %s", entry.ID)
+		}
+		seen[entry.ID] = true
+
+		def, err := buildRandomEvent(entry)
+		if err != nil {
+			return nil, fmt.Errorf("build random event %s: %w", entry.ID, err)
+		}
+		events = append(events, def)
+	}
+
+	return pet.NewRandomEventTable(cfg.BaseChance, events), nil
+}
+
+// buildRandomEvent 从配置条目构建随机事件定义
+func buildRandomEvent(entry config.RandomEventEntry) (pet.RandomEventDef, error) {
+	def := pet.RandomEventDef{
+		ID:        entry.ID,
+		Name:      entry.Name,
+		Message:   entry.Message,
+		Weight:    entry.Weight,
+		Cooldown:  time.Duration(entry.CooldownMinutes) * time.Minute,
+		Effect:    pet.RandomEventEffect(entry.Effect),
+		ItemID:    entry.ItemID,
+		Status:    pet.StatusType(entry.Status),
+		MinAmount: entry.MinAmount,
+		MaxAmount: entry.MaxAmount,
+	}
+	for _, name := range entry.Stages {
+		stage, ok := pet.ParseStage(name)
+		if !ok {
+			return def, fmt.Errorf("unknown stage: %s", name)
+		}
+		def.Stages = append(def.Stages, stage)
+	}
+	for _, id := range entry.Species {
+		def.Species = append(def.Species, pet.SpeciesID(id))
+	}
+
+	if err := def.Validate(); err != nil {
+		return def, err
+	}
+	return def, nil
+}
@@ -3,29 +3,44 @@ package providers
 import (
 	"gorm.io/gorm"
 
+	"pets-server/internal/domain/pet"
 	"pets-server/internal/infrastructure/persistence/postgres/repo"
 )
 
 // RepoSet 仓储集合
 type RepoSet struct {
-	User   *repo.UserRepository
-	Pet    *repo.PetRepository
-	Item   *repo.ItemRepository
-	Friend *repo.FriendRepository
-	Gift   *repo.GiftRepository
-	Trade  *repo.TradeRepository
-	Visit  *repo.VisitRepository
+	User             *repo.UserRepository
+	Pet              *repo.PetRepository
+	BreedingContract *repo.BreedingContractRepository
+	Adoption         *repo.AdoptionRepository
+	Ownership        *repo.OwnershipRepository
+	Memorial         *repo.MemorialRepository
+	RandomEvent      *repo.RandomEventRepository
+	Item             *repo.ItemRepository
+	Decoration       *repo.DecorationRepository
+	Friend           *repo.FriendRepository
+	Gift             *repo.GiftRepository
+	Trade            *repo.TradeRepository
+	Visit            *repo.VisitRepository
+	Interaction      *repo.InteractionRepository
 }
 
 // ProvideRepoSet 提供所有仓储
-func ProvideRepoSet(db *gorm.DB) *RepoSet {
+func ProvideRepoSet(db *gorm.DB, speciesRegistry *pet.SpeciesRegistry) *RepoSet {
 	return &RepoSet{
-		User:   repo.NewUserRepository(db),
-		Pet:    repo.NewPetRepository(db),
-		Item:   repo.NewItemRepository(db),
-		Friend: repo.NewFriendRepository(db),
-		Gift:   repo.NewGiftRepository(db),
-		Trade:  repo.NewTradeRepository(db),
-		Visit:  repo.NewVisitRepository(db),
+		User:             repo.NewUserRepository(db),
+		Pet:              repo.NewPetRepository(db, speciesRegistry),
+		BreedingContract: repo.NewBreedingContractRepository(db),
+		Adoption:         repo.NewAdoptionRepository(db),
+		Ownership:        repo.NewOwnershipRepository(db),
+		Memorial:         repo.NewMemorialRepository(db),
+		RandomEvent:      repo.NewRandomEventRepository(db),
+		Item:             repo.NewItemRepository(db),
+		Decoration:       repo.NewDecorationRepository(db),
+		Friend:           repo.NewFriendRepository(db),
+		Gift:             repo.NewGiftRepository(db),
+		Trade:            repo.NewTradeRepository(db),
+		Visit:            repo.NewVisitRepository(db),
+		Interaction:      repo.NewInteractionRepository(db),
 	}
 }
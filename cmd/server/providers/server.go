@@ -5,7 +5,9 @@ import (
 
 	authApp "pets-server/internal/application/auth"
 	"pets-server/internal/infrastructure/cron"
+	"pets-server/internal/domain/shared"
 	"pets-server/internal/infrastructure/persistence/postgres"
+	"pets-server/internal/infrastructure/persistence/redis"
 	httpInterface "pets-server/internal/interfaces/http"
 	"pets-server/internal/interfaces/http/handler"
 	ws "pets-server/internal/interfaces/websocket"
@@ -17,7 +19,7 @@ func ProvideRouter(cfg *config.Config, services *ServiceSet, wsHandler *ws.Handl
 	// 创建 HTTP Handler
 	authHandler := handler.NewAuthHandler(services.Auth)
 	petHandler := handler.NewPetHandler(services.Pet)
-	itemHandler := handler.NewItemHandler()
+	itemHandler := handler.NewItemHandler(services.Item)
 	socialHandler := handler.NewSocialHandler(services.Social)
 	rankingHandler := handler.NewRankingHandler(services.Ranking)
 
@@ -50,7 +52,29 @@ func ProvideWSHandler(hub *ws.Hub) *ws.Handler {
 }
 
 // ProvideScheduler 提供定时任务调度器
-func ProvideScheduler(repos *RepoSet, uow *postgres.UnitOfWork) *cron.Scheduler {
-	return cron.NewScheduler(repos.Pet, uow, nil)
+func ProvideScheduler(
+	cfg *config.Config,
+	repos *RepoSet,
+	uow *postgres.UnitOfWork,
+	services *ServiceSet,
+	hub *ws.Hub,
+	statusBuffer *redis.StatusBuffer,
+	eventPublisher shared.EventPublisher,
+) *cron.Scheduler {
+	return cron.NewScheduler(repos.Pet, uow, eventPublisher, statusBuffer, services.Social, services.Pet, hub,
+		cron.TradeSweepConfig{
+			Interval:  cfg.Cron.TradeSweepInterval(),
+			BatchSize: cfg.Cron.TradeSweepBatch(),
+		},
+		cron.StatusFlushConfig{
+			ScanInterval:  cfg.Cron.StatusScanInterval(),
+			FlushInterval: cfg.Cron.StatusFlushInterval(),
+			BatchSize:     cfg.Cron.StatusFlushBatch(),
+		},
+		cron.LifespanSweepConfig{
+			Interval:  cfg.Cron.LifespanSweepInterval(),
+			BatchSize: cfg.Cron.LifespanSweepBatch(),
+		},
+	)
 }
 
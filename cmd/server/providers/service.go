@@ -2,14 +2,17 @@ package providers
 
 import (
 	authApp "pets-server/internal/application/auth"
+	itemApp "pets-server/internal/application/item"
 	petApp "pets-server/internal/application/pet"
 	rankingApp "pets-server/internal/application/ranking"
 	socialApp "pets-server/internal/application/social"
+	"pets-server/internal/domain/item/effect"
 	"pets-server/internal/domain/pet"
 	"pets-server/internal/domain/shared"
 	"pets-server/internal/infrastructure/external/wechat"
 	"pets-server/internal/infrastructure/persistence/postgres"
 	"pets-server/internal/infrastructure/persistence/redis"
+	ws "pets-server/internal/interfaces/websocket"
 	"pets-server/internal/pkg/config"
 )
 
@@ -17,6 +20,7 @@ import (
 type ServiceSet struct {
 	Auth    *authApp.Service
 	Pet     *petApp.Service
+	Item    *itemApp.Service
 	Social  *socialApp.Service
 	Ranking *rankingApp.Service
 }
@@ -27,18 +31,25 @@ func ProvideServiceSet(
 	repos *RepoSet,
 	speciesRegistry *pet.SpeciesRegistry,
 	fusionRegistry *pet.SpeciesFusionRegistry,
+	randomEvents *pet.RandomEventTable,
 	uow *postgres.UnitOfWork,
 	cache *redis.CacheService,
 	rankingStore *redis.RankingStore,
 	sessionStore authApp.SessionStore,
 	wechatAuth *wechat.AuthService,
 	eventPublisher shared.EventPublisher,
+	hub *ws.Hub,
 ) *ServiceSet {
 	// Pet 服务不再使用缓存，保留参数仅为兼容现有依赖注入签名。
 	_ = cache
 
 	// 创建领域服务（使用注入的注册表）
-	petDomainService := pet.NewDomainService(repos.Pet, speciesRegistry, fusionRegistry)
+	petDomainService := pet.NewDomainService(repos.Pet, speciesRegistry, fusionRegistry, pet.InbreedingRules{
+		WarnThreshold:     cfg.Game.Inbreeding.WarnThreshold,
+		BlockThreshold:    cfg.Game.Inbreeding.BlockThreshold,
+		MutationBonus:     cfg.Game.Inbreeding.MutationBonus,
+		ResistancePenalty: cfg.Game.Inbreeding.ResistancePenalty,
+	})
 
 	return &ServiceSet{
 		Auth: authApp.NewService(
@@ -53,18 +64,48 @@ func ProvideServiceSet(
 			repos.User,
 			repos.Pet,
 			repos.Item,
+			repos.Decoration,
+			repos.BreedingContract,
+			repos.Adoption,
+			repos.Ownership,
+			repos.Memorial,
+			repos.RandomEvent,
+			repos.Friend,
+			repos.Interaction,
 			petDomainService,
+			randomEvents,
 			uow,
 			eventPublisher,
 			nil,
+			hub,
+			cfg.Game.KeepsakeItemID,
+			cfg.Game.GeneScannerItemID,
+			pet.RosterRules{
+				BaseCapacity: cfg.Game.Roster.BaseCapacity,
+				MaxCapacity:  cfg.Game.Roster.MaxCapacity,
+				SlotPrice:    cfg.Game.Roster.SlotPriceDiamonds,
+			},
+		),
+		Item: itemApp.NewService(
+			repos.User,
+			repos.Pet,
+			repos.Item,
+			repos.Decoration,
+			effect.NewEffectFactory(),
+			uow,
+			eventPublisher,
 		),
 		Social: socialApp.NewService(
+			repos.User,
+			repos.Item,
+			repos.Pet,
 			repos.Friend,
 			repos.Gift,
 			repos.Trade,
 			repos.Visit,
 			uow,
 			eventPublisher,
+			cfg.Cron.TradeTTL(),
 		),
 		Ranking: rankingApp.NewService(rankingStore),
 	}
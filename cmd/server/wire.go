@@ -16,6 +16,7 @@ func InitializeApp() (*App, func(), error) {
 		// 配置
 		providers.ProvideConfig,
 		providers.ProvideSpeciesConfig,
+		providers.ProvideRandomEventTable,
 
 		// 物种注册表
 		providers.ProvideInterpreterFactory,
@@ -29,6 +30,7 @@ func InitializeApp() (*App, func(), error) {
 		providers.ProvideWechatAuth,
 		providers.ProvideCacheService,
 		providers.ProvideRankingStore,
+		providers.ProvideStatusBuffer,
 		providers.ProvideAuthSessionStore,
 		providers.ProvideUnitOfWork,
 
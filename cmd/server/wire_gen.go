@@ -23,7 +23,6 @@ func InitializeApp() (*App, func(), error) {
 	if err != nil {
 		return nil, nil, err
 	}
-	repoSet := providers.ProvideRepoSet(db)
 	speciesConfig, err := providers.ProvideSpeciesConfig()
 	if err != nil {
 		cleanup()
@@ -35,7 +34,13 @@ func InitializeApp() (*App, func(), error) {
 		cleanup()
 		return nil, nil, err
 	}
+	repoSet := providers.ProvideRepoSet(db, speciesRegistry)
 	speciesFusionRegistry := providers.ProvideFusionRegistry(speciesConfig)
+	randomEventTable, err := providers.ProvideRandomEventTable(repoSet)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
 	unitOfWork := providers.ProvideUnitOfWork(db)
 	client, cleanup2, err := providers.ProvideRedis(config)
 	if err != nil {
@@ -52,11 +57,12 @@ func InitializeApp() (*App, func(), error) {
 		cleanup()
 		return nil, nil, err
 	}
-	serviceSet := providers.ProvideServiceSet(config, repoSet, speciesRegistry, speciesFusionRegistry, unitOfWork, cacheService, rankingStore, sessionStore, authService, eventPublisher)
 	hub := providers.ProvideWSHub()
+	serviceSet := providers.ProvideServiceSet(config, repoSet, speciesRegistry, speciesFusionRegistry, randomEventTable, unitOfWork, cacheService, rankingStore, sessionStore, authService, eventPublisher, hub)
 	handler := providers.ProvideWSHandler(hub)
 	engine := providers.ProvideRouter(config, serviceSet, handler, sessionStore)
-	scheduler := providers.ProvideScheduler(repoSet, unitOfWork)
+	statusBuffer := providers.ProvideStatusBuffer(client)
+	scheduler := providers.ProvideScheduler(config, repoSet, unitOfWork, serviceSet, hub, statusBuffer, eventPublisher)
 	app := NewApp(config, engine, hub, scheduler)
 	return app, func() {
 		cleanup3()
@@ -0,0 +1,264 @@
+// Package item 道具应用服务
+// 装饰品 - 穿戴与卸下
+package item
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"time"
+
+	"pets-server/internal/domain/item"
+	"pets-server/internal/domain/pet"
+)
+
+// ============================================================
+// 穿戴装饰 (POST /api/items/decorations/equip)
+// 调用链路：
+//   Handler.EquipDecoration()
+//     → AppService.EquipDecoration()
+//       → UoW.Do() 开启事务
+//         → ItemRepo.GetDefinition() 确认为装饰品并获取槽位
+//         → DecorationRepo.FindByPetAndSlot() 槽位必须为空
+//         → UserItem.Consume() 从背包取出装饰
+//         → DecorationRepo.Save() 穿戴
+//         → Pet.SetDecayBonus() 重新汇总衰减减免
+//         → PetRepo.Save()
+//       → 事务提交
+//       → EventPublisher.Publish(DecorationEquippedEvent)
+// 卸下流程相反：删除穿戴记录，装饰放回背包
+// ============================================================
+
+// EquipDecoration 为宠物穿戴装饰
+func (s *Service) EquipDecoration(ctx context.Context, userID int, req EquipDecorationRequest) (*PetDecorationsResponse, error) {
+	var response *PetDecorationsResponse
+	var event item.DecorationEquippedEvent
+
+	err := s.uow.Do(ctx, func(txCtx context.Context) error {
+		now := time.Now()
+
+		// 1. 获取装饰品定义
+		def, err := s.itemRepo.GetDefinition(txCtx, req.ItemID)
+		if err != nil {
+			return err
+		}
+
+		// 2. 获取宠物，先按旧的减免补算状态
+		p, err := s.findTargetPet(txCtx, userID, req.PetID)
+		if err != nil {
+			return err
+		}
+		p.ApplyStatusAt(now)
+
+		// 3. 领域逻辑：创建穿戴记录（校验道具类型与槽位）
+		decoration, err := item.NewPetDecoration(p.ID, def)
+		if err != nil {
+			return err
+		}
+
+		// 4. 每个槽位只能穿戴一件
+		if _, err := s.decoRepo.FindByPetAndSlot(txCtx, p.ID, decoration.Slot); err == nil {
+			return ErrSlotOccupied
+		} else if !errors.Is(err, item.ErrDecorationNotFound) {
+			return err
+		}
+
+		// 5. 从背包取出装饰
+		userItem, err := s.itemRepo.FindByUserAndItem(txCtx, userID, def.ID)
+		if err != nil {
+			return err
+		}
+		if err := userItem.Consume(1); err != nil {
+			return err
+		}
+		if err := s.saveUserItem(txCtx, userItem); err != nil {
+			return err
+		}
+
+		// 6. 保存穿戴记录并更新宠物减免
+		if err := s.decoRepo.Save(txCtx, decoration); err != nil {
+			return err
+		}
+		response, err = s.refreshDecorations(txCtx, p)
+		if err != nil {
+			return err
+		}
+
+		event = item.DecorationEquippedEvent{
+			UserID:    userID,
+			PetID:     p.ID,
+			ItemID:    def.ID,
+			Slot:      decoration.Slot,
+			Timestamp: now,
+		}
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if s.publisher != nil {
+		_ = s.publisher.Publish(ctx, event)
+	}
+
+	return response, nil
+}
+
+// UnequipDecoration 卸下宠物某槽位的装饰，装饰放回背包
+func (s *Service) UnequipDecoration(ctx context.Context, userID int, req UnequipDecorationRequest) (*PetDecorationsResponse, error) {
+	if !item.IsValidSlot(req.Slot) {
+		return nil, ErrInvalidSlot
+	}
+
+	var response *PetDecorationsResponse
+	var event item.DecorationUnequippedEvent
+
+	err := s.uow.Do(ctx, func(txCtx context.Context) error {
+		now := time.Now()
+
+		// 1. 获取宠物，先按旧的减免补算状态
+		p, err := s.findTargetPet(txCtx, userID, req.PetID)
+		if err != nil {
+			return err
+		}
+		p.ApplyStatusAt(now)
+
+		// 2. 获取并删除穿戴记录
+		decoration, err := s.decoRepo.FindByPetAndSlot(txCtx, p.ID, req.Slot)
+		if err != nil {
+			return err
+		}
+		if err := s.decoRepo.Delete(txCtx, decoration.ID); err != nil {
+			return err
+		}
+
+		// 3. 装饰放回背包
+		userItem, err := s.itemRepo.FindByUserAndItem(txCtx, userID, decoration.ItemID)
+		if err != nil {
+			if !errors.Is(err, item.ErrItemNotFound) {
+				return err
+			}
+			userItem = item.NewUserItem(userID, decoration.ItemID, 0)
+		}
+		userItem.Add(1)
+		if err := s.itemRepo.Save(txCtx, userItem); err != nil {
+			return err
+		}
+
+		// 4. 更新宠物减免
+		response, err = s.refreshDecorations(txCtx, p)
+		if err != nil {
+			return err
+		}
+
+		event = item.DecorationUnequippedEvent{
+			UserID:    userID,
+			PetID:     p.ID,
+			ItemID:    decoration.ItemID,
+			Slot:      decoration.Slot,
+			Timestamp: now,
+		}
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if s.publisher != nil {
+		_ = s.publisher.Publish(ctx, event)
+	}
+
+	return response, nil
+}
+
+// GetPetDecorations 获取宠物穿戴中的装饰
+func (s *Service) GetPetDecorations(ctx context.Context, userID int, req PetDecorationsRequest) (*PetDecorationsResponse, error) {
+	p, err := s.findTargetPet(ctx, userID, req.PetID)
+	if err != nil {
+		return nil, err
+	}
+
+	decorations, err := s.decoRepo.FindByPetID(ctx, p.ID)
+	if err != nil {
+		return nil, err
+	}
+	defs, err := s.definitionMap(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return toPetDecorationsResponse(p, decorations, defs), nil
+}
+
+// refreshDecorations 重新汇总宠物的装饰减免并保存宠物
+func (s *Service) refreshDecorations(ctx context.Context, p *pet.Pet) (*PetDecorationsResponse, error) {
+	decorations, err := s.decoRepo.FindByPetID(ctx, p.ID)
+	if err != nil {
+		return nil, err
+	}
+	defs, err := s.definitionMap(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	p.SetDecayBonus(item.DecorationBonus(decorations, defs))
+	if err := s.petRepo.Save(ctx, p); err != nil {
+		return nil, err
+	}
+
+	return toPetDecorationsResponse(p, decorations, defs), nil
+}
+
+// saveUserItem 保存背包道具，用完的道具从背包移除
+func (s *Service) saveUserItem(ctx context.Context, userItem *item.UserItem) error {
+	if userItem.IsEmpty() {
+		return s.itemRepo.Delete(ctx, userItem.ID)
+	}
+	return s.itemRepo.Save(ctx, userItem)
+}
+
+// findTargetPet 获取操作的宠物，未指定时使用主宠物
+func (s *Service) findTargetPet(ctx context.Context, userID, petID int) (*pet.Pet, error) {
+	if petID == 0 {
+		return s.getActivePet(ctx, userID)
+	}
+
+	p, err := s.petRepo.FindByID(ctx, petID)
+	if err != nil {
+		return nil, err
+	}
+	if p.UserID != userID {
+		return nil, ErrNotPetOwner
+	}
+	return p, nil
+}
+
+func toPetDecorationsResponse(p *pet.Pet, decorations []*item.PetDecoration, defs map[int]*item.ItemDefinition) *PetDecorationsResponse {
+	result := make([]DecorationDTO, 0, len(decorations))
+	for _, d := range decorations {
+		dto := DecorationDTO{
+			Slot:       d.Slot,
+			SlotName:   item.SlotName(d.Slot),
+			ItemID:     d.ItemID,
+			EquippedAt: d.EquippedAt,
+		}
+		if def, ok := defs[d.ItemID]; ok {
+			dto.Name = def.Name
+			dto.Rarity = def.Rarity
+		}
+		result = append(result, dto)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Slot < result[j].Slot })
+
+	return &PetDecorationsResponse{
+		PetID:       p.ID,
+		Decorations: result,
+		DecayBonus: DecayBonusDTO{
+			Hunger:      p.DecayBonus.Hunger,
+			Happiness:   p.DecayBonus.Happiness,
+			Cleanliness: p.DecayBonus.Cleanliness,
+		},
+	}
+}
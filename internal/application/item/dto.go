@@ -0,0 +1,151 @@
+// Package item 道具应用服务
+// DTO 数据传输对象
+package item
+
+import "time"
+
+// --- 背包相关 ---
+
+// BagItemDTO 背包道具
+type BagItemDTO struct {
+	ItemID      int    `json:"itemId"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Type        int    `json:"type"`
+	TypeName    string `json:"typeName"`
+	EffectType  string `json:"effectType"`
+	EffectValue int    `json:"effectValue"`
+	Rarity      int    `json:"rarity"`
+	Quantity    int    `json:"quantity"`
+}
+
+// BagResponse 背包响应
+type BagResponse struct {
+	Items []BagItemDTO `json:"items"`
+}
+
+// UseItemRequest 使用道具请求
+type UseItemRequest struct {
+	ItemID  int    `json:"itemId" binding:"required"`                // 道具ID
+	NewName string `json:"newName" binding:"omitempty,min=1,max=20"` // 新名字（改名卡使用）
+}
+
+// UseItemResponse 使用道具响应
+type UseItemResponse struct {
+	ItemID       int             `json:"itemId"`
+	Remaining    int             `json:"remaining"`    // 剩余数量
+	PetID        int             `json:"petId"`        // 作用的宠物
+	Name         string          `json:"name"`         // 宠物名字（改名后为新名字）
+	Status       PetStatusDTO    `json:"status"`       // 使用后的宠物状态
+	LevelUp      bool            `json:"levelUp"`      // 是否升级
+	NewLevel     int             `json:"newLevel"`     // 当前等级
+	SkillLevelUp bool            `json:"skillLevelUp"` // 技能是否升级
+	SkillLevel   int             `json:"skillLevel"`   // 当前技能等级
+	Effects      []ItemEffectDTO `json:"effects"`      // 生效的效果（可组合多个）
+}
+
+// ItemEffectDTO 道具效果
+type ItemEffectDTO struct {
+	Type  string `json:"type"`
+	Value int    `json:"value"`
+}
+
+// PetStatusDTO 宠物状态
+type PetStatusDTO struct {
+	Hunger      int        `json:"hunger"`
+	Happiness   int        `json:"happiness"`
+	Cleanliness int        `json:"cleanliness"`
+	Energy      int        `json:"energy"`
+	Level       int        `json:"level"`
+	Exp         int        `json:"exp"`
+	IsSick      bool       `json:"isSick"`
+	Illness     string     `json:"illness"`           // 疾病名称，健康时为"健康"
+	Mood        string     `json:"mood"`              // 情绪：content, happy, excited, grumpy, sulking, sleepy
+	MoodName    string     `json:"moodName"`          // 情绪名称
+	HatchAt     *time.Time `json:"hatchAt,omitempty"` // 蛋的预计孵化时间（孵化器生效后）
+}
+
+// --- 商店相关 ---
+
+// ShopItemsRequest 商店列表请求（查询参数）
+type ShopItemsRequest struct {
+	Type int `form:"type" binding:"omitempty,min=1,max=5"` // 道具类型（可选）：1食物 2清洁 3玩具 4装饰 5特殊
+}
+
+// ShopItemDTO 商店道具
+type ShopItemDTO struct {
+	ItemID       int    `json:"itemId"`
+	Name         string `json:"name"`
+	Description  string `json:"description"`
+	Type         int    `json:"type"`
+	TypeName     string `json:"typeName"`
+	EffectType   string `json:"effectType"`
+	EffectValue  int    `json:"effectValue"`
+	Rarity       int    `json:"rarity"`
+	Price        int    `json:"price"`
+	Currency     int    `json:"currency"` // 0金币 1钻石
+	CurrencyName string `json:"currencyName"`
+}
+
+// ShopResponse 商店响应
+type ShopResponse struct {
+	Items []ShopItemDTO `json:"items"`
+}
+
+// BuyItemRequest 购买道具请求
+type BuyItemRequest struct {
+	ItemID   int `json:"itemId" binding:"required"`                // 道具ID
+	Quantity int `json:"quantity" binding:"required,min=1,max=99"` // 购买数量
+}
+
+// BuyItemResponse 购买道具响应
+type BuyItemResponse struct {
+	ItemID   int `json:"itemId"`
+	Quantity int `json:"quantity"` // 购买后持有数量
+	Cost     int `json:"cost"`     // 花费
+	Coins    int `json:"coins"`    // 剩余金币
+	Diamonds int `json:"diamonds"` // 剩余钻石
+}
+
+// --- 装饰相关 ---
+
+// EquipDecorationRequest 穿戴装饰请求
+type EquipDecorationRequest struct {
+	PetID  int `json:"petId"`                     // 宠物ID，不传则为当前主宠物
+	ItemID int `json:"itemId" binding:"required"` // 装饰品道具ID（槽位由道具决定）
+}
+
+// UnequipDecorationRequest 卸下装饰请求
+type UnequipDecorationRequest struct {
+	PetID int    `json:"petId"`                                             // 宠物ID，不传则为当前主宠物
+	Slot  string `json:"slot" binding:"required,oneof=head body accessory"` // 槽位
+}
+
+// PetDecorationsRequest 查询宠物装饰请求
+type PetDecorationsRequest struct {
+	PetID int `form:"petId"` // 宠物ID，不传则为当前主宠物
+}
+
+// DecorationDTO 穿戴中的装饰
+type DecorationDTO struct {
+	Slot       string    `json:"slot"`
+	SlotName   string    `json:"slotName"`
+	ItemID     int       `json:"itemId"`
+	Name       string    `json:"name"`
+	Rarity     int       `json:"rarity"`
+	EquippedAt time.Time `json:"equippedAt"`
+}
+
+// DecayBonusDTO 装饰带来的状态衰减减免（百分比）
+type DecayBonusDTO struct {
+	Hunger      int `json:"hunger"`
+	Happiness   int `json:"happiness"`
+	Cleanliness int `json:"cleanliness"`
+}
+
+// PetDecorationsResponse 宠物装饰响应
+type PetDecorationsResponse struct {
+	PetID       int             `json:"petId"`
+	Decorations []DecorationDTO `json:"decorations"`
+	DecayBonus  DecayBonusDTO   `json:"decayBonus"`
+}
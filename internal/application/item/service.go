@@ -0,0 +1,397 @@
+// Package item 道具应用服务
+// 编排背包、商店和道具使用相关的业务用例
+package item
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"time"
+
+	"pets-server/internal/domain/item"
+	"pets-server/internal/domain/item/effect"
+	"pets-server/internal/domain/pet"
+	"pets-server/internal/domain/shared"
+	"pets-server/internal/domain/user"
+)
+
+// Service 道具应用服务
+type Service struct {
+	userRepo  user.Repository
+	petRepo   pet.Repository
+	itemRepo  item.Repository
+	decoRepo  item.DecorationRepository
+	effects   *effect.EffectFactory
+	uow       shared.UnitOfWork
+	publisher shared.EventPublisher
+}
+
+// NewService 创建道具应用服务
+func NewService(
+	userRepo user.Repository,
+	petRepo pet.Repository,
+	itemRepo item.Repository,
+	decoRepo item.DecorationRepository,
+	effects *effect.EffectFactory,
+	uow shared.UnitOfWork,
+	publisher shared.EventPublisher,
+) *Service {
+	return &Service{
+		userRepo:  userRepo,
+		petRepo:   petRepo,
+		itemRepo:  itemRepo,
+		decoRepo:  decoRepo,
+		effects:   effects,
+		uow:       uow,
+		publisher: publisher,
+	}
+}
+
+// GetBag 获取用户背包
+func (s *Service) GetBag(ctx context.Context, userID int) (*BagResponse, error) {
+	userItems, err := s.itemRepo.FindByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	defs, err := s.definitionMap(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]BagItemDTO, 0, len(userItems))
+	for _, ui := range userItems {
+		if ui.IsEmpty() {
+			continue
+		}
+		def, ok := defs[ui.ItemID]
+		if !ok {
+			continue
+		}
+		items = append(items, BagItemDTO{
+			ItemID:      def.ID,
+			Name:        def.Name,
+			Description: def.Description,
+			Type:        int(def.Type),
+			TypeName:    def.Type.Name(),
+			EffectType:  def.EffectType,
+			EffectValue: def.EffectValue,
+			Rarity:      def.Rarity,
+			Quantity:    ui.Quantity,
+		})
+	}
+
+	sort.Slice(items, func(i, j int) bool { return items[i].ItemID < items[j].ItemID })
+	return &BagResponse{Items: items}, nil
+}
+
+// GetShopItems 获取商店道具列表，未指定类型时返回全部
+func (s *Service) GetShopItems(ctx context.Context, req ShopItemsRequest) (*ShopResponse, error) {
+	itemType := item.ItemType(req.Type)
+
+	defs, err := s.itemRepo.GetAllDefinitions(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]ShopItemDTO, 0, len(defs))
+	for _, def := range defs {
+		if !def.IsForSale() {
+			continue
+		}
+		if itemType != 0 && def.Type != itemType {
+			continue
+		}
+		items = append(items, ShopItemDTO{
+			ItemID:       def.ID,
+			Name:         def.Name,
+			Description:  def.Description,
+			Type:         int(def.Type),
+			TypeName:     def.Type.Name(),
+			EffectType:   def.EffectType,
+			EffectValue:  def.EffectValue,
+			Rarity:       def.Rarity,
+			Price:        def.Price,
+			Currency:     int(def.Currency),
+			CurrencyName: def.Currency.Name(),
+		})
+	}
+
+	sort.Slice(items, func(i, j int) bool { return items[i].ItemID < items[j].ItemID })
+	return &ShopResponse{Items: items}, nil
+}
+
+// ============================================================
+// 购买道具 (POST /api/items/buy)
+// 调用链路：
+//   Handler.BuyItem()
+//     → AppService.BuyItem()
+//       → UoW.Do() 开启事务
+//         → ItemRepo.GetDefinition() 获取道具定义与价格
+//         → User.SpendCoins()/SpendDiamonds() 扣除货币
+//         → UserItem.Add() 增加背包数量
+//         → UserRepo.Save() / ItemRepo.Save()
+//       → 事务提交
+//       → EventPublisher.Publish(ItemPurchasedEvent)
+// ============================================================
+
+// BuyItem 购买道具
+func (s *Service) BuyItem(ctx context.Context, userID int, req BuyItemRequest) (*BuyItemResponse, error) {
+	var response *BuyItemResponse
+	var event item.ItemPurchasedEvent
+
+	err := s.uow.Do(ctx, func(txCtx context.Context) error {
+		// 1. 获取道具定义并计算总价
+		def, err := s.itemRepo.GetDefinition(txCtx, req.ItemID)
+		if err != nil {
+			return err
+		}
+		cost, err := def.TotalPrice(req.Quantity)
+		if err != nil {
+			return err
+		}
+
+		// 2. 扣除货币（锁定用户行，避免并发购买覆盖余额）
+		u, err := s.userRepo.FindByIDForUpdate(txCtx, userID)
+		if err != nil {
+			return err
+		}
+		if def.Currency == item.CurrencyDiamonds {
+			err = u.SpendDiamonds(cost)
+		} else {
+			err = u.SpendCoins(cost)
+		}
+		if err != nil {
+			return err
+		}
+
+		// 3. 增加背包道具
+		userItem, err := s.itemRepo.FindByUserAndItemForUpdate(txCtx, userID, def.ID)
+		if err != nil {
+			if !errors.Is(err, item.ErrItemNotFound) {
+				return err
+			}
+			userItem = item.NewUserItem(userID, def.ID, 0)
+		}
+		userItem.Add(req.Quantity)
+
+		// 4. 保存变更
+		if err := s.userRepo.Save(txCtx, u); err != nil {
+			return err
+		}
+		if err := s.itemRepo.Save(txCtx, userItem); err != nil {
+			return err
+		}
+
+		event = item.ItemPurchasedEvent{
+			UserID:    userID,
+			ItemID:    def.ID,
+			Quantity:  req.Quantity,
+			Currency:  int(def.Currency),
+			Cost:      cost,
+			Timestamp: time.Now(),
+		}
+		response = &BuyItemResponse{
+			ItemID:   def.ID,
+			Quantity: userItem.Quantity,
+			Cost:     cost,
+			Coins:    u.Coins,
+			Diamonds: u.Diamonds,
+		}
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if s.publisher != nil {
+		_ = s.publisher.Publish(ctx, event)
+	}
+
+	return response, nil
+}
+
+// ============================================================
+// 使用道具 (POST /api/items/use)
+// 调用链路：
+//   Handler.UseItem()
+//     → AppService.UseItem()
+//       → UoW.Do() 开启事务
+//         → ItemDefinition.Effects() 解析组合效果
+//         → EffectFactory.ApplyAll() 校验并应用每个效果处理器
+//         → UserItem.Consume() 扣减背包数量
+//         → ItemRepo.Save()/Delete() / PetRepo.Save()
+//       → 事务提交
+//       → EventPublisher.Publish(宠物事件 + ItemUsedEvent)
+// ============================================================
+
+// UseItem 对当前主宠物使用道具
+func (s *Service) UseItem(ctx context.Context, userID int, req UseItemRequest) (*UseItemResponse, error) {
+	var response *UseItemResponse
+	var events []any
+
+	err := s.uow.Do(ctx, func(txCtx context.Context) error {
+		now := time.Now()
+
+		// 1. 获取道具定义和背包道具（锁定道具行，避免并发使用重复扣减）
+		def, err := s.itemRepo.GetDefinition(txCtx, req.ItemID)
+		if err != nil {
+			return err
+		}
+		userItem, err := s.itemRepo.FindByUserAndItemForUpdate(txCtx, userID, req.ItemID)
+		if err != nil {
+			return err
+		}
+
+		// 2. 获取主宠物并补算状态
+		p, err := s.getActivePet(txCtx, userID)
+		if err != nil {
+			return err
+		}
+		p.ApplyStatusAt(now)
+
+		// 3. 领域逻辑：消耗道具并应用效果
+		if def.Type == item.ItemTypeDecoration {
+			return item.ErrItemNotUsable
+		}
+		effects, err := def.Effects()
+		if err != nil {
+			return err
+		}
+		if err := userItem.Consume(1); err != nil {
+			return err
+		}
+		oldLevel, oldSkillLevel := p.Level, p.Skill.Level
+		effectCtx := &item.EffectContext{
+			Pet:  p,
+			Item: def,
+			Args: item.EffectArgs{NewName: req.NewName},
+		}
+		if err := s.effects.ApplyAll(effectCtx, effects); err != nil {
+			return err
+		}
+		p.UpdateMood(now)
+
+		// 4. 保存变更（用完的道具从背包移除）
+		if err := s.saveUserItem(txCtx, userItem); err != nil {
+			return err
+		}
+		if err := s.petRepo.Save(txCtx, p); err != nil {
+			return err
+		}
+
+		// 5. 收集事件
+		events = append(events, p.Events()...)
+		events = append(events, item.ItemUsedEvent{
+			UserID:      userID,
+			PetID:       p.ID,
+			ItemID:      def.ID,
+			EffectType:  def.EffectType,
+			EffectValue: def.EffectValue,
+			Timestamp:   now,
+		})
+
+		response = &UseItemResponse{
+			ItemID:    def.ID,
+			Remaining: userItem.Quantity,
+			PetID:     p.ID,
+			Status: PetStatusDTO{
+				Hunger:      p.Hunger,
+				Happiness:   p.Happiness,
+				Cleanliness: p.Cleanliness,
+				Energy:      p.Energy,
+				Level:       p.Level,
+				Exp:         p.Exp,
+				IsSick:      p.IsSick(),
+				Illness:     p.Illness.Name(),
+				Mood:        string(p.Mood),
+				MoodName:    p.Mood.Name(),
+			},
+			LevelUp:      p.Level > oldLevel,
+			NewLevel:     p.Level,
+			SkillLevelUp: p.Skill.Level > oldSkillLevel,
+			SkillLevel:   p.Skill.Level,
+			Name:         p.Name,
+			Effects:      toItemEffectDTOs(effects),
+		}
+		if p.Stage == pet.StageEgg {
+			hatchAt := p.HatchTime()
+			response.Status.HatchAt = &hatchAt
+		}
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if s.publisher != nil {
+		for _, event := range events {
+			if e, ok := event.(shared.Event); ok {
+				_ = s.publisher.Publish(ctx, e)
+			}
+		}
+	}
+
+	return response, nil
+}
+
+// toItemEffectDTOs 转换生效的效果列表
+func toItemEffectDTOs(effects []item.Effect) []ItemEffectDTO {
+	result := make([]ItemEffectDTO, 0, len(effects))
+	for _, e := range effects {
+		result = append(result, ItemEffectDTO{Type: e.Type, Value: e.Value})
+	}
+	return result
+}
+
+// getActivePet 获取用户主宠物（未设置或已失效时退回第一只宠物）
+func (s *Service) getActivePet(ctx context.Context, userID int) (*pet.Pet, error) {
+	u, err := s.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return pet.ResolveActivePet(ctx, s.petRepo, userID, u.ActivePetID)
+}
+
+// definitionMap 获取道具定义索引
+func (s *Service) definitionMap(ctx context.Context) (map[int]*item.ItemDefinition, error) {
+	defs, err := s.itemRepo.GetAllDefinitions(ctx)
+	if err != nil {
+		return nil, err
+	}
+	result := make(map[int]*item.ItemDefinition, len(defs))
+	for _, def := range defs {
+		result[def.ID] = def
+	}
+	return result, nil
+}
+
+// 应用层错误
+var (
+	ErrNotPetOwner = errors.New("非宠物主人")
+
+	// 透传领域错误，便于接口层做响应码映射
+	ErrItemNotFound         = item.ErrItemNotFound
+	ErrInsufficientItem     = item.ErrInsufficientItem
+	ErrItemNotForSale       = item.ErrItemNotForSale
+	ErrItemNotUsable        = item.ErrItemNotUsable
+	ErrUnknownEffect        = item.ErrUnknownEffect
+	ErrInvalidEffect        = item.ErrInvalidEffect
+	ErrStageNotAllowed      = effect.ErrStageNotAllowed
+	ErrNameRequired         = effect.ErrNameRequired
+	ErrWrongMedicine        = effect.ErrWrongMedicine
+	ErrPetNotSick           = pet.ErrPetNotSick
+	ErrPetIsEgg             = pet.ErrPetIsEgg
+	ErrNoBreedCooldown      = pet.ErrNoBreedCooldown
+	ErrInvalidPetName       = pet.ErrInvalidPetName
+	ErrSamePetName          = pet.ErrSamePetName
+	ErrDecorationNotFound   = item.ErrDecorationNotFound
+	ErrNotDecoration        = item.ErrNotDecoration
+	ErrInvalidSlot          = item.ErrInvalidSlot
+	ErrSlotOccupied         = item.ErrSlotOccupied
+	ErrInsufficientCoins    = user.ErrInsufficientCoins
+	ErrInsufficientDiamonds = user.ErrInsufficientDiamonds
+	ErrPetNotFound          = pet.ErrPetNotFound
+)
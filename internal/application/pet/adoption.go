@@ -0,0 +1,388 @@
+// Package pet 宠物应用服务
+// 领养市场 - 宠物出售与赠送
+package pet
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"pets-server/internal/domain/item"
+	"pets-server/internal/domain/pet"
+	"pets-server/internal/domain/social"
+	"pets-server/internal/domain/user"
+)
+
+// DefaultAdoptionPageSize 领养市场默认每页数量
+const DefaultAdoptionPageSize = 20
+
+// ============================================================
+// 领养流程：
+//   原主人 ListPetForAdoption()
+//     → 出售：标价金币或钻石，任何人都可领养
+//     → 赠送：指定一位好友，只有该好友可领养
+//   领养人 AdoptPet()
+//     → UoW.Do() 开启事务
+//       → 锁定挂单与双方用户，校验挂单与宠物仍归原主人
+//       → 领养人付款，原主人收款（赠送跳过）
+//       → 卸下装饰并退回原主人背包
+//       → Pet.TransferTo() 易主，清空原主人的主宠物
+//       → 保存挂单与易主记录
+//     → 清除双方缓存，发布事件
+//   原主人 CancelAdoption() 撤回挂单
+// ============================================================
+
+// ListPetForAdoption 把宠物挂到领养市场
+func (s *Service) ListPetForAdoption(ctx context.Context, userID int, req ListPetForAdoptionRequest) (*AdoptionListingDTO, error) {
+	var listing *pet.AdoptionListing
+	var events []any
+
+	err := s.uow.Do(ctx, func(txCtx context.Context) error {
+		// 1. 只能挂自己的宠物
+		p, err := s.findOwnedPet(txCtx, userID, req.PetID)
+		if err != nil {
+			return err
+		}
+
+		// 2. 同一只宠物同时只能有一份挂单
+		if _, err := s.adoptionRepo.FindOpenByPet(txCtx, p.ID); err == nil {
+			return ErrPetAlreadyListed
+		} else if !errors.Is(err, pet.ErrAdoptionNotFound) {
+			return err
+		}
+
+		// 3. 创建挂单（赠送仅限好友）
+		if req.RecipientID != 0 {
+			if _, err := social.FindFriendship(txCtx, s.friendRepo, userID, req.RecipientID); err != nil {
+				return err
+			}
+			listing, err = pet.NewGiftListing(p.ID, userID, req.RecipientID)
+		} else {
+			listing, err = pet.NewAdoptionListing(p.ID, userID, pet.AdoptionCurrency(req.Currency), req.Price)
+		}
+		if err != nil {
+			return err
+		}
+
+		if err := s.adoptionRepo.Save(txCtx, listing); err != nil {
+			return err
+		}
+
+		listing.Listed()
+		events = listing.Events()
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	s.publishEvents(ctx, events)
+
+	dto := toAdoptionListingDTO(listing)
+	return &dto, nil
+}
+
+// AdoptPet 领养挂单中的宠物
+func (s *Service) AdoptPet(ctx context.Context, userID, listingID int) (*AdoptPetResponse, error) {
+	var response *AdoptPetResponse
+	var listing *pet.AdoptionListing
+	var events []any
+
+	err := s.uow.Do(ctx, func(txCtx context.Context) error {
+		now := time.Now()
+
+		// 1. 锁定挂单并校验领养资格，同一挂单的领养与撤回串行执行
+		var err error
+		listing, err = s.adoptionRepo.FindByIDForUpdate(txCtx, listingID)
+		if err != nil {
+			return err
+		}
+		if err := listing.CanAdopt(userID); err != nil {
+			return err
+		}
+
+		// 锁定双方用户，余额按整行保存，不能与其他扣款/收款并发
+		buyer, seller, err := user.LockPair(txCtx, s.userRepo, userID, listing.SellerID)
+		if err != nil {
+			return err
+		}
+
+		// 2. 宠物必须仍归原主人
+		p, err := s.findOwnedPet(txCtx, listing.SellerID, listing.PetID)
+		if err != nil {
+			if errors.Is(err, ErrNotPetOwner) {
+				return ErrAdoptionPetChanged
+			}
+			return err
+		}
+
+		// 3. 领养方宠物栏需有空位；赠送时好友关系可能已发生变化
+		if err := s.checkRosterRoom(txCtx, userID, 1); err != nil {
+			return err
+		}
+		if listing.IsGift() {
+			if _, err := social.FindFriendship(txCtx, s.friendRepo, listing.SellerID, userID); err != nil {
+				return err
+			}
+		}
+
+		// 4. 付款
+		switch listing.Currency {
+		case pet.AdoptionCurrencyCoins:
+			if err := buyer.SpendCoins(listing.Price); err != nil {
+				return err
+			}
+			seller.AddCoins(listing.Price)
+		case pet.AdoptionCurrencyDiamonds:
+			if err := buyer.SpendDiamonds(listing.Price); err != nil {
+				return err
+			}
+			seller.AddDiamonds(listing.Price)
+		}
+
+		// 5. 装饰品归原主人所有，卸下后退回其背包
+		if err := s.returnDecorations(txCtx, p.ID, seller.ID); err != nil {
+			return err
+		}
+
+		// 6. 易主（先按旧的减免补算状态）
+		p.ApplyStatusAt(now)
+		if err := p.TransferTo(userID); err != nil {
+			return err
+		}
+		if err := s.petRepo.Save(txCtx, p); err != nil {
+			return err
+		}
+
+		// 7. 更新双方主宠物
+		if seller.ActivePetID != nil && *seller.ActivePetID == p.ID {
+			seller.ActivePetID = nil
+		}
+		if buyer.ActivePetID == nil {
+			buyer.ActivePetID = &p.ID
+		}
+		if err := s.userRepo.Save(txCtx, seller); err != nil {
+			return err
+		}
+		if err := s.userRepo.Save(txCtx, buyer); err != nil {
+			return err
+		}
+
+		// 8. 完成挂单并记录易主
+		if err := listing.Complete(userID); err != nil {
+			return err
+		}
+		if err := s.adoptionRepo.Save(txCtx, listing); err != nil {
+			return err
+		}
+		record := pet.NewOwnershipRecord(listing)
+		if err := s.ownerRepo.Save(txCtx, &record); err != nil {
+			return err
+		}
+
+		events = append(p.Events(), listing.Events()...)
+		response = &AdoptPetResponse{
+			Listing: toAdoptionListingDTO(listing),
+			Pet:     *s.toPetDetailDTO(p),
+		}
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	s.publishEvents(ctx, events)
+
+	// 清除双方缓存
+	if s.cache != nil {
+		_ = s.cache.DeletePetDetail(ctx, listing.SellerID)
+		_ = s.cache.DeletePetDetail(ctx, userID)
+	}
+
+	return response, nil
+}
+
+// CancelAdoption 撤回领养挂单
+func (s *Service) CancelAdoption(ctx context.Context, userID, listingID int) (*AdoptionListingDTO, error) {
+	var listing *pet.AdoptionListing
+	var events []any
+
+	err := s.uow.Do(ctx, func(txCtx context.Context) error {
+		var err error
+		listing, err = s.adoptionRepo.FindByIDForUpdate(txCtx, listingID)
+		if err != nil {
+			return err
+		}
+		if err := listing.Cancel(userID); err != nil {
+			return err
+		}
+		if err := s.adoptionRepo.Save(txCtx, listing); err != nil {
+			return err
+		}
+		events = listing.Events()
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	s.publishEvents(ctx, events)
+
+	dto := toAdoptionListingDTO(listing)
+	return &dto, nil
+}
+
+// GetAdoptionMarket 获取可领养的挂单（公开出售及赠送给我的）
+func (s *Service) GetAdoptionMarket(ctx context.Context, userID int, req AdoptionMarketRequest) (*AdoptionListResponse, error) {
+	page, pageSize := req.Page, req.PageSize
+	if page <= 0 {
+		page = 1
+	}
+	if pageSize <= 0 {
+		pageSize = DefaultAdoptionPageSize
+	}
+
+	listings, err := s.adoptionRepo.FindOpenForUser(ctx, userID, (page-1)*pageSize, pageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.toAdoptionListResponse(ctx, listings), nil
+}
+
+// GetMyAdoptions 获取我发布的挂单
+func (s *Service) GetMyAdoptions(ctx context.Context, userID int) (*AdoptionListResponse, error) {
+	listings, err := s.adoptionRepo.FindBySeller(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.toAdoptionListResponse(ctx, listings), nil
+}
+
+// GetOwnershipHistory 获取宠物的历任主人
+// 只有现任主人可查看
+func (s *Service) GetOwnershipHistory(ctx context.Context, userID, petID int) (*OwnershipHistoryResponse, error) {
+	p, err := s.findOwnedPet(ctx, userID, petID)
+	if err != nil {
+		return nil, err
+	}
+
+	records, err := s.ownerRepo.FindByPetID(ctx, p.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]OwnershipRecordDTO, 0, len(records))
+	for _, r := range records {
+		result = append(result, OwnershipRecordDTO{
+			FromUserID:    r.FromUserID,
+			ToUserID:      r.ToUserID,
+			Method:        int(r.Method),
+			MethodName:    r.Method.Name(),
+			Currency:      int(r.Currency),
+			CurrencyName:  r.Currency.Name(),
+			Price:         r.Price,
+			TransferredAt: r.TransferredAt,
+		})
+	}
+
+	return &OwnershipHistoryResponse{
+		PetID:          p.ID,
+		CurrentOwnerID: p.UserID,
+		Records:        result,
+	}, nil
+}
+
+// returnDecorations 卸下宠物全部装饰并退回指定用户背包
+func (s *Service) returnDecorations(ctx context.Context, petID, ownerID int) error {
+	decorations, err := s.decoRepo.FindByPetID(ctx, petID)
+	if err != nil {
+		return err
+	}
+
+	for _, d := range decorations {
+		if err := s.decoRepo.Delete(ctx, d.ID); err != nil {
+			return err
+		}
+		if err := s.giveItem(ctx, ownerID, d.ItemID, 1); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// giveItem 向用户背包发放道具（锁定道具行，避免覆盖并发的数量变更）
+func (s *Service) giveItem(ctx context.Context, userID, itemID, quantity int) error {
+	userItem, err := s.itemRepo.FindByUserAndItemForUpdate(ctx, userID, itemID)
+	if err != nil {
+		if !errors.Is(err, item.ErrItemNotFound) {
+			return err
+		}
+		userItem = item.NewUserItem(userID, itemID, 0)
+	}
+	userItem.Add(quantity)
+	return s.itemRepo.Save(ctx, userItem)
+}
+
+// saveUserItem 保存背包道具，用完的道具从背包移除
+func (s *Service) saveUserItem(ctx context.Context, userItem *item.UserItem) error {
+	if userItem.IsEmpty() {
+		return s.itemRepo.Delete(ctx, userItem.ID)
+	}
+	return s.itemRepo.Save(ctx, userItem)
+}
+
+// toAdoptionListResponse 转换挂单列表，附带宠物概要
+func (s *Service) toAdoptionListResponse(ctx context.Context, listings []*pet.AdoptionListing) *AdoptionListResponse {
+	result := make([]AdoptionListingDTO, 0, len(listings))
+	for _, l := range listings {
+		dto := toAdoptionListingDTO(l)
+		if p, err := s.petRepo.FindByID(ctx, l.PetID); err == nil {
+			dto.Pet = &AdoptionPetDTO{
+				Name:       p.Name,
+				SpeciesID:  int(p.SpeciesID),
+				Gender:     p.Gender.Name(),
+				Stage:      p.Stage.Name(),
+				Level:      p.Level,
+				Generation: p.Generation,
+			}
+		}
+		result = append(result, dto)
+	}
+	return &AdoptionListResponse{Listings: result}
+}
+
+func toAdoptionListingDTO(l *pet.AdoptionListing) AdoptionListingDTO {
+	return AdoptionListingDTO{
+		ID:           l.ID,
+		PetID:        l.PetID,
+		SellerID:     l.SellerID,
+		Currency:     int(l.Currency),
+		CurrencyName: l.Currency.Name(),
+		Price:        l.Price,
+		RecipientID:  l.RecipientID,
+		BuyerID:      l.BuyerID,
+		Status:       int(l.Status),
+		StatusName:   l.Status.Name(),
+		CreatedAt:    l.CreatedAt,
+		CompletedAt:  l.CompletedAt,
+	}
+}
+
+// 领养相关错误
+var (
+	ErrAdoptionPetChanged    = errors.New("挂单中的宠物已易主")
+	ErrAdoptionNotFound      = pet.ErrAdoptionNotFound
+	ErrInvalidAdoptionStatus = pet.ErrInvalidAdoptionStatus
+	ErrInvalidAdoptionPrice  = pet.ErrInvalidAdoptionPrice
+	ErrAdoptSelf             = pet.ErrAdoptSelf
+	ErrNotAdoptionRecipient  = pet.ErrNotAdoptionRecipient
+	ErrNotAdoptionSeller     = pet.ErrNotAdoptionSeller
+	ErrPetAlreadyListed      = pet.ErrPetAlreadyListed
+	ErrInsufficientCoins     = user.ErrInsufficientCoins
+	ErrInsufficientDiamonds  = user.ErrInsufficientDiamonds
+)
@@ -0,0 +1,391 @@
+// Package pet 宠物应用服务
+// 繁殖契约 - 好友之间跨主人繁殖
+package pet
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"time"
+
+	"pets-server/internal/domain/pet"
+	"pets-server/internal/domain/shared"
+	"pets-server/internal/domain/social"
+)
+
+// ============================================================
+// 跨主人繁殖流程：
+//   发起方 ProposeBreedingContract()
+//     → 校验好友关系与亲密度、双方宠物繁殖条件
+//     → 保存契约，通知双方
+//   接受方 AcceptBreedingContract()
+//     → UoW.Do() 开启事务
+//       → 再次校验双方宠物（可能已易主或进入冷却）
+//       → DomainService.BreedLitter() 按契约分配后代
+//       → 保存后代、双亲和契约
+//     → 清除双方缓存，发布事件
+//   任一方 RejectBreedingContract() 拒绝/撤回
+//   超过有效期的契约在读取或处理时被标记为过期
+// ============================================================
+
+// ProposeBreedingContract 发起繁殖契约
+func (s *Service) ProposeBreedingContract(ctx context.Context, userID int, req ProposeBreedingContractRequest) (*BreedingContractDTO, error) {
+	var contract *pet.BreedingContract
+	var events []any
+
+	err := s.uow.Do(ctx, func(txCtx context.Context) error {
+		now := time.Now()
+
+		// 1. 获取双方宠物
+		myPet, err := s.findOwnedPet(txCtx, userID, req.MyPetID)
+		if err != nil {
+			return err
+		}
+		partnerPet, err := s.petRepo.FindByID(txCtx, req.PartnerPetID)
+		if err != nil {
+			if errors.Is(err, pet.ErrPetNotFound) {
+				return ErrPetNotFound
+			}
+			return err
+		}
+		if partnerPet.UserID == userID {
+			return ErrContractSelf
+		}
+
+		// 2. 校验好友关系与亲密度
+		if err := s.checkBreedingFriendship(txCtx, userID, partnerPet.UserID); err != nil {
+			return err
+		}
+
+		// 3. 双方宠物都需满足繁殖条件
+		myPet.ApplyStatusAt(now)
+		partnerPet.ApplyStatusAt(now)
+		ancestry, err := s.loadAncestry(txCtx, myPet, partnerPet)
+		if err != nil {
+			return err
+		}
+		if err := s.petDomainSvc.CanBreedPair(myPet, partnerPet, ancestry); err != nil {
+			return err
+		}
+
+		// 4. 同一只宠物同时只能有一份待处理契约
+		for _, petID := range []int{myPet.ID, partnerPet.ID} {
+			pending, err := s.contractRepo.FindPendingByPet(txCtx, petID)
+			if err != nil {
+				return err
+			}
+			for _, c := range pending {
+				if !c.IsExpired(now) {
+					return ErrContractPending
+				}
+			}
+		}
+
+		// 5. 创建并保存契约
+		contract, err = pet.NewBreedingContract(
+			userID, myPet.ID,
+			partnerPet.UserID, partnerPet.ID,
+			pet.OffspringAssignment(req.Assignment),
+			req.ChildName,
+		)
+		if err != nil {
+			return err
+		}
+		if err := s.contractRepo.Save(txCtx, contract); err != nil {
+			return err
+		}
+
+		contract.Proposed()
+		events = contract.Events()
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	s.publishEvents(ctx, events)
+
+	dto := toBreedingContractDTO(contract)
+	return &dto, nil
+}
+
+// AcceptBreedingContract 接受繁殖契约并执行繁殖
+func (s *Service) AcceptBreedingContract(ctx context.Context, userID, contractID int, req AcceptBreedingContractRequest) (*AcceptBreedingContractResponse, error) {
+	var response *AcceptBreedingContractResponse
+	var contract *pet.BreedingContract
+	var events []any
+	expired := false
+
+	err := s.uow.Do(ctx, func(txCtx context.Context) error {
+		now := time.Now()
+
+		var err error
+		// 锁定契约行，防止并发的接受/撤回重复处理同一份契约
+		contract, err = s.contractRepo.FindByIDForUpdate(txCtx, contractID)
+		if err != nil {
+			return err
+		}
+		if contract.PartnerID != userID {
+			return ErrNotContractParty
+		}
+
+		// 过期的契约需要落库，不能通过返回错误回滚
+		if contract.IsExpired(now) {
+			contract.MarkExpired()
+			if err := s.contractRepo.Save(txCtx, contract); err != nil {
+				return err
+			}
+			events = contract.Events()
+			expired = true
+			return nil
+		}
+		if contract.Status != pet.ContractStatusPending {
+			return ErrInvalidContractStatus
+		}
+
+		// 1. 获取双亲并确认仍归契约双方所有
+		parent1, err := s.findOwnedPet(txCtx, contract.ProposerID, contract.ProposerPetID)
+		if err != nil {
+			if errors.Is(err, ErrNotPetOwner) {
+				return ErrContractPetChanged
+			}
+			return err
+		}
+		parent2, err := s.findOwnedPet(txCtx, contract.PartnerID, contract.PartnerPetID)
+		if err != nil {
+			if errors.Is(err, ErrNotPetOwner) {
+				return ErrContractPetChanged
+			}
+			return err
+		}
+
+		// 2. 好友关系可能在契约期间发生变化
+		if err := s.checkBreedingFriendship(txCtx, contract.ProposerID, contract.PartnerID); err != nil {
+			return err
+		}
+
+		parent1.ApplyStatusAt(now)
+		parent2.ApplyStatusAt(now)
+
+		// 3. 按契约分配后代（繁殖条件由领域服务再次校验）
+		contract.PartnerChildName = req.ChildName
+		ownerIDs := contract.OwnerIDs()
+		litter := make([]pet.LitterSlot, 0, len(ownerIDs))
+		incoming := make(map[int]int, len(ownerIDs))
+		for _, ownerID := range ownerIDs {
+			litter = append(litter, pet.LitterSlot{
+				OwnerID:   ownerID,
+				ChildName: contract.ChildNameFor(ownerID),
+			})
+			incoming[ownerID]++
+		}
+
+		// 获得后代的一方宠物栏需有空位（按用户ID顺序加锁检查）
+		owners := make([]int, 0, len(incoming))
+		for ownerID := range incoming {
+			owners = append(owners, ownerID)
+		}
+		sort.Ints(owners)
+		for _, ownerID := range owners {
+			if err := s.checkRosterRoom(txCtx, ownerID, incoming[ownerID]); err != nil {
+				return err
+			}
+		}
+
+		ancestry, err := s.loadAncestry(txCtx, parent1, parent2)
+		if err != nil {
+			return err
+		}
+		result, err := s.petDomainSvc.BreedLitter(parent1, parent2, litter, ancestry)
+		if err != nil {
+			return err
+		}
+
+		// 4. 保存后代
+		offspringIDs := make([]int, 0, len(result.Litter))
+		offspring := make([]PetDetailDTO, 0, len(result.Litter))
+		for _, child := range result.Litter {
+			if err := s.petRepo.Save(txCtx, child); err != nil {
+				return err
+			}
+			offspringIDs = append(offspringIDs, child.ID)
+			offspring = append(offspring, *s.toPetDetailDTO(child))
+			events = append(events, child.Events()...)
+		}
+
+		// 5. 保存双亲（繁殖时间已更新）
+		if err := s.petRepo.Save(txCtx, parent1); err != nil {
+			return err
+		}
+		if err := s.petRepo.Save(txCtx, parent2); err != nil {
+			return err
+		}
+
+		// 6. 更新契约
+		if err := contract.Accept(req.ChildName, offspringIDs); err != nil {
+			return err
+		}
+		if err := s.contractRepo.Save(txCtx, contract); err != nil {
+			return err
+		}
+		events = append(events, contract.Events()...)
+
+		response = &AcceptBreedingContractResponse{
+			Contract:   toBreedingContractDTO(contract),
+			Offspring:  offspring,
+			Inbreeding: toInbreedingDTO(result.Inbreeding),
+		}
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	s.publishEvents(ctx, events)
+	if expired {
+		return nil, ErrContractExpired
+	}
+
+	// 清除双方缓存
+	if s.cache != nil {
+		_ = s.cache.DeletePetDetail(ctx, contract.ProposerID)
+		_ = s.cache.DeletePetDetail(ctx, contract.PartnerID)
+	}
+
+	return response, nil
+}
+
+// RejectBreedingContract 拒绝（接受方）或撤回（发起方）繁殖契约
+func (s *Service) RejectBreedingContract(ctx context.Context, userID, contractID int) (*BreedingContractDTO, error) {
+	var contract *pet.BreedingContract
+	var events []any
+
+	err := s.uow.Do(ctx, func(txCtx context.Context) error {
+		var err error
+		contract, err = s.contractRepo.FindByIDForUpdate(txCtx, contractID)
+		if err != nil {
+			return err
+		}
+		if !contract.IsParty(userID) {
+			return ErrNotContractParty
+		}
+
+		if contract.IsExpired(time.Now()) {
+			contract.MarkExpired()
+		} else if err := contract.Reject(); err != nil {
+			return err
+		}
+
+		if err := s.contractRepo.Save(txCtx, contract); err != nil {
+			return err
+		}
+		events = contract.Events()
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	s.publishEvents(ctx, events)
+
+	dto := toBreedingContractDTO(contract)
+	return &dto, nil
+}
+
+// ListBreedingContracts 获取我发起和收到的繁殖契约
+func (s *Service) ListBreedingContracts(ctx context.Context, userID int) (*BreedingContractListResponse, error) {
+	var contracts []*pet.BreedingContract
+	var events []any
+
+	err := s.uow.Do(ctx, func(txCtx context.Context) error {
+		var err error
+		contracts, err = s.contractRepo.FindByUser(txCtx, userID)
+		if err != nil {
+			return err
+		}
+
+		// 顺带处理已过期的契约
+		now := time.Now()
+		for _, c := range contracts {
+			if !c.IsExpired(now) {
+				continue
+			}
+			// 加锁后重新读取，契约可能已被对方接受或撤回
+			locked, err := s.contractRepo.FindByIDForUpdate(txCtx, c.ID)
+			if err != nil {
+				return err
+			}
+			*c = *locked
+			if !c.IsExpired(now) {
+				continue
+			}
+			c.MarkExpired()
+			if err := s.contractRepo.Save(txCtx, c); err != nil {
+				return err
+			}
+			events = append(events, c.Events()...)
+		}
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	s.publishEvents(ctx, events)
+
+	result := make([]BreedingContractDTO, 0, len(contracts))
+	for _, c := range contracts {
+		result = append(result, toBreedingContractDTO(c))
+	}
+	return &BreedingContractListResponse{Contracts: result}, nil
+}
+
+// checkBreedingFriendship 校验双方是否为好友且亲密度达标
+func (s *Service) checkBreedingFriendship(ctx context.Context, userID, friendID int) error {
+	friendship, err := social.FindFriendship(ctx, s.friendRepo, userID, friendID)
+	if err != nil {
+		return err
+	}
+	if !friendship.CanBreedTogether() {
+		return ErrIntimacyTooLow
+	}
+	return nil
+}
+
+// publishEvents 发布领域事件（事务外调用）
+func (s *Service) publishEvents(ctx context.Context, events []any) {
+	if s.publisher == nil {
+		return
+	}
+	for _, event := range events {
+		if e, ok := event.(shared.Event); ok {
+			_ = s.publisher.Publish(ctx, e)
+		}
+	}
+}
+
+func toBreedingContractDTO(c *pet.BreedingContract) BreedingContractDTO {
+	offspringIDs := c.OffspringIDs
+	if offspringIDs == nil {
+		offspringIDs = []int{}
+	}
+	return BreedingContractDTO{
+		ID:             c.ID,
+		ProposerID:     c.ProposerID,
+		ProposerPetID:  c.ProposerPetID,
+		PartnerID:      c.PartnerID,
+		PartnerPetID:   c.PartnerPetID,
+		Assignment:     int(c.Assignment),
+		AssignmentName: c.Assignment.Name(),
+		Status:         int(c.Status),
+		StatusName:     c.Status.Name(),
+		OffspringIDs:   offspringIDs,
+		CreatedAt:      c.CreatedAt,
+		ExpiresAt:      c.ExpiresAt,
+		RespondedAt:    c.RespondedAt,
+	}
+}
@@ -32,23 +32,65 @@ type SetActivePetResponse struct {
 
 // FeedPetResponse 喂食响应
 type FeedPetResponse struct {
-	Hunger    int  `json:"hunger"`    // 当前饱食度
-	ExpGained int  `json:"expGained"` // 获得经验
-	LevelUp   bool `json:"levelUp"`   // 是否升级
-	NewLevel  int  `json:"newLevel"`  // 新等级（如果升级）
+	Hunger       int  `json:"hunger"`       // 当前饱食度
+	ExpGained    int  `json:"expGained"`    // 获得经验
+	LevelUp      bool `json:"levelUp"`      // 是否升级
+	NewLevel     int  `json:"newLevel"`     // 新等级（如果升级）
+	SkillLevelUp bool `json:"skillLevelUp"` // 技能是否升级
+
+	RandomEvent *RandomEventDTO `json:"randomEvent,omitempty"` // 本次触发的随机事件
 }
 
 // PlayPetResponse 玩耍响应
 type PlayPetResponse struct {
-	Happiness int  `json:"happiness"` // 当前快乐度
-	Energy    int  `json:"energy"`    // 当前精力
-	ExpGained int  `json:"expGained"` // 获得经验
-	LevelUp   bool `json:"levelUp"`   // 是否升级
+	Happiness    int  `json:"happiness"`    // 当前快乐度
+	Energy       int  `json:"energy"`       // 当前精力
+	ExpGained    int  `json:"expGained"`    // 获得经验
+	LevelUp      bool `json:"levelUp"`      // 是否升级
+	SkillLevelUp bool `json:"skillLevelUp"` // 技能是否升级
+
+	RandomEvent *RandomEventDTO `json:"randomEvent,omitempty"` // 本次触发的随机事件
 }
 
 // CleanPetResponse 清洁响应
 type CleanPetResponse struct {
-	Cleanliness int `json:"cleanliness"` // 当前清洁度
+	Cleanliness  int  `json:"cleanliness"`  // 当前清洁度
+	SkillLevelUp bool `json:"skillLevelUp"` // 技能是否升级
+
+	RandomEvent *RandomEventDTO `json:"randomEvent,omitempty"` // 本次触发的随机事件
+}
+
+// RandomEventDTO 随机事件
+type RandomEventDTO struct {
+	ID         int       `json:"id"`
+	PetID      int       `json:"petId"`
+	EventID    string    `json:"eventId"`
+	Name       string    `json:"name"`
+	Message    string    `json:"message"`
+	Effect     string    `json:"effect"`           // coins, item, status
+	ItemID     int       `json:"itemId,omitempty"` // 道具效果获得的道具
+	Status     string    `json:"status,omitempty"` // 状态效果影响的状态
+	Amount     int       `json:"amount"`           // 金币数/道具数/状态变化量
+	OccurredAt time.Time `json:"occurredAt"`
+}
+
+// RandomEventListResponse 随机事件记录列表
+type RandomEventListResponse struct {
+	Events []RandomEventDTO `json:"events"`
+}
+
+// WarmEggResponse 保温响应
+type WarmEggResponse struct {
+	PetID            int       `json:"petId"`
+	HatchAt          time.Time `json:"hatchAt"`          // 预计孵化时间
+	RemainingSeconds int64     `json:"remainingSeconds"` // 距离可孵化的秒数
+	CanHatch         bool      `json:"canHatch"`         // 是否已可孵化
+	NextWarmAt       time.Time `json:"nextWarmAt"`       // 下次可保温时间
+}
+
+// HatchPetResponse 孵化响应
+type HatchPetResponse struct {
+	Pet PetDetailDTO `json:"pet"`
 }
 
 // PetDetailDTO 宠物详情DTO
@@ -62,8 +104,10 @@ type PetDetailDTO struct {
 	// 性格
 	Personality PersonalityDTO `json:"personality"`
 
-	// 技能
-	Skill SkillDTO `json:"skill"`
+	// 技能与特殊能力
+	Skill          SkillDTO     `json:"skill"`
+	SecondarySkill *SkillDTO    `json:"secondarySkill,omitempty"` // 副技能（成长期解锁）
+	Abilities      []AbilityDTO `json:"abilities"`                // 特殊能力（最多两个）
 
 	// 成长
 	Stage     string `json:"stage"`     // 阶段名称
@@ -71,11 +115,57 @@ type PetDetailDTO struct {
 	Exp       int    `json:"exp"`       // 当前经验
 	ExpToNext int    `json:"expToNext"` // 升级所需经验
 
+	// 进化形态（进入成熟期时由基因进化倾向和养育记录决定）
+	Form     string         `json:"form,omitempty"`
+	FormName string         `json:"formName,omitempty"`
+	Care     CareHistoryDTO `json:"care"`
+
+	// 孵化（蛋为预计孵化时间，已孵化为实际孵化时间）
+	HatchAt *time.Time `json:"hatchAt,omitempty"`
+
+	// 寿终时间（进入老年期后才有）
+	PassesAt *time.Time `json:"passesAt,omitempty"`
+
 	// 状态
 	Status StatusDTO `json:"status"`
 
-	// 基因码（可选，用于展示独特性）
+	// 装饰
+	Decorations []DecorationDTO `json:"decorations"` // 穿戴中的装饰
+	DecayBonus  DecayBonusDTO   `json:"decayBonus"`  // 装饰带来的状态衰减减免（百分比）
+
+	// 基因码（可选，用于展示独特性），没有基因扫描仪时隐藏片段用 * 代替
 	GeneCode string `json:"geneCode,omitempty"`
+
+	// 孟德尔性状（物种启用孟德尔遗传时才有）
+	Genetics []GenotypeDTO `json:"genetics,omitempty"`
+}
+
+// GenotypeDTO 孟德尔性状组的基因型与表现型
+type GenotypeDTO struct {
+	Trait     string `json:"trait"`              // 性状组标识，如 pattern
+	TraitName string `json:"traitName"`          // 性状组名称
+	Genotype  string `json:"genotype,omitempty"` // 基因型记号：显性大写、隐性小写，如 Aa
+	Phenotype int    `json:"phenotype"`          // 表现出来的等位基因（十六进制值 0-15）
+	Hidden    int    `json:"hidden"`             // 未表现的等位基因
+	Dominant  bool   `json:"dominant"`           // 表现型是否为显性
+	Carrier   bool   `json:"carrier"`            // 是否携带隐性等位基因（表现为显性，可能隔代遗传给后代）
+	Masked    bool   `json:"masked"`             // 没有基因扫描仪时被遮蔽，genotype、hidden、carrier 不返回真实值
+}
+
+// DecorationDTO 装饰DTO
+type DecorationDTO struct {
+	Slot       string    `json:"slot"`
+	SlotName   string    `json:"slotName"`
+	ItemID     int       `json:"itemId"`
+	Name       string    `json:"name"`
+	EquippedAt time.Time `json:"equippedAt"`
+}
+
+// DecayBonusDTO 衰减减免DTO
+type DecayBonusDTO struct {
+	Hunger      int `json:"hunger"`
+	Happiness   int `json:"happiness"`
+	Cleanliness int `json:"cleanliness"`
 }
 
 // AppearanceDTO 外观DTO
@@ -100,20 +190,34 @@ type PersonalityDTO struct {
 type SkillDTO struct {
 	Name        string `json:"name"`
 	Level       int    `json:"level"`
+	Exp         int    `json:"exp"`       // 当前等级已积累的技能经验
+	ExpToNext   int    `json:"expToNext"` // 升级所需技能经验，满级为0
 	Rarity      string `json:"rarity"`
 	Description string `json:"description"`
 }
 
+// AbilityDTO 特殊能力DTO
+type AbilityDTO struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
 // StatusDTO 状态DTO
 type StatusDTO struct {
-	Hunger      int  `json:"hunger"`
-	Happiness   int  `json:"happiness"`
-	Cleanliness int  `json:"cleanliness"`
-	Energy      int  `json:"energy"`
-	IsHungry    bool `json:"isHungry"`
-	IsUnhappy   bool `json:"isUnhappy"`
-	IsDirty     bool `json:"isDirty"`
-	IsTired     bool `json:"isTired"`
+	Hunger      int        `json:"hunger"`
+	Happiness   int        `json:"happiness"`
+	Cleanliness int        `json:"cleanliness"`
+	Energy      int        `json:"energy"`
+	IsHungry    bool       `json:"isHungry"`
+	IsUnhappy   bool       `json:"isUnhappy"`
+	IsDirty     bool       `json:"isDirty"`
+	IsTired     bool       `json:"isTired"`
+	IsSick      bool       `json:"isSick"`
+	Illness     string     `json:"illness"`         // 疾病名称，健康时为"健康"
+	IllAt       *time.Time `json:"illAt,omitempty"` // 生病时间
+	Mood        string     `json:"mood"`            // 情绪：content, happy, excited, grumpy, sulking, sleepy
+	MoodName    string     `json:"moodName"`        // 情绪名称
+	MoodCalm    int        `json:"moodCalm"`        // 闹情绪时还需安抚的次数
 }
 
 // PetSimpleDTO 宠物简要信息（用于列表、拜访等）
@@ -127,26 +231,41 @@ type PetSimpleDTO struct {
 
 // PetStatusDTO 宠物轻量状态 DTO
 type PetStatusDTO struct {
-	Hunger          int       `json:"hunger"`
-	Happiness       int       `json:"happiness"`
-	Cleanliness     int       `json:"cleanliness"`
-	Energy          int       `json:"energy"`
-	IsHungry        bool      `json:"isHungry"`
-	IsUnhappy       bool      `json:"isUnhappy"`
-	IsDirty         bool      `json:"isDirty"`
-	IsTired         bool      `json:"isTired"`
-	StatusUpdatedAt time.Time `json:"statusUpdatedAt"`
-	Revision        int64     `json:"revision"`
-	ServerTime      time.Time `json:"serverTime"`
+	Hunger          int        `json:"hunger"`
+	Happiness       int        `json:"happiness"`
+	Cleanliness     int        `json:"cleanliness"`
+	Energy          int        `json:"energy"`
+	IsHungry        bool       `json:"isHungry"`
+	IsUnhappy       bool       `json:"isUnhappy"`
+	IsDirty         bool       `json:"isDirty"`
+	IsTired         bool       `json:"isTired"`
+	IsSick          bool       `json:"isSick"`
+	Illness         string     `json:"illness"`           // 疾病名称，健康时为"健康"
+	IllAt           *time.Time `json:"illAt,omitempty"`   // 生病时间
+	Mood            string     `json:"mood"`              // 情绪：content, happy, excited, grumpy, sulking, sleepy
+	MoodName        string     `json:"moodName"`          // 情绪名称
+	MoodCalm        int        `json:"moodCalm"`          // 闹情绪时还需安抚的次数
+	HatchAt         *time.Time `json:"hatchAt,omitempty"` // 孵化时间（蛋为预计时间）
+	CanHatch        bool       `json:"canHatch"`          // 蛋是否已可孵化
+	HatchRemaining  int64      `json:"hatchRemaining"`    // 距离可孵化的秒数
+	StatusUpdatedAt time.Time  `json:"statusUpdatedAt"`
+	Revision        int64      `json:"revision"`
+	ServerTime      time.Time  `json:"serverTime"`
 }
 
 // --- 繁殖相关 DTO ---
 
 // BreedPetsRequest 繁殖请求
 type BreedPetsRequest struct {
-	Parent1ID int    `json:"parent1Id" binding:"required"` // 父母1 ID
-	Parent2ID int    `json:"parent2Id"`                    // 父母2 ID（可选，不填为分裂繁殖）
-	ChildName string `json:"childName" binding:"required"` // 后代名称
+	Parent1ID int    `json:"parent1Id" binding:"required"`              // 父母1 ID
+	Parent2ID int    `json:"parent2Id" binding:"required"`              // 父母2 ID
+	ChildName string `json:"childName" binding:"required,min=1,max=20"` // 后代名称
+}
+
+// SelfBreedRequest 分裂繁殖请求
+type SelfBreedRequest struct {
+	ParentID  int    `json:"parentId" binding:"required"`               // 亲本 ID
+	ChildName string `json:"childName" binding:"required,min=1,max=20"` // 后代名称
 }
 
 // BreedPetsResponse 繁殖响应
@@ -156,25 +275,45 @@ type BreedPetsResponse struct {
 	Mutations      []string              `json:"mutations"`      // 发生的变异
 	Parent1Updated PetBreedingStatusDTO  `json:"parent1Updated"` // 父母1更新后状态
 	Parent2Updated *PetBreedingStatusDTO `json:"parent2Updated"` // 父母2更新后状态（分裂繁殖时为nil）
+	Inbreeding     InbreedingDTO         `json:"inbreeding"`     // 近亲程度及对后代的影响
+}
+
+// InbreedingDTO 近亲程度
+type InbreedingDTO struct {
+	Coefficient       float64 `json:"coefficient"`       // 近交系数（0-1）：全同胞或亲子为 0.25，半同胞为 0.125
+	Warning           bool    `json:"warning"`           // 是否需要提醒玩家
+	Blocked           bool    `json:"blocked"`           // 是否禁止繁殖
+	ExtraMutation     int     `json:"extraMutation"`     // 后代额外的突变率（百分比）
+	ResistancePenalty int     `json:"resistancePenalty"` // 后代抗性基因降低的值
 }
 
 // PetBreedingStatusDTO 宠物繁殖状态
 type PetBreedingStatusDTO struct {
-	ID              int `json:"id"`
-	BreedingCount   int `json:"breedingCount"`   // 繁殖次数
-	RemainingBreeds int `json:"remainingBreeds"` // 剩余可繁殖次数
+	ID              int        `json:"id"`
+	BreedingCount   int        `json:"breedingCount"`         // 繁殖次数
+	RemainingBreeds int        `json:"remainingBreeds"`       // 剩余可繁殖次数
+	CooldownSeconds int64      `json:"cooldownSeconds"`       // 繁殖冷却剩余秒数
+	NextBreedAt     *time.Time `json:"nextBreedAt,omitempty"` // 冷却结束时间
+}
+
+// CanBreedRequest 是否可繁殖请求（查询参数）
+type CanBreedRequest struct {
+	Parent1ID int `form:"parent1Id" binding:"required"` // 父母1 ID
+	Parent2ID int `form:"parent2Id"`                    // 父母2 ID（可选，不填为检查分裂繁殖）
 }
 
 // CanBreedResponse 是否可繁殖响应
 type CanBreedResponse struct {
-	CanBreed bool   `json:"canBreed"`
-	Reason   string `json:"reason,omitempty"` // 不能繁殖的原因
+	CanBreed        bool       `json:"canBreed"`
+	Reason          string     `json:"reason,omitempty"`      // 不能繁殖的原因
+	CooldownSeconds int64      `json:"cooldownSeconds"`       // 繁殖冷却剩余秒数（取双亲中较长者）
+	NextBreedAt     *time.Time `json:"nextBreedAt,omitempty"` // 冷却结束时间
 }
 
 // PredictOffspringRequest 预测后代请求
 type PredictOffspringRequest struct {
 	Parent1ID int `json:"parent1Id" binding:"required"`
-	Parent2ID int `json:"parent2Id"`
+	Parent2ID int `json:"parent2Id"` // 可选，不填为分裂繁殖
 }
 
 // SpeciesProbabilityDTO 物种概率
@@ -182,11 +321,149 @@ type SpeciesProbabilityDTO struct {
 	SpeciesID   string  `json:"speciesId"`
 	SpeciesName string  `json:"speciesName"`
 	Probability float64 `json:"probability"` // 0-1
+	IsHidden    bool    `json:"isHidden"`    // 是否为隐藏物种
 }
 
 // PredictOffspringResponse 预测后代响应
 type PredictOffspringResponse struct {
 	PossibleSpecies []SpeciesProbabilityDTO `json:"possibleSpecies"`
+	Inbreeding      InbreedingDTO           `json:"inbreeding"` // 近亲程度，warning 为 true 时客户端应提醒玩家
+}
+
+// --- 繁殖契约相关 DTO ---
+
+// ProposeBreedingContractRequest 发起繁殖契约请求
+type ProposeBreedingContractRequest struct {
+	MyPetID      int    `json:"myPetId" binding:"required"`                // 我方宠物ID
+	PartnerPetID int    `json:"partnerPetId" binding:"required"`           // 好友宠物ID
+	Assignment   int    `json:"assignment" binding:"oneof=0 1 2"`          // 后代归属：0归发起方 1归接受方 2双方各一只
+	ChildName    string `json:"childName" binding:"required,min=1,max=20"` // 后代名称
+}
+
+// AcceptBreedingContractRequest 接受繁殖契约请求
+type AcceptBreedingContractRequest struct {
+	ChildName string `json:"childName" binding:"omitempty,max=20"` // 接受方为自己那只后代取的名字（可选）
+}
+
+// BreedingContractDTO 繁殖契约
+type BreedingContractDTO struct {
+	ID             int        `json:"id"`
+	ProposerID     int        `json:"proposerId"`
+	ProposerPetID  int        `json:"proposerPetId"`
+	PartnerID      int        `json:"partnerId"`
+	PartnerPetID   int        `json:"partnerPetId"`
+	Assignment     int        `json:"assignment"`
+	AssignmentName string     `json:"assignmentName"`
+	Status         int        `json:"status"`
+	StatusName     string     `json:"statusName"`
+	OffspringIDs   []int      `json:"offspringIds"`
+	CreatedAt      time.Time  `json:"createdAt"`
+	ExpiresAt      time.Time  `json:"expiresAt"`
+	RespondedAt    *time.Time `json:"respondedAt,omitempty"`
+}
+
+// BreedingContractListResponse 繁殖契约列表响应
+type BreedingContractListResponse struct {
+	Contracts []BreedingContractDTO `json:"contracts"`
+}
+
+// AcceptBreedingContractResponse 接受繁殖契约响应
+type AcceptBreedingContractResponse struct {
+	Contract   BreedingContractDTO `json:"contract"`
+	Offspring  []PetDetailDTO      `json:"offspring"`  // 本窝全部后代
+	Inbreeding InbreedingDTO       `json:"inbreeding"` // 近亲程度及对后代的影响
+}
+
+// --- 领养市场相关 DTO ---
+
+// ListPetForAdoptionRequest 挂单领养请求
+type ListPetForAdoptionRequest struct {
+	PetID       int `json:"petId" binding:"required"`               // 宠物ID
+	Currency    int `json:"currency" binding:"oneof=0 1"`           // 标价货币：0金币 1钻石（赠送时忽略）
+	Price       int `json:"price" binding:"min=0"`                  // 标价（赠送时忽略）
+	RecipientID int `json:"recipientId,omitempty" binding:"min=0"` // 赠送对象（好友ID），填写则为赠送
+}
+
+// AdoptionMarketRequest 领养市场查询请求
+type AdoptionMarketRequest struct {
+	Page     int `form:"page" binding:"omitempty,min=1"`
+	PageSize int `form:"pageSize" binding:"omitempty,min=1,max=100"`
+}
+
+// AdoptionPetDTO 挂单宠物概要
+type AdoptionPetDTO struct {
+	Name       string `json:"name"`
+	SpeciesID  int    `json:"speciesId"`
+	Gender     string `json:"gender"`
+	Stage      string `json:"stage"`
+	Level      int    `json:"level"`
+	Generation int    `json:"generation"`
+}
+
+// AdoptionListingDTO 领养挂单
+type AdoptionListingDTO struct {
+	ID           int             `json:"id"`
+	PetID        int             `json:"petId"`
+	SellerID     int             `json:"sellerId"`
+	Currency     int             `json:"currency"`
+	CurrencyName string          `json:"currencyName"`
+	Price        int             `json:"price"`
+	RecipientID  *int            `json:"recipientId,omitempty"`
+	BuyerID      *int            `json:"buyerId,omitempty"`
+	Status       int             `json:"status"`
+	StatusName   string          `json:"statusName"`
+	Pet          *AdoptionPetDTO `json:"pet,omitempty"`
+	CreatedAt    time.Time       `json:"createdAt"`
+	CompletedAt  *time.Time      `json:"completedAt,omitempty"`
+}
+
+// AdoptionListResponse 领养挂单列表响应
+type AdoptionListResponse struct {
+	Listings []AdoptionListingDTO `json:"listings"`
+}
+
+// AdoptPetResponse 领养响应
+type AdoptPetResponse struct {
+	Listing AdoptionListingDTO `json:"listing"`
+	Pet     PetDetailDTO       `json:"pet"` // 领养到的宠物
+}
+
+// OwnershipRecordDTO 易主记录
+type OwnershipRecordDTO struct {
+	FromUserID    int       `json:"fromUserId"`
+	ToUserID      int       `json:"toUserId"`
+	Method        int       `json:"method"`
+	MethodName    string    `json:"methodName"`
+	Currency      int       `json:"currency"`
+	CurrencyName  string    `json:"currencyName"`
+	Price         int       `json:"price"`
+	TransferredAt time.Time `json:"transferredAt"`
+}
+
+// OwnershipHistoryResponse 宠物历任主人响应
+type OwnershipHistoryResponse struct {
+	PetID          int                  `json:"petId"`
+	CurrentOwnerID int                  `json:"currentOwnerId"`
+	Records        []OwnershipRecordDTO `json:"records"` // 按时间先后排列
+}
+
+// --- 好友照顾相关 DTO ---
+
+// FriendCareResponse 照顾好友宠物响应
+type FriendCareResponse struct {
+	PetID          int    `json:"petId"`
+	Action         string `json:"action"`     // feed, play, clean
+	ActionName     string `json:"actionName"` // 喂食、玩耍、清洁
+	Hunger         int    `json:"hunger"`
+	Happiness      int    `json:"happiness"`
+	Cleanliness    int    `json:"cleanliness"`
+	Energy         int    `json:"energy"`
+	LevelUp        bool   `json:"levelUp"`
+	IntimacyGained int    `json:"intimacyGained"` // 增加的亲密度
+	Intimacy       int    `json:"intimacy"`       // 当前亲密度
+	RemainingToday int    `json:"remainingToday"` // 今天还能帮这位好友照顾的次数
+
+	RandomEvent *RandomEventDTO `json:"randomEvent,omitempty"` // 本次触发的随机事件（奖励归好友）
 }
 
 // --- 物种相关 DTO ---
@@ -220,3 +497,196 @@ type ScoreBreakdown struct {
 	GenerationScore int `json:"generationScore"`
 }
 
+// --- 纪念馆相关 DTO ---
+
+// MemorialDTO 纪念记录
+type MemorialDTO struct {
+	ID           int        `json:"id"`
+	PetID        int        `json:"petId"`
+	Name         string     `json:"name"`
+	SpeciesID    int        `json:"speciesId"`
+	Gender       string     `json:"gender"`
+	Stage        string     `json:"stage"`
+	Level        int        `json:"level"`
+	SkillName    string     `json:"skillName"`
+	SkillLevel   int        `json:"skillLevel"`
+	Generation   int        `json:"generation"`
+	Parent1ID    *int       `json:"parent1Id,omitempty"`
+	Parent2ID    *int       `json:"parent2Id,omitempty"`
+	GeneCode     string     `json:"geneCode"`
+	Achievements []string   `json:"achievements"`
+	BornAt       time.Time  `json:"bornAt"`
+	HatchAt      *time.Time `json:"hatchAt,omitempty"`
+	PassedAt     time.Time  `json:"passedAt"`
+	LifeDays     int        `json:"lifeDays"`
+	Cause        string     `json:"cause"`     // 离开的原因：passed_on 寿终，released 放生
+	CauseName    string     `json:"causeName"` // 原因名称
+}
+
+// MemorialListResponse 纪念馆响应
+type MemorialListResponse struct {
+	Memorials []MemorialDTO `json:"memorials"`
+}
+
+// CareHistoryDTO 养育记录
+type CareHistoryDTO struct {
+	FeedCount          int `json:"feedCount"`
+	PlayCount          int `json:"playCount"`
+	CleanCount         int `json:"cleanCount"`
+	AverageHappiness   int `json:"averageHappiness"`   // 照顾时的平均快乐度
+	AverageCleanliness int `json:"averageCleanliness"` // 照顾时的平均清洁度
+}
+
+// --- 成长曲线相关 DTO ---
+
+// GrowthPreviewDTO 等级曲线预览
+type GrowthPreviewDTO struct {
+	PetID          int              `json:"petId"`
+	SpeciesID      int              `json:"speciesId"`
+	Stage          string           `json:"stage"`
+	Level          int              `json:"level"`
+	Exp            int              `json:"exp"`
+	ExpToNext      int              `json:"expToNext"`                // 升级所需经验
+	GrowthRate     float64          `json:"growthRate"`               // 基因成长速率，获得的经验按此倍数修正
+	NextStage      string           `json:"nextStage,omitempty"`      // 由等级决定的下一阶段，蛋和老年期为空
+	NextStageLevel int              `json:"nextStageLevel,omitempty"` // 进入下一阶段的等级
+	ExpToNextStage int              `json:"expToNextStage"`           // 距离下一阶段还需的经验（未计成长速率）
+	Stages         []GrowthStageDTO `json:"stages"`                   // 各阶段的进化等级
+	Levels         []GrowthLevelDTO `json:"levels"`                   // 从当前等级起的升级经验
+}
+
+// GrowthStageDTO 阶段进化等级
+type GrowthStageDTO struct {
+	Stage   string `json:"stage"`
+	Level   int    `json:"level"`
+	Reached bool   `json:"reached"`
+}
+
+// GrowthLevelDTO 等级升级经验
+type GrowthLevelDTO struct {
+	Level       int `json:"level"`
+	RequiredExp int `json:"requiredExp"` // 升到下一级所需经验
+}
+
+// --- 宠物栏相关 DTO ---
+
+// RosterPetDTO 宠物栏中的宠物
+type RosterPetDTO struct {
+	ID          int    `json:"id"`
+	Name        string `json:"name"`
+	SpeciesID   int    `json:"speciesId"`
+	Gender      string `json:"gender"`
+	Stage       string `json:"stage"`
+	Level       int    `json:"level"`
+	Generation  int    `json:"generation"`
+	RosterOrder int    `json:"rosterOrder"` // 排序，0 表示未排序
+	IsActive    bool   `json:"isActive"`    // 是否为主宠物
+	PetStatusDTO
+}
+
+// RosterResponse 宠物栏响应
+type RosterResponse struct {
+	Pets        []RosterPetDTO `json:"pets"`
+	Count       int            `json:"count"`       // 拥有的宠物数
+	Capacity    int            `json:"capacity"`    // 当前栏位上限
+	MaxCapacity int            `json:"maxCapacity"` // 可扩充到的最大栏位数
+	SlotPrice   int            `json:"slotPrice"`   // 扩充一个栏位的钻石价格
+}
+
+// RenamePetRequest 改名请求
+type RenamePetRequest struct {
+	Name string `json:"name" binding:"required,min=1,max=20"`
+}
+
+// RenamePetResponse 改名响应
+type RenamePetResponse struct {
+	PetID int    `json:"petId"`
+	Name  string `json:"name"`
+}
+
+// ReleasePetRequest 放生请求
+type ReleasePetRequest struct {
+	ConfirmName string `json:"confirmName" binding:"required"` // 需输入宠物名字确认
+}
+
+// ReleasePetResponse 放生响应
+type ReleasePetResponse struct {
+	PetID    int         `json:"petId"`
+	Memorial MemorialDTO `json:"memorial"`
+}
+
+// ReorderRosterRequest 宠物栏排序请求
+type ReorderRosterRequest struct {
+	PetIDs []int `json:"petIds" binding:"required,min=1"` // 全部宠物ID，按期望顺序排列
+}
+
+// ExpandRosterResponse 扩充宠物栏响应
+type ExpandRosterResponse struct {
+	Capacity    int `json:"capacity"`
+	MaxCapacity int `json:"maxCapacity"`
+	SlotPrice   int `json:"slotPrice"`
+	Diamonds    int `json:"diamonds"` // 剩余钻石
+}
+
+// --- 血统图谱相关 DTO ---
+
+// LineageNodeDTO 血统图谱中的宠物
+type LineageNodeDTO struct {
+	PetID      int    `json:"petId"`
+	Name       string `json:"name"`
+	SpeciesID  int    `json:"speciesId"`
+	Gender     string `json:"gender"`
+	OwnerID    int    `json:"ownerId"`
+	OwnerName  string `json:"ownerName"`
+	Generation int    `json:"generation"`
+	Depth      int    `json:"depth"`           // 相对查询宠物的代差：祖先为负，后代为正
+	ParentIDs  []int  `json:"parentIds"`       // 亲本ID，分裂繁殖时只有一位
+	Departed   bool   `json:"departed"`        // 是否已离开（寿终或放生）
+	Cause      string `json:"cause,omitempty"` // 离开的原因：passed_on 寿终，released 放生
+}
+
+// LineageEdgeDTO 亲子关系
+type LineageEdgeDTO struct {
+	ParentID int `json:"parentId"`
+	ChildID  int `json:"childId"`
+}
+
+// LineageResponse 血统图谱响应
+type LineageResponse struct {
+	RootID      int              `json:"rootId"`
+	Generations int              `json:"generations"` // 向上追溯的祖先代数
+	Nodes       []LineageNodeDTO `json:"nodes"`       // 按代差排序
+	Edges       []LineageEdgeDTO `json:"edges"`
+	Truncated   bool             `json:"truncated"` // 家族过大时省略了部分后代
+}
+
+// GenomeResponse 基因组报告
+type GenomeResponse struct {
+	PetID      int                `json:"petId"`
+	Name       string             `json:"name"`
+	SpeciesID  int                `json:"speciesId"`
+	GeneCode   string             `json:"geneCode"`   // 基因码，被遮蔽的位用 * 代替
+	HasScanner bool               `json:"hasScanner"` // 是否有基因扫描仪（可查看隐藏片段）
+	PoolSize   int                `json:"poolSize"`   // 计算百分位时参与统计的宠物数
+	Segments   []GenomeSegmentDTO `json:"segments"`   // 五个基因片段
+}
+
+// GenomeSegmentDTO 基因片段
+type GenomeSegmentDTO struct {
+	Key    string           `json:"key"`    // appearance, species, personality, skill, hidden
+	Name   string           `json:"name"`   // 片段名称
+	Start  int              `json:"start"`  // 起始基因位
+	End    int              `json:"end"`    // 结束基因位（不含）
+	Hidden bool             `json:"hidden"` // 是否为隐藏片段
+	Masked bool             `json:"masked"` // 是否被遮蔽（隐藏片段且没有基因扫描仪）
+	Traits []GenomeTraitDTO `json:"traits"` // 逐位解读，被遮蔽时为空
+}
+
+// GenomeTraitDTO 单个基因位的解读
+type GenomeTraitDTO struct {
+	Position   int    `json:"position"`   // 基因位（0-39）
+	Name       string `json:"name"`       // 含义，物种特征位为解释器给出的部位
+	Raw        int    `json:"raw"`        // 原始值（0-15）
+	Meaning    string `json:"meaning"`    // 解读结果
+	Percentile int    `json:"percentile"` // 在全部宠物中的百分位（0-100）
+}
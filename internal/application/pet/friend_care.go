@@ -0,0 +1,193 @@
+// Package pet 宠物应用服务
+// 好友照顾 - 拜访时帮好友喂食、玩耍、清洁
+package pet
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"pets-server/internal/domain/item"
+	"pets-server/internal/domain/pet"
+	"pets-server/internal/domain/social"
+	"pets-server/internal/domain/user"
+)
+
+// ============================================================
+// 照顾好友宠物 (POST /api/pet/friends/:userId/feed|play|clean)
+// 调用链路：
+//   Handler.FeedFriendPet() / PlayWithFriendPet() / CleanFriendPet()
+//     → AppService.careForFriendPet()
+//       → UoW.Do() 开启事务
+//         → FriendRepo.FindByUsers() 以好友关系代替主人校验
+//         → user.LockPair() 锁定照顾者和好友，串行化计数与随机事件奖励
+//         → InteractionRepo.CountToday() 每天对同一好友的照顾次数有上限
+//         → getActivePet() 获取好友的主宠物并补算状态
+//         → Pet.Feed() / Play() / Clean() 复用主人照顾的领域逻辑
+//         → Friendship.AddIntimacy() 按宠物 LoyaltyBonus 增加亲密度
+//         → 保存宠物、好友关系和互动记录
+//       → 事务提交
+//       → EventPublisher.Publish(PetCaredByFriendEvent) 通知主人
+// ============================================================
+
+// FeedFriendPet 用自己的食物喂好友的宠物
+func (s *Service) FeedFriendPet(ctx context.Context, userID, friendID int, req FeedPetRequest) (*FriendCareResponse, error) {
+	return s.careForFriendPet(ctx, userID, friendID, social.CareActionFeed, func(txCtx context.Context, p *pet.Pet) error {
+		itemDef, err := s.itemRepo.GetDefinition(txCtx, req.FoodItemID)
+		if err != nil {
+			return err
+		}
+		if itemDef.Type != item.ItemTypeFood {
+			return ErrInvalidFoodItem
+		}
+
+		// 食物从照顾者自己的背包扣除（锁定道具行，避免并发喂食重复扣减）
+		userItem, err := s.itemRepo.FindByUserAndItemForUpdate(txCtx, userID, req.FoodItemID)
+		if err != nil {
+			return err
+		}
+		if err := userItem.Consume(1); err != nil {
+			return err
+		}
+		if _, err := p.Feed(pet.FoodType(itemDef.EffectValue)); err != nil {
+			return err
+		}
+		return s.saveUserItem(txCtx, userItem)
+	})
+}
+
+// PlayWithFriendPet 陪好友的宠物玩耍
+func (s *Service) PlayWithFriendPet(ctx context.Context, userID, friendID int) (*FriendCareResponse, error) {
+	return s.careForFriendPet(ctx, userID, friendID, social.CareActionPlay, func(_ context.Context, p *pet.Pet) error {
+		_, err := p.Play()
+		return err
+	})
+}
+
+// CleanFriendPet 帮好友的宠物清洁
+func (s *Service) CleanFriendPet(ctx context.Context, userID, friendID int) (*FriendCareResponse, error) {
+	return s.careForFriendPet(ctx, userID, friendID, social.CareActionClean, func(_ context.Context, p *pet.Pet) error {
+		return p.Clean()
+	})
+}
+
+// careForFriendPet 照顾好友宠物的公共流程
+func (s *Service) careForFriendPet(
+	ctx context.Context,
+	userID, friendID int,
+	action social.CareAction,
+	apply func(txCtx context.Context, p *pet.Pet) error,
+) (*FriendCareResponse, error) {
+	if userID == friendID {
+		return nil, ErrCareOwnPet
+	}
+
+	var response *FriendCareResponse
+	var events []any
+	var randomEvent *pet.RandomEvent
+
+	err := s.uow.Do(ctx, func(txCtx context.Context) error {
+		now := time.Now()
+
+		// 1. 以好友关系代替主人校验
+		friendship, err := social.FindFriendship(txCtx, s.friendRepo, userID, friendID)
+		if err != nil {
+			return err
+		}
+
+		// 2. 每天对同一好友的照顾次数有上限
+		// 先锁定照顾者，避免并发请求读到相同的计数而超出上限；
+		// 随机事件的金币发给好友，两人按ID顺序一起加锁，避免互相照顾时死锁
+		if _, _, err := user.LockPair(txCtx, s.userRepo, userID, friendID); err != nil {
+			return err
+		}
+		count, err := s.interactRepo.CountToday(txCtx, userID, friendID)
+		if err != nil {
+			return err
+		}
+		if count >= social.MaxDailyInteractionsPerFriend {
+			return ErrInteractionLimitReached
+		}
+
+		// 3. 获取好友的主宠物并补算状态
+		p, err := s.getActivePet(txCtx, friendID)
+		if err != nil {
+			if errors.Is(err, pet.ErrPetNotFound) {
+				return ErrPetNotFound
+			}
+			return err
+		}
+		p.ApplyStatusAt(now)
+		oldLevel := p.Level
+
+		// 4. 领域逻辑：喂食/玩耍/清洁，被好友照顾也锻炼友善技能
+		if err := apply(txCtx, p); err != nil {
+			return err
+		}
+		p.TrainSkill(pet.TrainingCaredByFriend)
+		randomEvent, err = s.rollRandomEvent(txCtx, p, now)
+		if err != nil {
+			return err
+		}
+		if err := s.petRepo.Save(txCtx, p); err != nil {
+			return err
+		}
+
+		// 5. 增加亲密度（好友宠物越忠诚，增加越多）
+		intimacy := social.CalculateCareIntimacy(p.Personality.LoyaltyBonus())
+		friendship.AddIntimacy(intimacy)
+		if err := s.friendRepo.Save(txCtx, friendship); err != nil {
+			return err
+		}
+
+		// 6. 记录互动
+		interaction := social.NewInteraction(userID, friendID, p.ID, action, intimacy)
+		if err := s.interactRepo.Save(txCtx, interaction); err != nil {
+			return err
+		}
+
+		events = append(p.Events(), pet.PetCaredByFriendEvent{
+			PetID:     p.ID,
+			UserID:    friendID,
+			FriendID:  userID,
+			Action:    string(action),
+			Timestamp: now,
+		})
+
+		response = &FriendCareResponse{
+			PetID:          p.ID,
+			Action:         string(action),
+			ActionName:     action.Name(),
+			Hunger:         p.Hunger,
+			Happiness:      p.Happiness,
+			Cleanliness:    p.Cleanliness,
+			Energy:         p.Energy,
+			LevelUp:        p.Level > oldLevel,
+			IntimacyGained: intimacy,
+			Intimacy:       friendship.Intimacy,
+			RemainingToday: social.MaxDailyInteractionsPerFriend - count - 1,
+		}
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	// 清除好友的缓存
+	if s.cache != nil {
+		_ = s.cache.DeletePetDetail(ctx, friendID)
+	}
+
+	s.publishEvents(ctx, events)
+	// 随机事件的奖励归好友，推送给好友
+	response.RandomEvent = s.notifyRandomEvent(randomEvent)
+
+	return response, nil
+}
+
+// 好友照顾相关错误
+var (
+	ErrCareOwnPet              = social.ErrCareOwnPet
+	ErrInteractionLimitReached = social.ErrInteractionLimitReached
+)
@@ -0,0 +1,102 @@
+// Package pet 宠物应用服务
+// 基因组报告 - 逐位解读宠物的基因并给出百分位，遗传隐藏片段需要基因扫描仪才能查看
+package pet
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"pets-server/internal/domain/item"
+	"pets-server/internal/domain/pet"
+)
+
+// genePoolCacheTTL 基因分布的缓存时间（百分位不要求实时）
+const genePoolCacheTTL = 10 * time.Minute
+
+// GetGenome 获取自己宠物的基因组报告
+func (s *Service) GetGenome(ctx context.Context, userID, petID int) (*GenomeResponse, error) {
+	p, err := s.findOwnedPet(ctx, userID, petID)
+	if err != nil {
+		return nil, err
+	}
+
+	hasScanner, err := s.hasGeneScanner(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	pool, err := s.genePool(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	report := s.petDomainSvc.InspectGenome(p, pool, hasScanner)
+
+	segments := make([]GenomeSegmentDTO, len(report.Segments))
+	for i, seg := range report.Segments {
+		traits := make([]GenomeTraitDTO, len(seg.Traits))
+		for j, t := range seg.Traits {
+			traits[j] = GenomeTraitDTO{
+				Position:   t.Position,
+				Name:       t.Name,
+				Raw:        t.Raw,
+				Meaning:    t.Meaning,
+				Percentile: t.Percentile,
+			}
+		}
+		segments[i] = GenomeSegmentDTO{
+			Key:    seg.Segment.Key,
+			Name:   seg.Segment.Name,
+			Start:  seg.Segment.Start,
+			End:    seg.Segment.End,
+			Hidden: seg.Segment.Hidden,
+			Masked: seg.Masked,
+			Traits: traits,
+		}
+	}
+
+	return &GenomeResponse{
+		PetID:      p.ID,
+		Name:       p.Name,
+		SpeciesID:  int(p.SpeciesID),
+		GeneCode:   report.Code,
+		HasScanner: hasScanner,
+		PoolSize:   report.PoolSize,
+		Segments:   segments,
+	}, nil
+}
+
+// hasGeneScanner 用户背包中是否有基因扫描仪（未配置扫描仪道具时都没有）
+func (s *Service) hasGeneScanner(ctx context.Context, userID int) (bool, error) {
+	if s.geneScannerItemID <= 0 {
+		return false, nil
+	}
+	userItem, err := s.itemRepo.FindByUserAndItem(ctx, userID, s.geneScannerItemID)
+	if err != nil {
+		if errors.Is(err, item.ErrItemNotFound) {
+			return false, nil
+		}
+		return false, err
+	}
+	return userItem.Quantity > 0, nil
+}
+
+// genePool 全部宠物的基因分布，优先读缓存
+func (s *Service) genePool(ctx context.Context) (*pet.GenePool, error) {
+	if s.cache != nil {
+		if pool, err := s.cache.GetGenePool(ctx); err == nil && pool != nil {
+			return pool, nil
+		}
+	}
+
+	pool, err := s.petRepo.GenePool(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if s.cache != nil {
+		_ = s.cache.SetGenePool(ctx, pool, genePoolCacheTTL)
+	}
+	return pool, nil
+}
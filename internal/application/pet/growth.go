@@ -0,0 +1,67 @@
+// Package pet 宠物应用服务
+// 成长曲线预览
+package pet
+
+import (
+	"context"
+	"errors"
+
+	"pets-server/internal/domain/pet"
+)
+
+// growthPreviewLevels 没有下一阶段时预览的等级数
+const growthPreviewLevels = 10
+
+// GetGrowthPreview 获取宠物的等级曲线预览
+// 升级经验和进化等级由物种决定，客户端据此展示"距离下一阶段还需多少经验"
+func (s *Service) GetGrowthPreview(ctx context.Context, userID, petID int) (*GrowthPreviewDTO, error) {
+	p, err := s.petRepo.FindByID(ctx, petID)
+	if err != nil {
+		if errors.Is(err, pet.ErrPetNotFound) {
+			return nil, ErrPetNotFound
+		}
+		return nil, err
+	}
+	if p.UserID != userID {
+		return nil, ErrNotPetOwner
+	}
+
+	result := &GrowthPreviewDTO{
+		PetID:      p.ID,
+		SpeciesID:  int(p.SpeciesID),
+		Stage:      p.StageName(),
+		Level:      p.Level,
+		Exp:        p.Exp,
+		ExpToNext:  p.RequiredExp(),
+		GrowthRate: p.Gene.GrowthRate(),
+	}
+
+	// 预览到下一阶段为止的升级经验
+	lastLevel := p.Level + growthPreviewLevels - 1
+	if next, remaining, ok := p.ExpToNextStage(); ok {
+		result.NextStage = next.Name()
+		result.NextStageLevel = p.Growth.StageLevel(next)
+		result.ExpToNextStage = remaining
+		lastLevel = result.NextStageLevel - 1
+		if lastLevel < p.Level {
+			lastLevel = p.Level
+		}
+	}
+
+	for _, stage := range []pet.Stage{pet.StageTeen, pet.StageAdult, pet.StageElderly} {
+		result.Stages = append(result.Stages, GrowthStageDTO{
+			Stage:   stage.Name(),
+			Level:   p.Growth.StageLevel(stage),
+			Reached: p.Stage >= stage,
+		})
+	}
+
+	for level := p.Level; level <= lastLevel; level++ {
+		result.Levels = append(result.Levels, GrowthLevelDTO{
+			Level:       level,
+			RequiredExp: p.Growth.RequiredExp(level),
+		})
+	}
+
+	return result, nil
+}
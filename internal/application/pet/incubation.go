@@ -0,0 +1,124 @@
+// Package pet 宠物应用服务
+// 孵化 - 蛋的保温与孵化
+package pet
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"pets-server/internal/domain/pet"
+)
+
+// ============================================================
+// 孵化流程：
+//   创建/繁殖时按物种 incubation_hours 设置预计孵化时间
+//   等待期间可缩短：
+//     → WarmEgg() 保温，每次缩短 30 分钟，有冷却
+//     → 道具 shorten_incubation（孵化器）按分钟缩短
+//   HatchPet() 到时间后孵化
+//     → Pet.Hatch() 进入幼年期，从此刻开始计算状态衰减
+//     → EventPublisher.Publish(PetHatchedEvent)
+// ============================================================
+
+// WarmEgg 给主宠物的蛋保温
+func (s *Service) WarmEgg(ctx context.Context, userID int) (*WarmEggResponse, error) {
+	var response *WarmEggResponse
+	var events []any
+
+	err := s.uow.Do(ctx, func(txCtx context.Context) error {
+		now := time.Now()
+
+		p, err := s.getActivePet(txCtx, userID)
+		if err != nil {
+			return err
+		}
+		if err := p.Warm(now); err != nil {
+			return err
+		}
+		if err := s.petRepo.Save(txCtx, p); err != nil {
+			return err
+		}
+
+		events = p.Events()
+		response = &WarmEggResponse{
+			PetID:            p.ID,
+			HatchAt:          p.HatchTime(),
+			RemainingSeconds: int64(p.IncubationRemaining(now).Seconds()),
+			CanHatch:         p.CanHatch(now),
+			NextWarmAt:       now.Add(pet.WarmCooldown),
+		}
+		return nil
+	})
+
+	if err != nil {
+		if errors.Is(err, pet.ErrPetNotFound) {
+			return nil, ErrPetNotFound
+		}
+		return nil, err
+	}
+
+	if s.cache != nil {
+		_ = s.cache.DeletePetDetail(ctx, userID)
+	}
+
+	s.publishEvents(ctx, events)
+
+	return response, nil
+}
+
+// HatchPet 孵化主宠物的蛋
+func (s *Service) HatchPet(ctx context.Context, userID int) (*HatchPetResponse, error) {
+	var response *HatchPetResponse
+	var events []any
+
+	err := s.uow.Do(ctx, func(txCtx context.Context) error {
+		p, err := s.getActivePet(txCtx, userID)
+		if err != nil {
+			return err
+		}
+		if err := p.Hatch(time.Now()); err != nil {
+			return err
+		}
+		if err := s.petRepo.Save(txCtx, p); err != nil {
+			return err
+		}
+
+		events = p.Events()
+		response = &HatchPetResponse{Pet: *s.toPetDetailDTO(p)}
+		return nil
+	})
+
+	if err != nil {
+		if errors.Is(err, pet.ErrPetNotFound) {
+			return nil, ErrPetNotFound
+		}
+		return nil, err
+	}
+
+	if s.cache != nil {
+		_ = s.cache.DeletePetDetail(ctx, userID)
+	}
+
+	s.publishEvents(ctx, events)
+
+	return response, nil
+}
+
+// hatchTimeOf 展示用的孵化时间
+// 蛋为预计孵化时间；已孵化但未记录孵化时间的老数据不展示
+func hatchTimeOf(p *pet.Pet) *time.Time {
+	if p.Stage != pet.StageEgg {
+		return p.HatchAt
+	}
+	hatchAt := p.HatchTime()
+	return &hatchAt
+}
+
+// 孵化相关错误
+var (
+	ErrPetNotEgg    = pet.ErrPetNotEgg
+	ErrEggNotReady  = pet.ErrEggNotReady
+	ErrEggReady     = pet.ErrEggReady
+	ErrWarmCooldown = pet.ErrWarmCooldown
+)
@@ -0,0 +1,216 @@
+// Package pet 宠物应用服务
+// 血统图谱 - 向上追溯祖先、向下列出全部后代，已离开的宠物从纪念记录中补全
+package pet
+
+import (
+	"context"
+	"errors"
+
+	"pets-server/internal/domain/pet"
+	"pets-server/internal/domain/user"
+)
+
+// GetLineage 获取宠物的血统图谱
+// generations 为向上追溯的祖先代数（默认 3，最多 10），后代全部列出
+func (s *Service) GetLineage(ctx context.Context, userID, petID, generations int) (*LineageResponse, error) {
+	g, generations, err := s.buildLineage(ctx, userID, petID, generations)
+	if err != nil {
+		return nil, err
+	}
+
+	nodes := g.Nodes()
+	ownerNames, err := s.lineageOwnerNames(ctx, nodes)
+	if err != nil {
+		return nil, err
+	}
+
+	nodeDTOs := make([]LineageNodeDTO, len(nodes))
+	for i, n := range nodes {
+		parentIDs := n.ParentIDs
+		if parentIDs == nil {
+			parentIDs = []int{}
+		}
+		nodeDTOs[i] = LineageNodeDTO{
+			PetID:      n.PetID,
+			Name:       n.Name,
+			SpeciesID:  int(n.SpeciesID),
+			Gender:     n.Gender.Name(),
+			OwnerID:    n.OwnerID,
+			OwnerName:  ownerNames[n.OwnerID],
+			Generation: n.Generation,
+			Depth:      n.Depth,
+			ParentIDs:  parentIDs,
+			Departed:   n.Departed,
+			Cause:      string(n.Cause),
+		}
+	}
+
+	edges := g.Edges()
+	edgeDTOs := make([]LineageEdgeDTO, len(edges))
+	for i, e := range edges {
+		edgeDTOs[i] = LineageEdgeDTO{ParentID: e.ParentID, ChildID: e.ChildID}
+	}
+
+	return &LineageResponse{
+		RootID:      g.RootID,
+		Generations: generations,
+		Nodes:       nodeDTOs,
+		Edges:       edgeDTOs,
+		Truncated:   g.Truncated(),
+	}, nil
+}
+
+// GetLineageDOT 获取 Graphviz DOT 格式的血统图谱
+func (s *Service) GetLineageDOT(ctx context.Context, userID, petID, generations int) (string, error) {
+	g, _, err := s.buildLineage(ctx, userID, petID, generations)
+	if err != nil {
+		return "", err
+	}
+	return g.DOT(), nil
+}
+
+// buildLineage 以宠物为中心构建血统图谱，返回实际使用的祖先代数
+// 查询的宠物须归当前用户所有（在世的，或纪念馆中的）
+func (s *Service) buildLineage(ctx context.Context, userID, petID, generations int) (*pet.LineageGraph, int, error) {
+	if generations <= 0 {
+		generations = pet.DefaultLineageDepth
+	}
+	if generations > pet.MaxLineageDepth {
+		generations = pet.MaxLineageDepth
+	}
+
+	root, found, err := s.findLineageNode(ctx, petID, 0)
+	if err != nil {
+		return nil, 0, err
+	}
+	if !found {
+		return nil, 0, ErrPetNotFound
+	}
+	if root.OwnerID != userID {
+		return nil, 0, ErrNotPetOwner
+	}
+
+	g := pet.NewLineageGraph(root)
+
+	// 1. 逐代向上追溯祖先
+	if err := s.addAncestors(ctx, g, []pet.LineageNode{root}, generations); err != nil {
+		return nil, 0, err
+	}
+
+	// 2. 逐代向下列出全部后代（在世的和已离开的）
+	frontier := []pet.LineageNode{root}
+	for depth := 1; len(frontier) > 0 && !g.Truncated(); depth++ {
+		var next []pet.LineageNode
+		for _, parent := range frontier {
+			children, err := s.findLineageChildren(ctx, parent.PetID, depth)
+			if err != nil {
+				return nil, 0, err
+			}
+			for _, child := range children {
+				if g.AddNode(child) {
+					next = append(next, child)
+				}
+				g.AddEdge(parent.PetID, child.PetID)
+			}
+		}
+		frontier = next
+	}
+
+	return g, generations, nil
+}
+
+// loadAncestry 加载繁殖双亲的祖先图谱，用于计算近交系数
+func (s *Service) loadAncestry(ctx context.Context, parent1, parent2 *pet.Pet) (*pet.LineageGraph, error) {
+	nodes := []pet.LineageNode{pet.LineageNodeFromPet(parent1, 0), pet.LineageNodeFromPet(parent2, 0)}
+	g := pet.NewLineageGraph(nodes[0])
+	g.AddNode(nodes[1])
+	if err := s.addAncestors(ctx, g, nodes, pet.InbreedingDepth); err != nil {
+		return nil, err
+	}
+	return g, nil
+}
+
+// addAncestors 从 frontier 出发逐代向上追溯 generations 代祖先并加入图谱
+func (s *Service) addAncestors(ctx context.Context, g *pet.LineageGraph, frontier []pet.LineageNode, generations int) error {
+	for depth := 1; depth <= generations && len(frontier) > 0; depth++ {
+		var next []pet.LineageNode
+		for _, child := range frontier {
+			for _, parentID := range child.ParentIDs {
+				if !g.Has(parentID) {
+					parent, found, err := s.findLineageNode(ctx, parentID, child.Depth-1)
+					if err != nil {
+						return err
+					}
+					if !found || !g.AddNode(parent) {
+						continue
+					}
+					next = append(next, parent)
+				}
+				g.AddEdge(parentID, child.PetID)
+			}
+		}
+		frontier = next
+	}
+	return nil
+}
+
+// findLineageNode 查找血统图谱中的宠物，已离开的从纪念记录中查找
+func (s *Service) findLineageNode(ctx context.Context, petID, depth int) (pet.LineageNode, bool, error) {
+	p, err := s.petRepo.FindByID(ctx, petID)
+	if err == nil {
+		return pet.LineageNodeFromPet(p, depth), true, nil
+	}
+	if !errors.Is(err, pet.ErrPetNotFound) {
+		return pet.LineageNode{}, false, err
+	}
+
+	m, err := s.memorialRepo.FindByPetID(ctx, petID)
+	if err == nil {
+		return pet.LineageNodeFromMemorial(m, depth), true, nil
+	}
+	if errors.Is(err, pet.ErrMemorialNotFound) {
+		return pet.LineageNode{}, false, nil
+	}
+	return pet.LineageNode{}, false, err
+}
+
+// findLineageChildren 查找宠物的全部子代（在世的和已离开的）
+func (s *Service) findLineageChildren(ctx context.Context, parentID, depth int) ([]pet.LineageNode, error) {
+	pets, err := s.petRepo.FindByParent(ctx, parentID)
+	if err != nil {
+		return nil, err
+	}
+	memorials, err := s.memorialRepo.FindByParent(ctx, parentID)
+	if err != nil {
+		return nil, err
+	}
+
+	children := make([]pet.LineageNode, 0, len(pets)+len(memorials))
+	for _, p := range pets {
+		children = append(children, pet.LineageNodeFromPet(p, depth))
+	}
+	for _, m := range memorials {
+		children = append(children, pet.LineageNodeFromMemorial(m, depth))
+	}
+	return children, nil
+}
+
+// lineageOwnerNames 查询图谱中各主人的昵称（用户已注销时留空）
+func (s *Service) lineageOwnerNames(ctx context.Context, nodes []pet.LineageNode) (map[int]string, error) {
+	names := make(map[int]string)
+	for _, n := range nodes {
+		if _, ok := names[n.OwnerID]; ok {
+			continue
+		}
+		u, err := s.userRepo.FindByID(ctx, n.OwnerID)
+		if err != nil {
+			if errors.Is(err, user.ErrUserNotFound) {
+				names[n.OwnerID] = ""
+				continue
+			}
+			return nil, err
+		}
+		names[n.OwnerID] = u.Nickname
+	}
+	return names, nil
+}
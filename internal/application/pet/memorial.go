@@ -0,0 +1,223 @@
+// Package pet 宠物应用服务
+// 寿终与纪念馆
+package pet
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"pets-server/internal/domain/item"
+	"pets-server/internal/domain/pet"
+)
+
+// ============================================================
+// 寿终流程（由定时任务调用）：
+//   进入老年期时按 物种寿命 × 基因寿命修正 确定寿终时间
+//   ExpiredPetIDs() 按ID游标分批获取寿命已尽的宠物
+//   PassOnPet() 逐只处理，失败的宠物由定时任务记录日志后跳过
+//     → UoW.Do() 每只宠物单独开启事务
+//       → PetRepo.LockPassedAway() 加锁获取并确认宠物仍寿命已尽
+//       → Pet.PassOn() 生成纪念记录（保留数据、血统和生平成就）
+//       → 装饰退回背包，撤回领养挂单，拒绝待处理的繁殖契约，发放纪念品
+//       → 删除宠物，主宠物回退到下一只
+//     → 清除缓存，发布 PetPassedOnEvent
+//   定时任务随后通知在线的主人
+// ============================================================
+
+// ExpiredPetIDs 按ID游标获取一批寿命已尽的宠物ID（不加锁，由 PassOnPet 逐只加锁处理）
+func (s *Service) ExpiredPetIDs(ctx context.Context, afterID, limit int) ([]int, error) {
+	return s.petRepo.FindPassedAwayIDs(ctx, time.Now(), afterID, limit)
+}
+
+// PassOnPet 处理一只寿命已尽的宠物，返回生成的纪念记录
+// 每只宠物单独一个事务，一只处理失败不影响其他宠物；宠物加锁读取，
+// 已被其他实例处理或不再满足寿终条件时返回 nil
+func (s *Service) PassOnPet(ctx context.Context, petID int) (*pet.Memorial, error) {
+	var memorial *pet.Memorial
+	var events []any
+
+	err := s.uow.Do(ctx, func(txCtx context.Context) error {
+		now := time.Now()
+
+		p, err := s.petRepo.LockPassedAway(txCtx, petID, now)
+		if err != nil {
+			if errors.Is(err, pet.ErrPetNotFound) {
+				return nil
+			}
+			return err
+		}
+
+		memorial, events, err = s.passOn(txCtx, p, now)
+		return err
+	})
+
+	if err != nil || memorial == nil {
+		return nil, err
+	}
+
+	if s.cache != nil {
+		_ = s.cache.DeletePetDetail(ctx, memorial.UserID)
+	}
+
+	s.publishEvents(ctx, events)
+
+	return memorial, nil
+}
+
+// passOn 宠物寿终：保存纪念记录并清理宠物的关联数据
+func (s *Service) passOn(ctx context.Context, p *pet.Pet, now time.Time) (*pet.Memorial, []any, error) {
+	memorial, err := p.PassOn(now)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := s.memorialRepo.Save(ctx, memorial); err != nil {
+		return nil, nil, err
+	}
+	events := p.Events()
+
+	// 发放纪念品
+	if err := s.giveKeepsake(ctx, p.UserID); err != nil {
+		return nil, nil, err
+	}
+
+	removeEvents, err := s.removePet(ctx, p, now)
+	if err != nil {
+		return nil, nil, err
+	}
+	return memorial, append(events, removeEvents...), nil
+}
+
+// removePet 宠物离开主人（寿终或放生）：装饰退回背包，撤回领养挂单，拒绝待处理的繁殖契约，
+// 删除宠物，主宠物回退到下一只
+func (s *Service) removePet(ctx context.Context, p *pet.Pet, now time.Time) ([]any, error) {
+	var events []any
+
+	// 装饰退回主人背包
+	if err := s.returnDecorations(ctx, p.ID, p.UserID); err != nil {
+		return nil, err
+	}
+
+	// 撤回领养挂单
+	listing, err := s.adoptionRepo.FindOpenByPet(ctx, p.ID)
+	if err == nil {
+		if err := listing.Cancel(p.UserID); err != nil {
+			return nil, err
+		}
+		if err := s.adoptionRepo.Save(ctx, listing); err != nil {
+			return nil, err
+		}
+		events = append(events, listing.Events()...)
+	} else if !errors.Is(err, pet.ErrAdoptionNotFound) {
+		return nil, err
+	}
+
+	// 拒绝待处理的繁殖契约（已过期的标记为过期）
+	contracts, err := s.contractRepo.FindPendingByPet(ctx, p.ID)
+	if err != nil {
+		return nil, err
+	}
+	for _, c := range contracts {
+		if c.IsExpired(now) {
+			c.MarkExpired()
+		} else if err := c.Reject(); err != nil {
+			return nil, err
+		}
+		if err := s.contractRepo.Save(ctx, c); err != nil {
+			return nil, err
+		}
+		events = append(events, c.Events()...)
+	}
+
+	if err := s.petRepo.Delete(ctx, p.ID); err != nil {
+		return nil, err
+	}
+
+	// 主宠物回退到下一只（没有则清空），锁定用户行避免覆盖并发写入
+	u, err := s.userRepo.FindByIDForUpdate(ctx, p.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if u.ActivePetID != nil && *u.ActivePetID == p.ID {
+		u.ActivePetID = nil
+		next, err := s.petRepo.FindByUserID(ctx, p.UserID)
+		if err == nil {
+			u.ActivePetID = &next.ID
+		} else if !errors.Is(err, pet.ErrPetNotFound) {
+			return nil, err
+		}
+		if err := s.userRepo.Save(ctx, u); err != nil {
+			return nil, err
+		}
+	}
+
+	return events, nil
+}
+
+// giveKeepsake 向主人发放纪念品，未配置或道具不存在时跳过
+func (s *Service) giveKeepsake(ctx context.Context, userID int) error {
+	if s.keepsakeItemID <= 0 {
+		return nil
+	}
+	if _, err := s.itemRepo.GetDefinition(ctx, s.keepsakeItemID); err != nil {
+		if errors.Is(err, item.ErrItemNotFound) {
+			return nil
+		}
+		return err
+	}
+	return s.giveItem(ctx, userID, s.keepsakeItemID, 1)
+}
+
+// KeepsakeItemID 纪念品道具ID
+func (s *Service) KeepsakeItemID() int {
+	return s.keepsakeItemID
+}
+
+// GetMemorials 获取我的纪念馆
+func (s *Service) GetMemorials(ctx context.Context, userID int) (*MemorialListResponse, error) {
+	memorials, err := s.memorialRepo.FindByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]MemorialDTO, 0, len(memorials))
+	for _, m := range memorials {
+		result = append(result, toMemorialDTO(m))
+	}
+	return &MemorialListResponse{Memorials: result}, nil
+}
+
+func toMemorialDTO(m *pet.Memorial) MemorialDTO {
+	skill := pet.Skill{Type: m.SkillType, Level: m.SkillLevel}
+	achievements := m.Achievements
+	if achievements == nil {
+		achievements = []string{}
+	}
+	return MemorialDTO{
+		ID:           m.ID,
+		PetID:        m.PetID,
+		Name:         m.Name,
+		SpeciesID:    int(m.SpeciesID),
+		Gender:       m.Gender.Name(),
+		Stage:        m.Stage.Name(),
+		Level:        m.Level,
+		SkillName:    skill.Name(),
+		SkillLevel:   m.SkillLevel,
+		Generation:   m.Generation,
+		Parent1ID:    m.Parent1ID,
+		Parent2ID:    m.Parent2ID,
+		GeneCode:     m.Gene.String(),
+		Achievements: achievements,
+		BornAt:       m.BornAt,
+		HatchAt:      m.HatchAt,
+		PassedAt:     m.PassedAt,
+		LifeDays:     m.LifeDays(),
+		Cause:        string(m.Cause),
+		CauseName:    m.Cause.Name(),
+	}
+}
+
+// 纪念馆相关错误
+var (
+	ErrMemorialNotFound = pet.ErrMemorialNotFound
+)
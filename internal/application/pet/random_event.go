@@ -0,0 +1,106 @@
+// Package pet 宠物应用服务
+// 随机事件 - 照顾宠物时概率触发，奖励发给主人并推送通知
+package pet
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"pets-server/internal/domain/pet"
+)
+
+// randomEventListLimit 随机事件记录返回的条数
+const randomEventListLimit = 20
+
+// rollRandomEvent 照顾宠物后掷骰触发随机事件（须在事务中、保存宠物前调用）
+// 金币和道具发给宠物当前的主人，状态效果直接作用于宠物
+func (s *Service) rollRandomEvent(ctx context.Context, p *pet.Pet, now time.Time) (*pet.RandomEvent, error) {
+	if s.randomEvents == nil || s.eventRepo == nil {
+		return nil, nil
+	}
+
+	lastTriggered, err := s.eventRepo.LastTriggeredAt(ctx, p.ID)
+	if err != nil {
+		return nil, err
+	}
+	e, ok := s.randomEvents.Roll(p, lastTriggered, now)
+	if !ok {
+		return nil, nil
+	}
+
+	switch e.Effect {
+	case pet.RandomEffectCoins:
+		// 锁定主人行，避免覆盖并发的金币变更
+		owner, err := s.userRepo.FindByIDForUpdate(ctx, p.UserID)
+		if err != nil {
+			return nil, err
+		}
+		owner.AddCoins(e.Amount)
+		if err := s.userRepo.Save(ctx, owner); err != nil {
+			return nil, err
+		}
+	case pet.RandomEffectItem:
+		if err := s.giveItem(ctx, p.UserID, e.ItemID, e.Amount); err != nil {
+			return nil, err
+		}
+	}
+
+	p.ExperienceRandomEvent(e)
+	if err := s.eventRepo.Save(ctx, e); err != nil {
+		return nil, err
+	}
+	return e, nil
+}
+
+// notifyRandomEvent 推送随机事件给在线的主人（事务提交后调用）
+func (s *Service) notifyRandomEvent(e *pet.RandomEvent) *RandomEventDTO {
+	if e == nil {
+		return nil
+	}
+	dto := toRandomEventDTO(e)
+	if s.notifier != nil {
+		s.notifier.SendToUser(e.UserID, "random_event", dto)
+	}
+	return dto
+}
+
+// GetRandomEvents 获取宠物最近经历的随机事件
+func (s *Service) GetRandomEvents(ctx context.Context, userID, petID int) (*RandomEventListResponse, error) {
+	p, err := s.petRepo.FindByID(ctx, petID)
+	if err != nil {
+		if errors.Is(err, pet.ErrPetNotFound) {
+			return nil, ErrPetNotFound
+		}
+		return nil, err
+	}
+	if p.UserID != userID {
+		return nil, ErrNotPetOwner
+	}
+
+	events, err := s.eventRepo.FindByPetID(ctx, petID, randomEventListLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]RandomEventDTO, len(events))
+	for i, e := range events {
+		result[i] = *toRandomEventDTO(e)
+	}
+	return &RandomEventListResponse{Events: result}, nil
+}
+
+func toRandomEventDTO(e *pet.RandomEvent) *RandomEventDTO {
+	return &RandomEventDTO{
+		ID:         e.ID,
+		PetID:      e.PetID,
+		EventID:    e.EventID,
+		Name:       e.Name,
+		Message:    e.Message,
+		Effect:     string(e.Effect),
+		ItemID:     e.ItemID,
+		Status:     string(e.Status),
+		Amount:     e.Amount,
+		OccurredAt: e.OccurredAt,
+	}
+}
@@ -0,0 +1,208 @@
+// Package pet 宠物应用服务
+// 宠物栏 - 查看、改名、放生、排序和扩充自己拥有的多只宠物
+package pet
+
+import (
+	"context"
+	"time"
+
+	"pets-server/internal/domain/pet"
+)
+
+// checkRosterRoom 检查用户的宠物栏是否还能容纳 incoming 只宠物（须在事务中调用）
+// 先锁定用户行再计数，同一用户并发获得宠物时不会同时通过检查而超出栏位
+func (s *Service) checkRosterRoom(ctx context.Context, userID, incoming int) error {
+	u, err := s.userRepo.FindByIDForUpdate(ctx, userID)
+	if err != nil {
+		return err
+	}
+	owned, err := s.petRepo.CountByUserID(ctx, userID)
+	if err != nil {
+		return err
+	}
+	return s.rosterRules.CheckRoom(owned, u.PetSlots, incoming)
+}
+
+// GetRoster 获取我的宠物栏（按排序返回全部宠物及实时状态）
+func (s *Service) GetRoster(ctx context.Context, userID int) (*RosterResponse, error) {
+	u, err := s.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	pets, err := s.petRepo.FindByUserIDAll(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	// 主宠物未设置或已不在栏中时，与 getActivePet 一致回退到最早的一只
+	activeID := 0
+	for _, p := range pets {
+		if u.ActivePetID != nil && p.ID == *u.ActivePetID {
+			activeID = p.ID
+			break
+		}
+		if activeID == 0 || p.ID < activeID {
+			activeID = p.ID
+		}
+	}
+
+	now := time.Now()
+	result := make([]RosterPetDTO, 0, len(pets))
+	for _, p := range pets {
+		result = append(result, RosterPetDTO{
+			ID:           p.ID,
+			Name:         p.Name,
+			SpeciesID:    int(p.SpeciesID),
+			Gender:       p.Gender.Name(),
+			Stage:        p.Stage.Name(),
+			Level:        p.Level,
+			Generation:   p.Generation,
+			RosterOrder:  p.RosterOrder,
+			IsActive:     p.ID == activeID,
+			PetStatusDTO: toPetStatusDTO(p, now),
+		})
+	}
+
+	return &RosterResponse{
+		Pets:        result,
+		Count:       len(pets),
+		Capacity:    s.rosterRules.Capacity(u.PetSlots),
+		MaxCapacity: s.rosterRules.MaxCapacity,
+		SlotPrice:   s.rosterRules.SlotPrice,
+	}, nil
+}
+
+// RenamePet 给自己的宠物改名
+func (s *Service) RenamePet(ctx context.Context, userID, petID int, req RenamePetRequest) (*RenamePetResponse, error) {
+	var p *pet.Pet
+
+	err := s.uow.Do(ctx, func(txCtx context.Context) error {
+		var err error
+		p, err = s.findOwnedPet(txCtx, userID, petID)
+		if err != nil {
+			return err
+		}
+		if err := p.Rename(req.Name); err != nil {
+			return err
+		}
+		return s.petRepo.Save(txCtx, p)
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if s.cache != nil {
+		_ = s.cache.DeletePetDetail(ctx, userID)
+	}
+
+	s.publishEvents(ctx, p.Events())
+
+	return &RenamePetResponse{PetID: p.ID, Name: p.Name}, nil
+}
+
+// ReleasePet 放生宠物
+// 需输入宠物名字确认；宠物转入纪念馆（不发放纪念品），待处理的繁殖契约一并拒绝
+func (s *Service) ReleasePet(ctx context.Context, userID, petID int, req ReleasePetRequest) (*ReleasePetResponse, error) {
+	var memorial *pet.Memorial
+	var events []any
+
+	err := s.uow.Do(ctx, func(txCtx context.Context) error {
+		now := time.Now()
+
+		p, err := s.findOwnedPet(txCtx, userID, petID)
+		if err != nil {
+			return err
+		}
+
+		// 1. 生成纪念记录
+		memorial, err = p.Release(req.ConfirmName, now)
+		if err != nil {
+			return err
+		}
+		if err := s.memorialRepo.Save(txCtx, memorial); err != nil {
+			return err
+		}
+		events = p.Events()
+
+		// 2. 清理关联数据（含待处理的繁殖契约）并删除宠物
+		removeEvents, err := s.removePet(txCtx, p, now)
+		if err != nil {
+			return err
+		}
+		events = append(events, removeEvents...)
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if s.cache != nil {
+		_ = s.cache.DeletePetDetail(ctx, userID)
+	}
+
+	s.publishEvents(ctx, events)
+
+	return &ReleasePetResponse{PetID: petID, Memorial: toMemorialDTO(memorial)}, nil
+}
+
+// ReorderRoster 按给定顺序重排宠物栏
+func (s *Service) ReorderRoster(ctx context.Context, userID int, req ReorderRosterRequest) (*RosterResponse, error) {
+	err := s.uow.Do(ctx, func(txCtx context.Context) error {
+		pets, err := s.petRepo.FindByUserIDAll(txCtx, userID)
+		if err != nil {
+			return err
+		}
+		if err := pet.ReorderRoster(pets, req.PetIDs); err != nil {
+			return err
+		}
+		for _, p := range pets {
+			if err := s.petRepo.Save(txCtx, p); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return s.GetRoster(ctx, userID)
+}
+
+// ExpandRoster 花费钻石扩充一个宠物栏位
+func (s *Service) ExpandRoster(ctx context.Context, userID int) (*ExpandRosterResponse, error) {
+	var response *ExpandRosterResponse
+
+	err := s.uow.Do(ctx, func(txCtx context.Context) error {
+		// 锁定用户行，避免并发扩容重复扣费或覆盖栏位数
+		u, err := s.userRepo.FindByIDForUpdate(txCtx, userID)
+		if err != nil {
+			return err
+		}
+		if err := s.rosterRules.CanExpand(u.PetSlots); err != nil {
+			return err
+		}
+		if err := u.BuyPetSlot(s.rosterRules.SlotPrice); err != nil {
+			return err
+		}
+		if err := s.userRepo.Save(txCtx, u); err != nil {
+			return err
+		}
+
+		response = &ExpandRosterResponse{
+			Capacity:    s.rosterRules.Capacity(u.PetSlots),
+			MaxCapacity: s.rosterRules.MaxCapacity,
+			SlotPrice:   s.rosterRules.SlotPrice,
+			Diamonds:    u.Diamonds,
+		}
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
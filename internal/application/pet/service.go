@@ -12,6 +12,7 @@ import (
 	"pets-server/internal/domain/item"
 	"pets-server/internal/domain/pet"
 	"pets-server/internal/domain/shared"
+	"pets-server/internal/domain/social"
 	"pets-server/internal/domain/user"
 )
 
@@ -20,10 +21,24 @@ type Service struct {
 	userRepo     user.Repository
 	petRepo      pet.Repository
 	itemRepo     item.Repository
-	petDomainSvc *pet.DomainService // 领域服务
+	decoRepo     item.DecorationRepository
+	contractRepo pet.BreedingContractRepository
+	adoptionRepo pet.AdoptionRepository
+	ownerRepo    pet.OwnershipRepository
+	memorialRepo pet.MemorialRepository
+	eventRepo    pet.RandomEventRepository
+	friendRepo   social.FriendRepository
+	interactRepo social.InteractionRepository
+	petDomainSvc *pet.DomainService    // 领域服务
+	randomEvents *pet.RandomEventTable // 随机事件表
 	uow          shared.UnitOfWork
 	publisher    shared.EventPublisher
 	cache        CacheService // 缓存服务接口
+	notifier     Notifier     // 在线推送接口，可为 nil
+
+	keepsakeItemID    int             // 宠物寿终后发给主人的纪念品道具ID，0 表示不发放
+	geneScannerItemID int             // 基因扫描仪道具ID，0 表示不开放隐藏片段
+	rosterRules       pet.RosterRules // 宠物栏规则
 }
 
 // CacheService 缓存服务接口（在应用层定义，基础设施层实现）
@@ -31,6 +46,13 @@ type CacheService interface {
 	GetPetDetail(ctx context.Context, userID int) (*PetDetailDTO, error)
 	SetPetDetail(ctx context.Context, userID int, pet *PetDetailDTO, ttl time.Duration) error
 	DeletePetDetail(ctx context.Context, userID int) error
+	GetGenePool(ctx context.Context) (*pet.GenePool, error)
+	SetGenePool(ctx context.Context, pool *pet.GenePool, ttl time.Duration) error
+}
+
+// Notifier 在线用户推送接口（在应用层定义，由 WebSocket Hub 实现）
+type Notifier interface {
+	SendToUser(userID int, msgType string, payload interface{})
 }
 
 // NewService 创建宠物应用服务
@@ -38,45 +60,56 @@ func NewService(
 	userRepo user.Repository,
 	petRepo pet.Repository,
 	itemRepo item.Repository,
+	decoRepo item.DecorationRepository,
+	contractRepo pet.BreedingContractRepository,
+	adoptionRepo pet.AdoptionRepository,
+	ownerRepo pet.OwnershipRepository,
+	memorialRepo pet.MemorialRepository,
+	eventRepo pet.RandomEventRepository,
+	friendRepo social.FriendRepository,
+	interactRepo social.InteractionRepository,
 	petDomainSvc *pet.DomainService,
+	randomEvents *pet.RandomEventTable,
 	uow shared.UnitOfWork,
 	publisher shared.EventPublisher,
 	cache CacheService,
+	notifier Notifier,
+	keepsakeItemID int,
+	geneScannerItemID int,
+	rosterRules pet.RosterRules,
 ) *Service {
 	return &Service{
 		userRepo:     userRepo,
 		petRepo:      petRepo,
 		itemRepo:     itemRepo,
+		decoRepo:     decoRepo,
+		contractRepo: contractRepo,
+		adoptionRepo: adoptionRepo,
+		ownerRepo:    ownerRepo,
+		memorialRepo: memorialRepo,
+		eventRepo:    eventRepo,
+		friendRepo:   friendRepo,
+		interactRepo: interactRepo,
 		petDomainSvc: petDomainSvc,
+		randomEvents: randomEvents,
 		uow:          uow,
 		publisher:    publisher,
 		cache:        cache,
+		notifier:     notifier,
+
+		keepsakeItemID:    keepsakeItemID,
+		geneScannerItemID: geneScannerItemID,
+		rosterRules:       rosterRules.Normalize(),
 	}
 }
 
+// getActivePet 获取用户主宠物（未设置或已失效时退回第一只宠物）
 func (s *Service) getActivePet(ctx context.Context, userID int) (*pet.Pet, error) {
 	u, err := s.userRepo.FindByID(ctx, userID)
 	if err != nil {
 		return nil, err
 	}
-
-	// 兼容老数据：未设置主宠物时退回第一只宠物
-	if u.ActivePetID == nil {
-		return s.petRepo.FindByUserID(ctx, userID)
-	}
-
-	p, err := s.petRepo.FindByID(ctx, *u.ActivePetID)
-	if err != nil {
-		// 兼容脏数据：主宠物不存在时退回第一只宠物
-		if errors.Is(err, pet.ErrPetNotFound) {
-			return s.petRepo.FindByUserID(ctx, userID)
-		}
-		return nil, err
-	}
-	if p.UserID != userID {
-		return nil, ErrNotPetOwner
-	}
-	return p, nil
+	return pet.ResolveActivePet(ctx, s.petRepo, userID, u.ActivePetID)
 }
 
 // ============================================================
@@ -85,7 +118,7 @@ func (s *Service) getActivePet(ctx context.Context, userID int) (*pet.Pet, error
 //   Handler.GetMyPet()
 //     → AppService.GetPetDetail()
 //       → PetRepo.FindByUserID() 查询数据库
-//       → 组装 DTO
+//       → 组装 DTO（没有基因扫描仪时遮蔽隐藏片段）
 //     ← 返回 DTO
 // ============================================================
 
@@ -100,12 +133,52 @@ func (s *Service) GetPetDetail(ctx context.Context, userID int) (*PetDetailDTO,
 		return nil, err
 	}
 
-	// 2. 将领域实体转换为 DTO
+	// 2. 将领域实体转换为 DTO（持有基因扫描仪时展示完整基因）
 	dto := s.toPetDetailDTO(p)
+	hasScanner, err := s.hasGeneScanner(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if hasScanner {
+		dto.GeneCode = p.Gene.String()
+		dto.Genetics = toGenotypeDTOs(s.petDomainSvc.Genotypes(p), true)
+	}
+
+	// 3. 补充穿戴中的装饰
+	dto.Decorations, err = s.getDecorationDTOs(ctx, p.ID)
+	if err != nil {
+		return nil, err
+	}
 
 	return dto, nil
 }
 
+// getDecorationDTOs 获取宠物穿戴中的装饰
+func (s *Service) getDecorationDTOs(ctx context.Context, petID int) ([]DecorationDTO, error) {
+	result := []DecorationDTO{}
+	if s.decoRepo == nil {
+		return result, nil
+	}
+
+	decorations, err := s.decoRepo.FindByPetID(ctx, petID)
+	if err != nil {
+		return nil, err
+	}
+	for _, d := range decorations {
+		dto := DecorationDTO{
+			Slot:       d.Slot,
+			SlotName:   item.SlotName(d.Slot),
+			ItemID:     d.ItemID,
+			EquippedAt: d.EquippedAt,
+		}
+		if def, err := s.itemRepo.GetDefinition(ctx, d.ItemID); err == nil {
+			dto.Name = def.Name
+		}
+		result = append(result, dto)
+	}
+	return result, nil
+}
+
 // GetPetStatus 获取指定宠物轻量状态（只读计算，不落库）
 func (s *Service) GetPetStatus(ctx context.Context, userID, petID int) (*PetStatusDTO, error) {
 	p, err := s.petRepo.FindByID(ctx, petID)
@@ -119,10 +192,16 @@ func (s *Service) GetPetStatus(ctx context.Context, userID, petID int) (*PetStat
 		return nil, ErrNotPetOwner
 	}
 
-	now := time.Now()
+	dto := toPetStatusDTO(p, time.Now())
+	return &dto, nil
+}
+
+// toPetStatusDTO 按时间差计算宠物在 now 时刻的轻量状态（不修改实体）
+func toPetStatusDTO(p *pet.Pet, now time.Time) PetStatusDTO {
 	hunger, happiness, cleanliness, energy := p.StatusAt(now)
+	mood, moodCalm := p.MoodAt(now)
 
-	return &PetStatusDTO{
+	return PetStatusDTO{
 		Hunger:          hunger,
 		Happiness:       happiness,
 		Cleanliness:     cleanliness,
@@ -131,29 +210,18 @@ func (s *Service) GetPetStatus(ctx context.Context, userID, petID int) (*PetStat
 		IsUnhappy:       happiness < 30,
 		IsDirty:         cleanliness < 30,
 		IsTired:         energy < 20,
+		IsSick:          p.IsSick(),
+		Illness:         p.Illness.Name(),
+		IllAt:           p.IllAt,
+		Mood:            string(mood),
+		MoodName:        mood.Name(),
+		MoodCalm:        moodCalm,
+		HatchAt:         hatchTimeOf(p),
+		CanHatch:        p.CanHatch(now),
+		HatchRemaining:  int64(p.IncubationRemaining(now).Seconds()),
 		StatusUpdatedAt: p.StatusUpdatedAt,
 		Revision:        p.Revision,
 		ServerTime:      now,
-	}, nil
-}
-
-// applyComputedStatus 将按时间差计算出的状态回填到实体（用于写操作前补算）
-func (s *Service) applyComputedStatus(p *pet.Pet, now time.Time) {
-	hunger, happiness, cleanliness, energy := p.StatusAt(now)
-	changed := p.Hunger != hunger ||
-		p.Happiness != happiness ||
-		p.Cleanliness != cleanliness ||
-		p.Energy != energy
-
-	p.Hunger = hunger
-	p.Happiness = happiness
-	p.Cleanliness = cleanliness
-	p.Energy = energy
-
-	// 零值锚点或状态发生变化时推进锚点与版本
-	if p.StatusUpdatedAt.IsZero() || changed {
-		p.StatusUpdatedAt = now
-		p.Revision++
 	}
 }
 
@@ -179,6 +247,7 @@ func (s *Service) applyComputedStatus(p *pet.Pet, now time.Time) {
 func (s *Service) FeedPet(ctx context.Context, userID int, req FeedPetRequest) (*FeedPetResponse, error) {
 	var response *FeedPetResponse
 	var events []any
+	var randomEvent *pet.RandomEvent
 
 	// 1. 在事务中执行所有数据库操作
 	err := s.uow.Do(ctx, func(txCtx context.Context) error {
@@ -187,7 +256,7 @@ func (s *Service) FeedPet(ctx context.Context, userID int, req FeedPetRequest) (
 		if err != nil {
 			return err
 		}
-		s.applyComputedStatus(p, time.Now())
+		p.ApplyStatusAt(time.Now())
 
 		// 1.2 获取道具定义，确定食物类型
 		itemDef, err := s.itemRepo.GetDefinition(txCtx, req.FoodItemID)
@@ -209,34 +278,42 @@ func (s *Service) FeedPet(ctx context.Context, userID int, req FeedPetRequest) (
 			return err
 		}
 
-		// 1.5 记录喂食前的等级
-		oldLevel := p.Level
+		// 1.5 记录喂食前的等级和技能等级
+		oldLevel, oldSkillLevel := p.Level, p.Skill.Level
 
 		// 1.6 领域逻辑：喂食宠物
 		foodType := pet.FoodType(itemDef.EffectValue)
-		if err := p.Feed(foodType); err != nil {
+		expGained, err := p.Feed(foodType)
+		if err != nil {
+			return err
+		}
+
+		// 1.7 照顾后可能触发随机事件
+		randomEvent, err = s.rollRandomEvent(txCtx, p, time.Now())
+		if err != nil {
 			return err
 		}
 
-		// 1.7 保存道具变更
+		// 1.8 保存道具变更
 		if err := s.itemRepo.Save(txCtx, userItem); err != nil {
 			return err
 		}
 
-		// 1.8 保存宠物变更
+		// 1.9 保存宠物变更
 		if err := s.petRepo.Save(txCtx, p); err != nil {
 			return err
 		}
 
-		// 1.9 收集领域事件
+		// 1.10 收集领域事件
 		events = p.Events()
 
-		// 1.10 构建响应
+		// 1.11 构建响应
 		response = &FeedPetResponse{
-			Hunger:    p.Hunger,
-			ExpGained: 10, // 基础经验
-			LevelUp:   p.Level > oldLevel,
-			NewLevel:  p.Level,
+			Hunger:       p.Hunger,
+			ExpGained:    expGained,
+			LevelUp:      p.Level > oldLevel,
+			NewLevel:     p.Level,
+			SkillLevelUp: p.Skill.Level > oldSkillLevel,
 		}
 
 		return nil
@@ -260,6 +337,9 @@ func (s *Service) FeedPet(ctx context.Context, userID int, req FeedPetRequest) (
 		}
 	}
 
+	// 4. 推送随机事件
+	response.RandomEvent = s.notifyRandomEvent(randomEvent)
+
 	return response, nil
 }
 
@@ -268,6 +348,10 @@ func (s *Service) CreatePet(ctx context.Context, userID int, req CreatePetReques
 	var dto *PetDetailDTO
 
 	err := s.uow.Do(ctx, func(txCtx context.Context) error {
+		// 宠物栏需有空位
+		if err := s.checkRosterRoom(txCtx, userID, 1); err != nil {
+			return err
+		}
 
 		// 使用领域服务创建宠物
 		var p *pet.Pet
@@ -318,29 +402,38 @@ func (s *Service) CreatePet(ctx context.Context, userID int, req CreatePetReques
 // PlayWithPet 和宠物玩耍
 func (s *Service) PlayWithPet(ctx context.Context, userID int) (*PlayPetResponse, error) {
 	var response *PlayPetResponse
+	var events []any
+	var randomEvent *pet.RandomEvent
 
 	err := s.uow.Do(ctx, func(txCtx context.Context) error {
 		p, err := s.getActivePet(txCtx, userID)
 		if err != nil {
 			return err
 		}
-		s.applyComputedStatus(p, time.Now())
+		p.ApplyStatusAt(time.Now())
 
-		oldLevel := p.Level
+		oldLevel, oldSkillLevel := p.Level, p.Skill.Level
 
-		if err := p.Play(); err != nil {
+		expGained, err := p.Play()
+		if err != nil {
+			return err
+		}
+		randomEvent, err = s.rollRandomEvent(txCtx, p, time.Now())
+		if err != nil {
 			return err
 		}
 
 		if err := s.petRepo.Save(txCtx, p); err != nil {
 			return err
 		}
+		events = p.Events()
 
 		response = &PlayPetResponse{
-			Happiness: p.Happiness,
-			Energy:    p.Energy,
-			ExpGained: 10,
-			LevelUp:   p.Level > oldLevel,
+			Happiness:    p.Happiness,
+			Energy:       p.Energy,
+			ExpGained:    expGained,
+			LevelUp:      p.Level > oldLevel,
+			SkillLevelUp: p.Skill.Level > oldSkillLevel,
 		}
 
 		return nil
@@ -355,30 +448,42 @@ func (s *Service) PlayWithPet(ctx context.Context, userID int) (*PlayPetResponse
 		_ = s.cache.DeletePetDetail(ctx, userID)
 	}
 
+	s.publishEvents(ctx, events)
+	response.RandomEvent = s.notifyRandomEvent(randomEvent)
+
 	return response, nil
 }
 
 // CleanPet 清洁宠物
 func (s *Service) CleanPet(ctx context.Context, userID int) (*CleanPetResponse, error) {
 	var response *CleanPetResponse
+	var events []any
+	var randomEvent *pet.RandomEvent
 
 	err := s.uow.Do(ctx, func(txCtx context.Context) error {
 		p, err := s.getActivePet(txCtx, userID)
 		if err != nil {
 			return err
 		}
-		s.applyComputedStatus(p, time.Now())
+		p.ApplyStatusAt(time.Now())
+		oldSkillLevel := p.Skill.Level
 
 		if err := p.Clean(); err != nil {
 			return err
 		}
+		randomEvent, err = s.rollRandomEvent(txCtx, p, time.Now())
+		if err != nil {
+			return err
+		}
 
 		if err := s.petRepo.Save(txCtx, p); err != nil {
 			return err
 		}
+		events = p.Events()
 
 		response = &CleanPetResponse{
-			Cleanliness: p.Cleanliness,
+			Cleanliness:  p.Cleanliness,
+			SkillLevelUp: p.Skill.Level > oldSkillLevel,
 		}
 
 		return nil
@@ -392,6 +497,9 @@ func (s *Service) CleanPet(ctx context.Context, userID int) (*CleanPetResponse,
 		_ = s.cache.DeletePetDetail(ctx, userID)
 	}
 
+	s.publishEvents(ctx, events)
+	response.RandomEvent = s.notifyRandomEvent(randomEvent)
+
 	return response, nil
 }
 
@@ -418,13 +526,22 @@ func (s *Service) toPetDetailDTO(p *pet.Pet) *PetDetailDTO {
 		Skill: SkillDTO{
 			Name:        p.Skill.Name(),
 			Level:       p.Skill.Level,
+			Exp:         p.Skill.Exp,
+			ExpToNext:   p.Skill.ExpToNext(),
 			Rarity:      p.Skill.Rarity(),
 			Description: p.Skill.Description(),
 		},
-		Stage:     p.StageName(),
-		Level:     p.Level,
-		Exp:       p.Exp,
-		ExpToNext: p.Level * 100,
+		SecondarySkill: toSecondarySkillDTO(p),
+		Abilities:      toAbilityDTOs(p),
+		Stage:          p.StageName(),
+		Level:          p.Level,
+		Exp:            p.Exp,
+		ExpToNext:      p.RequiredExp(),
+		Form:           p.Form,
+		FormName:       formNameOf(p),
+		Care:           toCareHistoryDTO(p.Care),
+		HatchAt:        hatchTimeOf(p),
+		PassesAt:       p.PassesAt,
 		Status: StatusDTO{
 			Hunger:      p.Hunger,
 			Happiness:   p.Happiness,
@@ -434,55 +551,128 @@ func (s *Service) toPetDetailDTO(p *pet.Pet) *PetDetailDTO {
 			IsUnhappy:   p.IsUnhappy(),
 			IsDirty:     p.IsDirty(),
 			IsTired:     p.IsTired(),
+			IsSick:      p.IsSick(),
+			Illness:     p.Illness.Name(),
+			IllAt:       p.IllAt,
+			Mood:        string(p.Mood),
+			MoodName:    p.Mood.Name(),
+			MoodCalm:    p.MoodCalm,
+		},
+		DecayBonus: DecayBonusDTO{
+			Hunger:      p.DecayBonus.Hunger,
+			Happiness:   p.DecayBonus.Happiness,
+			Cleanliness: p.DecayBonus.Cleanliness,
 		},
-		GeneCode: p.Gene.String(),
+		Decorations: []DecorationDTO{},
+		GeneCode:    pet.MaskedGeneCode(p.Gene),
+		Genetics:    toGenotypeDTOs(s.petDomainSvc.Genotypes(p), false),
+	}
+}
+
+// formNameOf 进化形态名称，物种已不再配置该形态时返回形态ID
+func formNameOf(p *pet.Pet) string {
+	if form, ok := p.CurrentForm(); ok {
+		return form.Name
+	}
+	return p.Form
+}
+
+// toCareHistoryDTO 养育记录DTO
+func toCareHistoryDTO(c pet.CareHistory) CareHistoryDTO {
+	return CareHistoryDTO{
+		FeedCount:          c.FeedCount,
+		PlayCount:          c.PlayCount,
+		CleanCount:         c.CleanCount,
+		AverageHappiness:   c.AverageHappiness(),
+		AverageCleanliness: c.AverageCleanliness(),
 	}
 }
 
+// toSecondarySkillDTO 副技能DTO，未解锁或没有副技能时为 nil
+func toSecondarySkillDTO(p *pet.Pet) *SkillDTO {
+	if p.Stage < pet.StageTeen || p.SecondarySkill.Type == pet.SkillTypeNone {
+		return nil
+	}
+	return &SkillDTO{
+		Name:        p.SecondarySkill.Name(),
+		Level:       p.SecondarySkill.Level,
+		Rarity:      p.SecondarySkill.Rarity(),
+		Description: p.SecondarySkill.Description(),
+	}
+}
+
+func toAbilityDTOs(p *pet.Pet) []AbilityDTO {
+	abilities := p.Abilities()
+	result := make([]AbilityDTO, 0, len(abilities))
+	for _, a := range abilities {
+		result = append(result, AbilityDTO{
+			Name:        a.Name(),
+			Description: a.Description(),
+		})
+	}
+	return result
+}
+
 // ============================================================
 // 繁殖相关方法
 // ============================================================
 
-// BreedPets 繁殖宠物
+// BreedPets 双亲繁殖
 func (s *Service) BreedPets(ctx context.Context, userID int, req BreedPetsRequest) (*BreedPetsResponse, error) {
+	if req.Parent1ID == req.Parent2ID {
+		return nil, ErrSameParent
+	}
+	return s.breed(ctx, userID, req.Parent1ID, req.Parent2ID, req.ChildName)
+}
+
+// SelfBreedPet 分裂繁殖
+func (s *Service) SelfBreedPet(ctx context.Context, userID int, req SelfBreedRequest) (*BreedPetsResponse, error) {
+	return s.breed(ctx, userID, req.ParentID, 0, req.ChildName)
+}
+
+// breed 执行繁殖，parent2ID 为 0 时为分裂繁殖
+func (s *Service) breed(ctx context.Context, userID, parent1ID, parent2ID int, childName string) (*BreedPetsResponse, error) {
 	var response *BreedPetsResponse
 	var events []any
 
 	err := s.uow.Do(ctx, func(txCtx context.Context) error {
+		now := time.Now()
+
+		// 宠物栏需有空位容纳后代
+		if err := s.checkRosterRoom(txCtx, userID, 1); err != nil {
+			return err
+		}
+
 		// 1. 获取父母1
-		parent1, err := s.petRepo.FindByID(txCtx, req.Parent1ID)
+		parent1, err := s.findOwnedPet(txCtx, userID, parent1ID)
 		if err != nil {
 			return err
 		}
-		s.applyComputedStatus(parent1, time.Now())
-		// 验证所有权
-		if parent1.UserID != userID {
-			return ErrNotPetOwner
-		}
+		parent1.ApplyStatusAt(now)
 
 		var parent2 *pet.Pet
 		var result *pet.BreedingResult
 
-		if req.Parent2ID > 0 {
+		if parent2ID > 0 {
 			// 2. 双亲繁殖
-			parent2, err = s.petRepo.FindByID(txCtx, req.Parent2ID)
+			parent2, err = s.findOwnedPet(txCtx, userID, parent2ID)
 			if err != nil {
 				return err
 			}
-			s.applyComputedStatus(parent2, time.Now())
-			// 验证所有权
-			if parent2.UserID != userID {
-				return ErrNotPetOwner
-			}
+			parent2.ApplyStatusAt(now)
 
-			// 3. 委托领域服务执行繁殖
-			result, err = s.petDomainSvc.BreedPets(parent1, parent2, req.ChildName, userID)
+			// 3. 委托领域服务执行繁殖（按祖先图谱计算近交系数）
+			ancestry, err := s.loadAncestry(txCtx, parent1, parent2)
+			if err != nil {
+				return err
+			}
+			result, err = s.petDomainSvc.BreedPets(parent1, parent2, childName, userID, ancestry)
 			if err != nil {
 				return err
 			}
 		} else {
 			// 分裂繁殖
-			result, err = s.petDomainSvc.SelfBreedPet(parent1, req.ChildName, userID)
+			result, err = s.petDomainSvc.SelfBreedPet(parent1, childName, userID)
 			if err != nil {
 				return err
 			}
@@ -508,23 +698,32 @@ func (s *Service) BreedPets(ctx context.Context, userID int, req BreedPetsReques
 
 		// 7. 构建响应
 		inheritedGenes := []string{}
+		for _, g := range s.petDomainSvc.Genotypes(result.Child) {
+			switch {
+			case !g.Dominant():
+				inheritedGenes = append(inheritedGenes, "隐性"+g.Trait.Name+"显现（"+g.Notation()+"）")
+			case g.Carrier():
+				inheritedGenes = append(inheritedGenes, "携带隐性"+g.Trait.Name+"（"+g.Notation()+"）")
+			}
+		}
 		mutations := []string{}
 		if result.IsHidden {
 			mutations = append(mutations, "触发隐藏物种融合")
 		}
+		if result.Inbreeding.Coefficient > 0 {
+			mutations = append(mutations, "近亲繁殖：突变率上升，抗性降低")
+		}
 
 		response = &BreedPetsResponse{
 			Offspring:      *s.toPetDetailDTO(result.Child),
 			InheritedGenes: inheritedGenes,
 			Mutations:      mutations,
-			Parent1Updated: PetBreedingStatusDTO{
-				ID: parent1.ID,
-			},
+			Parent1Updated: s.toBreedingStatusDTO(parent1),
+			Inbreeding:     toInbreedingDTO(result.Inbreeding),
 		}
 		if parent2 != nil {
-			response.Parent2Updated = &PetBreedingStatusDTO{
-				ID: parent2.ID,
-			}
+			status := s.toBreedingStatusDTO(parent2)
+			response.Parent2Updated = &status
 		}
 
 		return nil
@@ -553,56 +752,82 @@ func (s *Service) BreedPets(ctx context.Context, userID int, req BreedPetsReques
 
 // CanBreed 检查是否可以繁殖
 func (s *Service) CanBreed(ctx context.Context, userID int, parent1ID, parent2ID int) (*CanBreedResponse, error) {
-	parent1, err := s.petRepo.FindByID(ctx, parent1ID)
+	if parent1ID == parent2ID {
+		return nil, ErrSameParent
+	}
+
+	now := time.Now()
+	parent1, err := s.findOwnedPet(ctx, userID, parent1ID)
 	if err != nil {
 		return nil, err
 	}
-	s.applyComputedStatus(parent1, time.Now())
-	if parent1.UserID != userID {
-		return &CanBreedResponse{CanBreed: false, Reason: "非宠物主人"}, nil
-	}
+	parent1.ApplyStatusAt(now)
 
+	// 冷却取双亲中较长者
+	cooldown := s.petDomainSvc.GetBreedingCooldown(parent1)
+	response := &CanBreedResponse{CanBreed: true}
+
+	var checkErr error
 	if parent2ID > 0 {
-		parent2, err := s.petRepo.FindByID(ctx, parent2ID)
+		parent2, err := s.findOwnedPet(ctx, userID, parent2ID)
 		if err != nil {
 			return nil, err
 		}
-		s.applyComputedStatus(parent2, time.Now())
-		if parent2.UserID != userID {
-			return &CanBreedResponse{CanBreed: false, Reason: "非宠物主人"}, nil
+		parent2.ApplyStatusAt(now)
+		if c := s.petDomainSvc.GetBreedingCooldown(parent2); c > cooldown {
+			cooldown = c
 		}
 
-		// 委托领域服务检查
-		if err := s.petDomainSvc.CanBreedPair(parent1, parent2); err != nil {
-			return &CanBreedResponse{CanBreed: false, Reason: err.Error()}, nil
+		// 委托领域服务检查（含近亲程度）
+		ancestry, err := s.loadAncestry(ctx, parent1, parent2)
+		if err != nil {
+			return nil, err
 		}
+		checkErr = s.petDomainSvc.CanBreedPair(parent1, parent2, ancestry)
 	} else {
 		// 检查分裂繁殖
-		if err := s.petDomainSvc.CanSelfBreed(parent1); err != nil {
-			return &CanBreedResponse{CanBreed: false, Reason: err.Error()}, nil
-		}
+		checkErr = s.petDomainSvc.CanSelfBreed(parent1)
 	}
 
-	return &CanBreedResponse{CanBreed: true}, nil
+	if cooldown > 0 {
+		next := now.Add(cooldown)
+		response.CooldownSeconds = int64(cooldown.Seconds())
+		response.NextBreedAt = &next
+	}
+	if checkErr != nil {
+		response.CanBreed = false
+		response.Reason = checkErr.Error()
+	}
+
+	return response, nil
 }
 
 // PredictOffspring 预测后代物种
-func (s *Service) PredictOffspring(ctx context.Context, req PredictOffspringRequest) (*PredictOffspringResponse, error) {
-	parent1, err := s.petRepo.FindByID(ctx, req.Parent1ID)
+func (s *Service) PredictOffspring(ctx context.Context, userID int, req PredictOffspringRequest) (*PredictOffspringResponse, error) {
+	if req.Parent1ID == req.Parent2ID {
+		return nil, ErrSameParent
+	}
+
+	parent1, err := s.findOwnedPet(ctx, userID, req.Parent1ID)
 	if err != nil {
 		return nil, err
 	}
 
 	var parent2 *pet.Pet
+	var ancestry *pet.LineageGraph
 	if req.Parent2ID > 0 {
-		parent2, err = s.petRepo.FindByID(ctx, req.Parent2ID)
+		parent2, err = s.findOwnedPet(ctx, userID, req.Parent2ID)
+		if err != nil {
+			return nil, err
+		}
+		ancestry, err = s.loadAncestry(ctx, parent1, parent2)
 		if err != nil {
 			return nil, err
 		}
 	}
 
 	// 委托领域服务预测
-	predictions := s.petDomainSvc.PredictOffspringSpecies(parent1, parent2)
+	predictions, inbreeding := s.petDomainSvc.PredictOffspringSpecies(parent1, parent2, ancestry)
 
 	// 转换为 DTO
 	result := make([]SpeciesProbabilityDTO, 0, len(predictions))
@@ -616,10 +841,74 @@ func (s *Service) PredictOffspring(ctx context.Context, req PredictOffspringRequ
 			SpeciesID:   strconv.Itoa(int(p.SpeciesID)),
 			SpeciesName: name,
 			Probability: float64(p.Probability) / 100.0, // 转换为 0-1 范围
+			IsHidden:    p.IsHidden,
 		})
 	}
 
-	return &PredictOffspringResponse{PossibleSpecies: result}, nil
+	return &PredictOffspringResponse{
+		PossibleSpecies: result,
+		Inbreeding:      toInbreedingDTO(inbreeding),
+	}, nil
+}
+
+func toInbreedingDTO(i pet.Inbreeding) InbreedingDTO {
+	return InbreedingDTO{
+		Coefficient:       i.Coefficient,
+		Warning:           i.Warning,
+		Blocked:           i.Blocked,
+		ExtraMutation:     i.ExtraMutation,
+		ResistancePenalty: i.ResistancePenalty,
+	}
+}
+
+// toGenotypeDTOs 转换孟德尔性状，物种未启用时返回 nil；revealHidden 为 false 时遮蔽未表现的等位基因
+func toGenotypeDTOs(genotypes []pet.Genotype, revealHidden bool) []GenotypeDTO {
+	if len(genotypes) == 0 {
+		return nil
+	}
+	result := make([]GenotypeDTO, len(genotypes))
+	for i, g := range genotypes {
+		result[i] = GenotypeDTO{
+			Trait:     g.Trait.Key,
+			TraitName: g.Trait.Name,
+			Phenotype: g.Expressed,
+			Dominant:  g.Dominant(),
+			Masked:    !revealHidden,
+		}
+		// 未表现的等位基因位于隐藏片段，持有基因扫描仪才展示
+		if revealHidden {
+			result[i].Genotype = g.Notation()
+			result[i].Hidden = g.Hidden
+			result[i].Carrier = g.Carrier()
+		}
+	}
+	return result
+}
+
+// findOwnedPet 获取宠物并校验所有权
+func (s *Service) findOwnedPet(ctx context.Context, userID, petID int) (*pet.Pet, error) {
+	p, err := s.petRepo.FindByID(ctx, petID)
+	if err != nil {
+		if errors.Is(err, pet.ErrPetNotFound) {
+			return nil, ErrPetNotFound
+		}
+		return nil, err
+	}
+	if p.UserID != userID {
+		return nil, ErrNotPetOwner
+	}
+	return p, nil
+}
+
+// toBreedingStatusDTO 转换繁殖状态
+func (s *Service) toBreedingStatusDTO(p *pet.Pet) PetBreedingStatusDTO {
+	dto := PetBreedingStatusDTO{ID: p.ID}
+	if cooldown := s.petDomainSvc.GetBreedingCooldown(p); cooldown > 0 {
+		next := time.Now().Add(cooldown)
+		dto.CooldownSeconds = int64(cooldown.Seconds())
+		dto.NextBreedAt = &next
+	}
+	return dto
 }
 
 // ============================================================
@@ -704,8 +993,37 @@ func (s *Service) GetAvailableSpecies(ctx context.Context) (*GetSpeciesListRespo
 // 应用层错误
 var (
 	ErrPetNotFound      = errors.New("宠物不存在")
-	ErrAlreadyHasPet    = errors.New("已经拥有宠物了")
 	ErrInvalidFoodItem  = errors.New("无效的食物道具")
 	ErrNotPetOwner      = errors.New("非宠物主人")
 	ErrInvalidSpeciesID = errors.New("无效的物种ID")
+	ErrSameParent       = errors.New("父母不能是同一只宠物")
+
+	// 繁殖相关领域错误（透传给接口层做响应码映射）
+	ErrBreedCooldown      = pet.ErrBreedCooldown
+	ErrIncompatibleGender = pet.ErrIncompatibleGender
+	ErrPetNotMature       = pet.ErrPetNotMature
+	ErrPetLevelTooLow     = pet.ErrPetLevelTooLow
+	ErrPetUnhappy         = pet.ErrPetUnhappy
+	ErrCannotSelfBreed    = pet.ErrCannotSelfBreed
+	ErrPetIsSick          = pet.ErrPetIsSick
+	ErrTooInbred          = pet.ErrTooInbred
+
+	// 宠物栏相关
+	ErrRosterFull          = pet.ErrRosterFull
+	ErrRosterMaxCapacity   = pet.ErrRosterMaxCapacity
+	ErrReleaseNotConfirmed = pet.ErrReleaseNotConfirmed
+	ErrInvalidRosterOrder  = pet.ErrInvalidRosterOrder
+	ErrInvalidPetName      = pet.ErrInvalidPetName
+	ErrSamePetName         = pet.ErrSamePetName
+
+	// 繁殖契约相关
+	ErrNotFriends            = social.ErrNotFriends
+	ErrIntimacyTooLow        = errors.New("亲密度不足，无法签订繁殖契约")
+	ErrNotContractParty      = errors.New("非契约当事人")
+	ErrContractPending       = errors.New("该宠物已有待处理的繁殖契约")
+	ErrContractPetChanged    = errors.New("契约中的宠物已易主")
+	ErrContractSelf          = pet.ErrContractSelf
+	ErrContractNotFound      = pet.ErrContractNotFound
+	ErrContractExpired       = pet.ErrContractExpired
+	ErrInvalidContractStatus = pet.ErrInvalidContractStatus
 )
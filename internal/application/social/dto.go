@@ -78,29 +78,48 @@ type TradeDTO struct {
 	FromNickname    string    `json:"fromNickname"`
 	ToUserID        int       `json:"toUserId"`
 	ToNickname      string    `json:"toNickname"`
+	OfferItemID     int       `json:"offerItemId"`
 	OfferItemName   string    `json:"offerItemName"`
 	OfferQuantity   int       `json:"offerQuantity"`
+	RequestItemID   int       `json:"requestItemId"`
 	RequestItemName string    `json:"requestItemName"`
 	RequestQuantity int       `json:"requestQuantity"`
 	Status          string    `json:"status"`
+	StatusCode      int       `json:"statusCode"`
 	CreatedAt       time.Time `json:"createdAt"`
+	CompletedAt     time.Time `json:"completedAt,omitempty"`
+}
+
+// TradeListResponse 交易列表响应
+type TradeListResponse struct {
+	Trades []TradeDTO `json:"trades"`
 }
 
 // --- 拜访相关 ---
 
 // VisitResponse 拜访响应
 type VisitResponse struct {
-	Pet          PetVisitDTO `json:"pet"`
-	RewardCoins  int         `json:"rewardCoins"`
-	CanVisitMore bool        `json:"canVisitMore"`
+	Pet             PetVisitDTO `json:"pet"`
+	FirstVisitToday bool        `json:"firstVisitToday"` // 今天首次拜访（有奖励）
+	RewardCoins     int         `json:"rewardCoins"`     // 访客获得金币（含幸运额外获得）
+	HostRewardCoins int         `json:"hostRewardCoins"` // 主人获得金币（含幸运额外获得）
+	BonusCoins      int         `json:"bonusCoins"`      // 访客宠物幸运额外获得的金币
+	HostBonusCoins  int         `json:"hostBonusCoins"`  // 主人宠物幸运额外获得的金币
+	IntimacyGained  int         `json:"intimacyGained"`  // 增加的亲密度
+	Intimacy        int         `json:"intimacy"`        // 当前亲密度
+	CanVisitMore    bool        `json:"canVisitMore"`    // 主人今天是否还能接待访客
 }
 
 // PetVisitDTO 拜访时的宠物信息
 type PetVisitDTO struct {
-	ID        int    `json:"id"`
-	Name      string `json:"name"`
-	Level     int    `json:"level"`
-	Stage     string `json:"stage"`
-	OwnerName string `json:"ownerName"`
+	ID          int    `json:"id"`
+	Name        string `json:"name"`
+	SpeciesID   int    `json:"speciesId"`
+	Level       int    `json:"level"`
+	Stage       string `json:"stage"`
+	OwnerName   string `json:"ownerName"`
+	Hunger      int    `json:"hunger"`
+	Happiness   int    `json:"happiness"`
+	Cleanliness int    `json:"cleanliness"`
+	Energy      int    `json:"energy"`
 }
-
@@ -5,37 +5,53 @@ package social
 import (
 	"context"
 	"errors"
+	"time"
 
+	"pets-server/internal/domain/item"
+	"pets-server/internal/domain/pet"
 	"pets-server/internal/domain/shared"
 	"pets-server/internal/domain/social"
+	"pets-server/internal/domain/user"
 )
 
 // Service 社交应用服务
 type Service struct {
+	userRepo   user.Repository
+	itemRepo   item.Repository
+	petRepo    pet.Repository
 	friendRepo social.FriendRepository
 	giftRepo   social.GiftRepository
 	tradeRepo  social.TradeRepository
 	visitRepo  social.VisitRepository
 	uow        shared.UnitOfWork
 	publisher  shared.EventPublisher
+	tradeTTL   time.Duration // 交易有效期
 }
 
 // NewService 创建社交应用服务
 func NewService(
+	userRepo user.Repository,
+	itemRepo item.Repository,
+	petRepo pet.Repository,
 	friendRepo social.FriendRepository,
 	giftRepo social.GiftRepository,
 	tradeRepo social.TradeRepository,
 	visitRepo social.VisitRepository,
 	uow shared.UnitOfWork,
 	publisher shared.EventPublisher,
+	tradeTTL time.Duration,
 ) *Service {
 	return &Service{
+		userRepo:   userRepo,
+		itemRepo:   itemRepo,
+		petRepo:    petRepo,
 		friendRepo: friendRepo,
 		giftRepo:   giftRepo,
 		tradeRepo:  tradeRepo,
 		visitRepo:  visitRepo,
 		uow:        uow,
 		publisher:  publisher,
+		tradeTTL:   tradeTTL,
 	}
 }
 
@@ -156,4 +172,17 @@ func (s *Service) GetReceivedGifts(ctx context.Context, userID int) ([]GiftRecor
 // 应用层错误
 var (
 	ErrNotAllowed = errors.New("无权进行此操作")
+	ErrNotFriends = social.ErrNotFriends
+
+	// 透传领域错误，便于接口层做响应码映射
+	ErrTradeNotFound        = social.ErrTradeNotFound
+	ErrTradeExpired         = social.ErrTradeExpired
+	ErrInvalidTradeStatus   = social.ErrInvalidTradeStatus
+	ErrTradeSelf            = social.ErrTradeSelf
+	ErrInvalidTradeQuantity = social.ErrInvalidTradeQuantity
+	ErrItemNotFound         = item.ErrItemNotFound
+	ErrInsufficientItem     = item.ErrInsufficientItem
+	ErrPetNotFound          = pet.ErrPetNotFound
+	ErrVisitSelf            = social.ErrVisitSelf
+	ErrVisitLimitReached    = social.ErrVisitLimitReached
 )
@@ -0,0 +1,371 @@
+// Package social 社交应用服务
+// 道具交易 - 好友之间以物易物，发起方道具由系统托管
+package social
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"pets-server/internal/domain/item"
+	"pets-server/internal/domain/shared"
+	"pets-server/internal/domain/social"
+)
+
+// ============================================================
+// 道具交易流程：
+//   发起方 CreateTrade()
+//     → UoW.Do() 开启事务
+//       → 校验好友关系、双方道具存在
+//       → UserItem.Consume() 扣除发起方道具（托管）
+//       → 保存交易
+//     → 发布 social.trade_created
+//   接收方 AcceptTrade()
+//     → UoW.Do() 开启事务
+//       → 扣除接收方请求的道具
+//       → 托管道具交给接收方，请求道具交给发起方
+//     → 发布 social.trade_completed
+//   任一方 CancelTrade() 取消，托管道具退还发起方
+//   超过有效期的交易在读取或处理时标记为过期并退还托管道具
+// ============================================================
+
+// CreateTrade 发起交易，提供的道具立即从背包托管
+func (s *Service) CreateTrade(ctx context.Context, userID int, req CreateTradeRequest) (*TradeDTO, error) {
+	var trade *social.Trade
+	var events []any
+
+	err := s.uow.Do(ctx, func(txCtx context.Context) error {
+		// 1. 创建交易（校验双方与数量）
+		var err error
+		trade, err = social.NewTrade(userID, req.ToUserID, req.OfferItemID, req.OfferQuantity, req.RequestItemID, req.RequestQuantity)
+		if err != nil {
+			return err
+		}
+
+		// 2. 双方必须是好友
+		if _, err := social.FindFriendship(txCtx, s.friendRepo, userID, req.ToUserID); err != nil {
+			return err
+		}
+
+		// 3. 双方道具必须存在
+		if _, err := s.itemRepo.GetDefinition(txCtx, req.OfferItemID); err != nil {
+			return err
+		}
+		if _, err := s.itemRepo.GetDefinition(txCtx, req.RequestItemID); err != nil {
+			return err
+		}
+
+		// 4. 托管发起方道具
+		if err := s.takeItem(txCtx, userID, req.OfferItemID, req.OfferQuantity); err != nil {
+			return err
+		}
+
+		// 5. 保存交易
+		if err := s.tradeRepo.Save(txCtx, trade); err != nil {
+			return err
+		}
+
+		trade.Created()
+		events = trade.Events()
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	s.publishEvents(ctx, events)
+
+	return s.toTradeDTO(ctx, trade), nil
+}
+
+// AcceptTrade 接受交易，双方道具在同一事务中交换
+func (s *Service) AcceptTrade(ctx context.Context, userID, tradeID int) (*TradeDTO, error) {
+	var trade *social.Trade
+	var events []any
+	expired := false
+
+	err := s.uow.Do(ctx, func(txCtx context.Context) error {
+		// 锁定交易行，同一笔交易的接受、取消与过期处理串行执行
+		var err error
+		trade, err = s.tradeRepo.FindByIDForUpdate(txCtx, tradeID)
+		if err != nil {
+			return err
+		}
+
+		// 只有接收方可以接受
+		if trade.ToUserID != userID {
+			return ErrNotAllowed
+		}
+
+		// 过期的交易需要退还托管并落库，不能通过返回错误回滚
+		if trade.IsExpired(s.tradeTTL) {
+			if err := s.expireTrade(txCtx, trade); err != nil {
+				return err
+			}
+			events = trade.Events()
+			expired = true
+			return nil
+		}
+		if trade.Status != social.TradeStatusPending {
+			return ErrInvalidTradeStatus
+		}
+
+		// 好友关系可能在交易期间发生变化
+		if _, err := social.FindFriendship(txCtx, s.friendRepo, trade.FromUserID, trade.ToUserID); err != nil {
+			return err
+		}
+
+		// 1. 扣除接收方请求的道具
+		if err := s.takeItem(txCtx, trade.ToUserID, trade.RequestItemID, trade.RequestQuantity); err != nil {
+			return err
+		}
+
+		// 2. 交换：托管道具给接收方，请求道具给发起方
+		if err := s.giveItem(txCtx, trade.ToUserID, trade.OfferItemID, trade.OfferQuantity); err != nil {
+			return err
+		}
+		if err := s.giveItem(txCtx, trade.FromUserID, trade.RequestItemID, trade.RequestQuantity); err != nil {
+			return err
+		}
+
+		// 3. 更新交易状态
+		if err := trade.Accept(); err != nil {
+			return err
+		}
+		if err := s.tradeRepo.Save(txCtx, trade); err != nil {
+			return err
+		}
+
+		events = trade.Events()
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	s.publishEvents(ctx, events)
+	if expired {
+		return nil, ErrTradeExpired
+	}
+
+	return s.toTradeDTO(ctx, trade), nil
+}
+
+// CancelTrade 取消交易（发起方撤回或接收方拒绝），托管道具退还发起方
+func (s *Service) CancelTrade(ctx context.Context, userID, tradeID int) (*TradeDTO, error) {
+	var trade *social.Trade
+	var events []any
+
+	err := s.uow.Do(ctx, func(txCtx context.Context) error {
+		var err error
+		trade, err = s.tradeRepo.FindByIDForUpdate(txCtx, tradeID)
+		if err != nil {
+			return err
+		}
+		if !trade.IsParty(userID) {
+			return ErrNotAllowed
+		}
+
+		if trade.IsExpired(s.tradeTTL) {
+			if err := s.expireTrade(txCtx, trade); err != nil {
+				return err
+			}
+			events = trade.Events()
+			return nil
+		}
+
+		if err := trade.Cancel(userID); err != nil {
+			return err
+		}
+		if err := s.giveItem(txCtx, trade.FromUserID, trade.OfferItemID, trade.OfferQuantity); err != nil {
+			return err
+		}
+		if err := s.tradeRepo.Save(txCtx, trade); err != nil {
+			return err
+		}
+
+		events = trade.Events()
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	s.publishEvents(ctx, events)
+
+	return s.toTradeDTO(ctx, trade), nil
+}
+
+// GetTrades 获取我发起和收到的交易
+func (s *Service) GetTrades(ctx context.Context, userID int) (*TradeListResponse, error) {
+	var trades []*social.Trade
+	var events []any
+
+	err := s.uow.Do(ctx, func(txCtx context.Context) error {
+		var err error
+		trades, err = s.tradeRepo.FindByUser(txCtx, userID)
+		if err != nil {
+			return err
+		}
+
+		// 顺带处理已过期的交易
+		for _, t := range trades {
+			if !t.IsExpired(s.tradeTTL) {
+				continue
+			}
+			// 加锁后重新读取，交易可能已被对方接受或取消
+			locked, err := s.tradeRepo.FindByIDForUpdate(txCtx, t.ID)
+			if err != nil {
+				return err
+			}
+			*t = *locked
+			if !t.IsExpired(s.tradeTTL) {
+				continue
+			}
+			if err := s.expireTrade(txCtx, t); err != nil {
+				return err
+			}
+			events = append(events, t.Events()...)
+		}
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	s.publishEvents(ctx, events)
+
+	result := make([]TradeDTO, 0, len(trades))
+	for _, t := range trades {
+		result = append(result, *s.toTradeDTO(ctx, t))
+	}
+	return &TradeListResponse{Trades: result}, nil
+}
+
+// ExpireStaleTrades 处理一批已过期的待处理交易：标记过期、退还托管道具并发布事件
+// 由定时任务循环调用，返回本批处理的交易；返回数量小于 batchSize 表示已处理完
+// 交易在事务中加锁读取并跳过已被锁定的记录，多个实例同时运行也不会重复退还
+func (s *Service) ExpireStaleTrades(ctx context.Context, batchSize int) ([]*social.Trade, error) {
+	var trades []*social.Trade
+	var events []any
+
+	err := s.uow.Do(ctx, func(txCtx context.Context) error {
+		ttl := s.tradeTTL
+		if ttl <= 0 {
+			ttl = social.DefaultTradeTTL
+		}
+
+		var err error
+		trades, err = s.tradeRepo.LockExpiredPending(txCtx, time.Now().Add(-ttl), batchSize)
+		if err != nil {
+			return err
+		}
+
+		for _, t := range trades {
+			if err := s.expireTrade(txCtx, t); err != nil {
+				return err
+			}
+			events = append(events, t.Events()...)
+		}
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	s.publishEvents(ctx, events)
+	return trades, nil
+}
+
+// expireTrade 标记交易过期并退还托管道具
+// 调用方须已锁定交易行；已不是待处理状态的交易不再退还，避免与接受/取消重复处理
+func (s *Service) expireTrade(ctx context.Context, trade *social.Trade) error {
+	if trade.Status != social.TradeStatusPending {
+		return nil
+	}
+	trade.MarkExpired()
+	if err := s.giveItem(ctx, trade.FromUserID, trade.OfferItemID, trade.OfferQuantity); err != nil {
+		return err
+	}
+	return s.tradeRepo.Save(ctx, trade)
+}
+
+// takeItem 从用户背包扣除道具，用完的道具从背包移除
+// 锁定道具行后再扣减，避免并发发起交易重复托管同一批道具
+func (s *Service) takeItem(ctx context.Context, userID, itemID, quantity int) error {
+	userItem, err := s.itemRepo.FindByUserAndItemForUpdate(ctx, userID, itemID)
+	if err != nil {
+		if errors.Is(err, item.ErrItemNotFound) {
+			return ErrInsufficientItem
+		}
+		return err
+	}
+	if err := userItem.Consume(quantity); err != nil {
+		return err
+	}
+	if userItem.IsEmpty() {
+		return s.itemRepo.Delete(ctx, userItem.ID)
+	}
+	return s.itemRepo.Save(ctx, userItem)
+}
+
+// giveItem 向用户背包增加道具
+func (s *Service) giveItem(ctx context.Context, userID, itemID, quantity int) error {
+	userItem, err := s.itemRepo.FindByUserAndItemForUpdate(ctx, userID, itemID)
+	if err != nil {
+		if !errors.Is(err, item.ErrItemNotFound) {
+			return err
+		}
+		userItem = item.NewUserItem(userID, itemID, 0)
+	}
+	userItem.Add(quantity)
+	return s.itemRepo.Save(ctx, userItem)
+}
+
+// publishEvents 发布领域事件（事务外调用）
+func (s *Service) publishEvents(ctx context.Context, events []any) {
+	if s.publisher == nil {
+		return
+	}
+	for _, event := range events {
+		if e, ok := event.(shared.Event); ok {
+			_ = s.publisher.Publish(ctx, e)
+		}
+	}
+}
+
+// toTradeDTO 转换交易DTO，补充昵称和道具名称（查询失败时留空）
+func (s *Service) toTradeDTO(ctx context.Context, t *social.Trade) *TradeDTO {
+	dto := &TradeDTO{
+		ID:              t.ID,
+		FromUserID:      t.FromUserID,
+		ToUserID:        t.ToUserID,
+		OfferItemID:     t.OfferItemID,
+		OfferQuantity:   t.OfferQuantity,
+		RequestItemID:   t.RequestItemID,
+		RequestQuantity: t.RequestQuantity,
+		Status:          t.Status.Name(),
+		StatusCode:      int(t.Status),
+		CreatedAt:       t.CreatedAt,
+		CompletedAt:     t.CompletedAt,
+	}
+
+	if u, err := s.userRepo.FindByID(ctx, t.FromUserID); err == nil {
+		dto.FromNickname = u.Nickname
+	}
+	if u, err := s.userRepo.FindByID(ctx, t.ToUserID); err == nil {
+		dto.ToNickname = u.Nickname
+	}
+	if def, err := s.itemRepo.GetDefinition(ctx, t.OfferItemID); err == nil {
+		dto.OfferItemName = def.Name
+	}
+	if def, err := s.itemRepo.GetDefinition(ctx, t.RequestItemID); err == nil {
+		dto.RequestItemName = def.Name
+	}
+	return dto
+}
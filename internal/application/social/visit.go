@@ -0,0 +1,210 @@
+// Package social 社交应用服务
+// 拜访好友 - 查看好友宠物并领取每日奖励
+package social
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"pets-server/internal/domain/pet"
+	"pets-server/internal/domain/social"
+	"pets-server/internal/domain/user"
+)
+
+// ============================================================
+// 拜访好友 (GET /api/social/visit/:userId)
+// 调用链路：
+//   Handler.VisitFriend()
+//     → AppService.VisitFriend()
+//       → UoW.Do() 开启事务
+//         → FriendRepo.FindByUsers() 必须是好友
+//         → user.LockPair() 锁定访客与主人
+//         → pet.ResolveActivePet() 获取主人的主宠物，StatusAt() 生成快照
+//         → VisitRepo.HasVisitedToday() 今天已拜访过则只返回快照
+//         → VisitRepo.CountTodayVisits() 主人当天接待数达到上限则拒绝
+//         → 按宠物 VisitBonus 计算奖励，双方加金币（幸运技能/寻宝能力可额外获得），好友加亲密度
+//         → trainVisitSkills() 主人宠物锻炼魅力，访客宠物锻炼幸运
+//         → VisitRepo.RecordVisit()
+//       → 事务提交
+//       → EventPublisher.Publish(FriendVisitedEvent) 通知主人
+// ============================================================
+
+// VisitFriend 拜访好友
+func (s *Service) VisitFriend(ctx context.Context, visitorID, hostID int) (*VisitResponse, error) {
+	if visitorID == hostID {
+		return nil, ErrVisitSelf
+	}
+
+	var response *VisitResponse
+	var events []any
+
+	err := s.uow.Do(ctx, func(txCtx context.Context) error {
+		now := time.Now()
+
+		// 1. 必须是好友
+		friendship, err := social.FindFriendship(txCtx, s.friendRepo, visitorID, hostID)
+		if err != nil {
+			return err
+		}
+
+		// 2. 锁定双方用户：同一主人的拜访串行执行，每日首次奖励与接待上限不会被并发请求重复领取
+		visitor, host, err := user.LockPair(txCtx, s.userRepo, visitorID, hostID)
+		if err != nil {
+			return err
+		}
+
+		// 获取主人的主宠物快照（只读，不落库）
+		p, err := pet.ResolveActivePet(txCtx, s.petRepo, hostID, host.ActivePetID)
+		if err != nil {
+			return err
+		}
+		response = &VisitResponse{Pet: toPetVisitDTO(p, host.Nickname, now)}
+
+		// 3. 每天只有首次拜访有奖励
+		visited, err := s.visitRepo.HasVisitedToday(txCtx, visitorID, hostID)
+		if err != nil {
+			return err
+		}
+		count, err := s.visitRepo.CountTodayVisits(txCtx, hostID)
+		if err != nil {
+			return err
+		}
+		if visited {
+			response.Intimacy = friendship.Intimacy
+			response.CanVisitMore = count < social.MaxDailyVisitsPerHost
+			return nil
+		}
+
+		// 4. 主人每天接待的访客有上限
+		if count >= social.MaxDailyVisitsPerHost {
+			return ErrVisitLimitReached
+		}
+
+		// 5. 发放奖励（按主人宠物的社交性加成，双方宠物的幸运可额外获得金币）
+		reward := social.CalculateVisitReward(p.Personality.VisitBonus())
+
+		visitorPet, err := s.findActivePet(txCtx, visitor.ActivePetID)
+		if err != nil {
+			return err
+		}
+		hostBonus := p.RollBonusCoins(reward.HostCoins)
+		visitorBonus := 0
+		if visitorPet != nil {
+			visitorBonus = visitorPet.RollBonusCoins(reward.VisitorCoins)
+		}
+		visitorCoins := reward.VisitorCoins + visitorBonus
+		hostCoins := reward.HostCoins + hostBonus
+
+		visitor.AddCoins(visitorCoins)
+		host.AddCoins(hostCoins)
+		if err := s.userRepo.Save(txCtx, visitor); err != nil {
+			return err
+		}
+		if err := s.userRepo.Save(txCtx, host); err != nil {
+			return err
+		}
+
+		friendship.AddIntimacy(reward.Intimacy)
+		if err := s.friendRepo.Save(txCtx, friendship); err != nil {
+			return err
+		}
+
+		// 6. 锻炼双方宠物的技能
+		skillEvents, err := s.trainVisitSkills(txCtx, p, visitorPet)
+		if err != nil {
+			return err
+		}
+		events = append(events, skillEvents...)
+
+		// 7. 记录拜访
+		if err := s.visitRepo.RecordVisit(txCtx, visitorID, hostID); err != nil {
+			return err
+		}
+
+		response.FirstVisitToday = true
+		response.RewardCoins = visitorCoins
+		response.HostRewardCoins = hostCoins
+		response.BonusCoins = visitorBonus
+		response.HostBonusCoins = hostBonus
+		response.IntimacyGained = reward.Intimacy
+		response.Intimacy = friendship.Intimacy
+		response.CanVisitMore = count+1 < social.MaxDailyVisitsPerHost
+
+		events = append(events, social.FriendVisitedEvent{
+			VisitorID:    visitorID,
+			HostID:       hostID,
+			PetID:        p.ID,
+			VisitorCoins: visitorCoins,
+			HostCoins:    hostCoins,
+			Intimacy:     reward.Intimacy,
+			Timestamp:    now,
+		})
+		return nil
+	})
+
+	if err != nil {
+		if errors.Is(err, pet.ErrPetNotFound) {
+			return nil, ErrPetNotFound
+		}
+		return nil, err
+	}
+
+	s.publishEvents(ctx, events)
+
+	return response, nil
+}
+
+// findActivePet 获取用户的主宠物，没有主宠物时返回 nil
+func (s *Service) findActivePet(ctx context.Context, activePetID *int) (*pet.Pet, error) {
+	if activePetID == nil {
+		return nil, nil
+	}
+	p, err := s.petRepo.FindByID(ctx, *activePetID)
+	if err != nil {
+		if errors.Is(err, pet.ErrPetNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return p, nil
+}
+
+// trainVisitSkills 被拜访锻炼主人宠物的魅力技能，领取奖励锻炼访客主宠物的幸运技能
+// 只有技能类型相符的宠物才会保存
+func (s *Service) trainVisitSkills(ctx context.Context, hostPet, visitorPet *pet.Pet) ([]any, error) {
+	var events []any
+
+	if pet.TrainingHosted.Trains(hostPet.Skill.Type) {
+		hostPet.TrainSkill(pet.TrainingHosted)
+		if err := s.petRepo.Save(ctx, hostPet); err != nil {
+			return nil, err
+		}
+		events = append(events, hostPet.Events()...)
+	}
+
+	if visitorPet != nil && pet.TrainingVisit.Trains(visitorPet.Skill.Type) {
+		visitorPet.TrainSkill(pet.TrainingVisit)
+		if err := s.petRepo.Save(ctx, visitorPet); err != nil {
+			return nil, err
+		}
+		events = append(events, visitorPet.Events()...)
+	}
+	return events, nil
+}
+
+func toPetVisitDTO(p *pet.Pet, ownerName string, now time.Time) PetVisitDTO {
+	hunger, happiness, cleanliness, energy := p.StatusAt(now)
+	return PetVisitDTO{
+		ID:          p.ID,
+		Name:        p.Name,
+		SpeciesID:   int(p.SpeciesID),
+		Level:       p.Level,
+		Stage:       p.Stage.Name(),
+		OwnerName:   ownerName,
+		Hunger:      hunger,
+		Happiness:   happiness,
+		Cleanliness: cleanliness,
+		Energy:      energy,
+	}
+}
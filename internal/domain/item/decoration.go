@@ -0,0 +1,93 @@
+// Package item 道具领域
+// 装饰品 - 槽位、穿戴与衰减减免
+package item
+
+import (
+	"time"
+
+	"pets-server/internal/domain/pet"
+)
+
+// 装饰槽位，每个槽位同时只能穿戴一件装饰
+const (
+	SlotHead      = "head"      // 头部
+	SlotBody      = "body"      // 身体
+	SlotAccessory = "accessory" // 配饰
+)
+
+// IsValidSlot 是否为合法槽位
+func IsValidSlot(slot string) bool {
+	return slot == SlotHead || slot == SlotBody || slot == SlotAccessory
+}
+
+// SlotName 获取槽位名称
+func SlotName(slot string) string {
+	names := map[string]string{
+		SlotHead:      "头部",
+		SlotBody:      "身体",
+		SlotAccessory: "配饰",
+	}
+	if name, ok := names[slot]; ok {
+		return name
+	}
+	return "未知"
+}
+
+// 装饰品被动效果类型（穿戴期间生效，不能直接使用）
+const (
+	EffectReduceHungerDecay      = "reduce_hunger_decay"      // 降低饱食度衰减（百分比）
+	EffectReduceHappinessDecay   = "reduce_happiness_decay"   // 降低快乐度衰减（百分比）
+	EffectReduceCleanlinessDecay = "reduce_cleanliness_decay" // 降低清洁度衰减（百分比）
+)
+
+// NewPetDecoration 为宠物穿戴装饰品
+func NewPetDecoration(petID int, def *ItemDefinition) (*PetDecoration, error) {
+	if def.Type != ItemTypeDecoration {
+		return nil, ErrNotDecoration
+	}
+	if !IsValidSlot(def.Slot) {
+		return nil, ErrInvalidSlot
+	}
+	return &PetDecoration{
+		PetID:      petID,
+		ItemID:     def.ID,
+		Slot:       def.Slot,
+		EquippedAt: time.Now(),
+	}, nil
+}
+
+// DecayBonus 获取装饰品提供的衰减减免
+// 装饰品没有配置被动效果或配置无法解析时不提供减免
+func (d *ItemDefinition) DecayBonus() pet.DecayBonus {
+	var bonus pet.DecayBonus
+	if d.Type != ItemTypeDecoration {
+		return bonus
+	}
+
+	effects, err := d.Effects()
+	if err != nil {
+		return bonus
+	}
+	for _, e := range effects {
+		switch e.Type {
+		case EffectReduceHungerDecay:
+			bonus.Hunger += e.Value
+		case EffectReduceHappinessDecay:
+			bonus.Happiness += e.Value
+		case EffectReduceCleanlinessDecay:
+			bonus.Cleanliness += e.Value
+		}
+	}
+	return bonus
+}
+
+// DecorationBonus 汇总宠物所有装饰提供的衰减减免
+func DecorationBonus(decorations []*PetDecoration, defs map[int]*ItemDefinition) pet.DecayBonus {
+	var bonus pet.DecayBonus
+	for _, d := range decorations {
+		if def, ok := defs[d.ItemID]; ok {
+			bonus = bonus.Add(def.DecayBonus())
+		}
+	}
+	return bonus.Capped()
+}
@@ -0,0 +1,85 @@
+// Package item 道具领域
+// 道具效果 - 效果声明解析与效果处理器接口
+package item
+
+import (
+	"strconv"
+	"strings"
+
+	"pets-server/internal/domain/pet"
+)
+
+// 内置道具效果类型（ItemDefinition.EffectType）
+const (
+	EffectRestoreHunger      = "restore_hunger"       // 恢复饱食度
+	EffectRestoreHappiness   = "restore_happiness"    // 恢复快乐度
+	EffectRestoreCleanliness = "restore_cleanliness"  // 恢复清洁度
+	EffectRestoreEnergy      = "restore_energy"       // 恢复精力
+	EffectAddExp             = "add_exp"              // 增加经验
+	EffectResetBreedCooldown = "reset_breed_cooldown" // 清除繁殖冷却
+	EffectRenamePet          = "rename_pet"           // 改名卡
+	EffectRerollSkill        = "reroll_skill"         // 技能重置
+	EffectShortenIncubation  = "shorten_incubation"   // 孵化器：缩短孵化时间（分钟）
+	EffectCureIllness        = "cure_illness"         // 药品：治愈疾病（数值为疾病类型，0 表示全部）
+	EffectAddSkillExp        = "add_skill_exp"        // 技能训练：增加技能经验
+)
+
+// Effect 单个道具效果（值对象）
+type Effect struct {
+	Type  string // 效果类型
+	Value int    // 效果数值
+}
+
+// Effects 解析道具的效果列表
+// EffectType 支持多个效果组合，格式为 "type[:value],type[:value]"，
+// 未写数值的效果使用 EffectValue
+func (d *ItemDefinition) Effects() ([]Effect, error) {
+	if strings.TrimSpace(d.EffectType) == "" {
+		return nil, nil
+	}
+
+	parts := strings.Split(d.EffectType, ",")
+	effects := make([]Effect, 0, len(parts))
+	for _, part := range parts {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+
+		effect := Effect{Type: part, Value: d.EffectValue}
+		if name, raw, ok := strings.Cut(part, ":"); ok {
+			value, err := strconv.Atoi(strings.TrimSpace(raw))
+			if err != nil {
+				return nil, ErrInvalidEffect
+			}
+			effect = Effect{Type: strings.TrimSpace(name), Value: value}
+		}
+		if effect.Type == "" {
+			return nil, ErrInvalidEffect
+		}
+		effects = append(effects, effect)
+	}
+	return effects, nil
+}
+
+// EffectArgs 使用道具时由玩家提供的附加参数
+type EffectArgs struct {
+	NewName string // 改名卡使用的新名字
+}
+
+// EffectContext 道具效果的作用上下文
+type EffectContext struct {
+	Pet  *pet.Pet        // 目标宠物
+	Item *ItemDefinition // 使用的道具
+	Args EffectArgs      // 附加参数
+}
+
+// EffectHandler 道具效果处理器
+// 每种效果类型对应一个处理器，通过效果工厂按类型名称获取
+type EffectHandler interface {
+	// Validate 检查效果能否作用于目标宠物（如成长阶段、参数），不修改状态
+	Validate(ctx *EffectContext, value int) error
+
+	// Apply 将效果作用于目标宠物，宠物实体负责记录领域事件
+	Apply(ctx *EffectContext, value int) error
+}
@@ -0,0 +1,31 @@
+package effect
+
+import (
+	"pets-server/internal/domain/item"
+	"pets-server/internal/domain/pet"
+)
+
+// ResetBreedCooldownEffect 繁殖冷却清除效果
+// 仅对成熟期及以后、且正处于繁殖冷却中的宠物有效
+type ResetBreedCooldownEffect struct{}
+
+// NewResetBreedCooldownEffect 创建繁殖冷却清除效果
+func NewResetBreedCooldownEffect() *ResetBreedCooldownEffect {
+	return &ResetBreedCooldownEffect{}
+}
+
+// Validate 检查宠物阶段与冷却状态
+func (e *ResetBreedCooldownEffect) Validate(ctx *item.EffectContext, _ int) error {
+	if ctx.Pet.Stage < pet.StageAdult {
+		return ErrStageNotAllowed
+	}
+	if ctx.Pet.LastBreedAt == nil {
+		return pet.ErrNoBreedCooldown
+	}
+	return nil
+}
+
+// Apply 清除繁殖冷却
+func (e *ResetBreedCooldownEffect) Apply(ctx *item.EffectContext, _ int) error {
+	return ctx.Pet.ResetBreedCooldown()
+}
@@ -0,0 +1,110 @@
+// Package effect 道具效果处理器
+package effect
+
+import (
+	"errors"
+
+	"pets-server/internal/domain/item"
+	"pets-server/internal/domain/pet"
+)
+
+// EffectFactory 道具效果工厂
+// 通过效果类型名称获取对应的效果处理器实例
+type EffectFactory struct {
+	creators map[string]func() item.EffectHandler
+}
+
+// NewEffectFactory 创建效果工厂
+func NewEffectFactory() *EffectFactory {
+	f := &EffectFactory{
+		creators: make(map[string]func() item.EffectHandler),
+	}
+
+	// 注册所有内置效果
+	// 状态恢复
+	f.Register(item.EffectRestoreHunger, func() item.EffectHandler { return NewRestoreStatusEffect(pet.StatusHunger) })
+	f.Register(item.EffectRestoreHappiness, func() item.EffectHandler { return NewRestoreStatusEffect(pet.StatusHappiness) })
+	f.Register(item.EffectRestoreCleanliness, func() item.EffectHandler { return NewRestoreStatusEffect(pet.StatusCleanliness) })
+	f.Register(item.EffectRestoreEnergy, func() item.EffectHandler { return NewRestoreStatusEffect(pet.StatusEnergy) })
+
+	// 成长
+	f.Register(item.EffectAddExp, func() item.EffectHandler { return NewAddExpEffect() })
+	f.Register(item.EffectRerollSkill, func() item.EffectHandler { return NewRerollSkillEffect() })
+	f.Register(item.EffectAddSkillExp, func() item.EffectHandler { return NewAddSkillExpEffect() })
+	f.Register(item.EffectShortenIncubation, func() item.EffectHandler { return NewShortenIncubationEffect() })
+
+	// 健康
+	f.Register(item.EffectCureIllness, func() item.EffectHandler { return NewCureIllnessEffect() })
+
+	// 繁殖
+	f.Register(item.EffectResetBreedCooldown, func() item.EffectHandler { return NewResetBreedCooldownEffect() })
+
+	// 其他
+	f.Register(item.EffectRenamePet, func() item.EffectHandler { return NewRenamePetEffect() })
+
+	return f
+}
+
+// Register 注册效果处理器创建函数
+func (f *EffectFactory) Register(effectType string, creator func() item.EffectHandler) {
+	f.creators[effectType] = creator
+}
+
+// Get 获取效果处理器实例
+// 如果效果类型不存在，返回 nil 和 false
+func (f *EffectFactory) Get(effectType string) (item.EffectHandler, bool) {
+	creator, ok := f.creators[effectType]
+	if !ok {
+		return nil, false
+	}
+	return creator(), true
+}
+
+// Has 检查是否存在指定类型的效果处理器
+func (f *EffectFactory) Has(effectType string) bool {
+	_, ok := f.creators[effectType]
+	return ok
+}
+
+// Types 获取所有已注册的效果类型名称
+func (f *EffectFactory) Types() []string {
+	types := make([]string, 0, len(f.creators))
+	for t := range f.creators {
+		types = append(types, t)
+	}
+	return types
+}
+
+// ApplyAll 依次应用组合效果
+// 先校验全部效果再统一应用，任一效果不满足条件时宠物不会被部分修改
+func (f *EffectFactory) ApplyAll(ctx *item.EffectContext, effects []item.Effect) error {
+	if len(effects) == 0 {
+		return item.ErrItemNotUsable
+	}
+
+	handlers := make([]item.EffectHandler, 0, len(effects))
+	for _, e := range effects {
+		handler, ok := f.Get(e.Type)
+		if !ok {
+			return item.ErrUnknownEffect
+		}
+		if err := handler.Validate(ctx, e.Value); err != nil {
+			return err
+		}
+		handlers = append(handlers, handler)
+	}
+
+	for i, handler := range handlers {
+		if err := handler.Apply(ctx, effects[i].Value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// 效果相关错误
+var (
+	ErrStageNotAllowed = errors.New("宠物当前成长阶段无法使用该道具")
+	ErrNameRequired    = errors.New("使用改名卡需要提供新名字")
+	ErrWrongMedicine   = errors.New("药品不对症")
+)
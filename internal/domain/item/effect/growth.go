@@ -0,0 +1,85 @@
+package effect
+
+import (
+	"pets-server/internal/domain/item"
+	"pets-server/internal/domain/pet"
+)
+
+// AddExpEffect 经验增加效果
+// 蛋阶段的宠物无法获得经验
+type AddExpEffect struct{}
+
+// NewAddExpEffect 创建经验增加效果
+func NewAddExpEffect() *AddExpEffect {
+	return &AddExpEffect{}
+}
+
+// Validate 检查宠物阶段与数值
+func (e *AddExpEffect) Validate(ctx *item.EffectContext, value int) error {
+	if value <= 0 {
+		return item.ErrInvalidEffect
+	}
+	if ctx.Pet.Stage == pet.StageEgg {
+		return pet.ErrPetIsEgg
+	}
+	return nil
+}
+
+// Apply 增加经验，可能触发升级和进化
+func (e *AddExpEffect) Apply(ctx *item.EffectContext, value int) error {
+	_, err := ctx.Pet.GainExp(value)
+	return err
+}
+
+// RerollSkillEffect 技能重置效果
+// 重新随机主技能基因位，技能从1级开始；蛋阶段技能尚未觉醒，不能重置
+type RerollSkillEffect struct{}
+
+// NewRerollSkillEffect 创建技能重置效果
+func NewRerollSkillEffect() *RerollSkillEffect {
+	return &RerollSkillEffect{}
+}
+
+// Validate 检查宠物阶段
+func (e *RerollSkillEffect) Validate(ctx *item.EffectContext, _ int) error {
+	if ctx.Pet.Stage == pet.StageEgg {
+		return ErrStageNotAllowed
+	}
+	return nil
+}
+
+// Apply 重置技能
+func (e *RerollSkillEffect) Apply(ctx *item.EffectContext, _ int) error {
+	return ctx.Pet.RerollSkill()
+}
+
+// AddSkillExpEffect 技能训练效果
+// 增加主技能经验（受学习速度加成），蛋阶段技能尚未觉醒，满级技能无法再训练
+type AddSkillExpEffect struct{}
+
+// NewAddSkillExpEffect 创建技能训练效果
+func NewAddSkillExpEffect() *AddSkillExpEffect {
+	return &AddSkillExpEffect{}
+}
+
+// Validate 检查宠物阶段、技能与数值
+func (e *AddSkillExpEffect) Validate(ctx *item.EffectContext, value int) error {
+	if value <= 0 {
+		return item.ErrInvalidEffect
+	}
+	if ctx.Pet.Stage == pet.StageEgg {
+		return ErrStageNotAllowed
+	}
+	if ctx.Pet.Skill.Type == pet.SkillTypeNone {
+		return pet.ErrNoSkill
+	}
+	if ctx.Pet.Skill.IsMaxLevel() {
+		return pet.ErrSkillMaxLevel
+	}
+	return nil
+}
+
+// Apply 增加技能经验，可能触发技能升级
+func (e *AddSkillExpEffect) Apply(ctx *item.EffectContext, value int) error {
+	return ctx.Pet.GainSkillExp(value)
+}
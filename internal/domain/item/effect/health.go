@@ -0,0 +1,37 @@
+package effect
+
+import (
+	"pets-server/internal/domain/item"
+	"pets-server/internal/domain/pet"
+)
+
+// CureIllnessEffect 药品效果
+// 数值为可治愈的疾病类型，0 表示可治愈所有疾病
+type CureIllnessEffect struct{}
+
+// NewCureIllnessEffect 创建药品效果
+func NewCureIllnessEffect() *CureIllnessEffect {
+	return &CureIllnessEffect{}
+}
+
+// Validate 检查宠物是否生病以及药品是否对症
+func (e *CureIllnessEffect) Validate(ctx *item.EffectContext, value int) error {
+	if value < 0 {
+		return item.ErrInvalidEffect
+	}
+	if ctx.Pet.Stage == pet.StageEgg {
+		return pet.ErrPetIsEgg
+	}
+	if !ctx.Pet.IsSick() {
+		return pet.ErrPetNotSick
+	}
+	if value > 0 && pet.Illness(value) != ctx.Pet.Illness {
+		return ErrWrongMedicine
+	}
+	return nil
+}
+
+// Apply 治愈疾病
+func (e *CureIllnessEffect) Apply(ctx *item.EffectContext, _ int) error {
+	return ctx.Pet.Cure()
+}
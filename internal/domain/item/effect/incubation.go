@@ -0,0 +1,33 @@
+package effect
+
+import (
+	"time"
+
+	"pets-server/internal/domain/item"
+	"pets-server/internal/domain/pet"
+)
+
+// ShortenIncubationEffect 孵化器效果
+// 按分钟缩短蛋的孵化时间，只对蛋有效
+type ShortenIncubationEffect struct{}
+
+// NewShortenIncubationEffect 创建孵化器效果
+func NewShortenIncubationEffect() *ShortenIncubationEffect {
+	return &ShortenIncubationEffect{}
+}
+
+// Validate 检查宠物阶段与数值
+func (e *ShortenIncubationEffect) Validate(ctx *item.EffectContext, value int) error {
+	if value <= 0 {
+		return item.ErrInvalidEffect
+	}
+	if ctx.Pet.Stage != pet.StageEgg {
+		return ErrStageNotAllowed
+	}
+	return nil
+}
+
+// Apply 缩短孵化时间
+func (e *ShortenIncubationEffect) Apply(ctx *item.EffectContext, value int) error {
+	return ctx.Pet.ShortenIncubation(time.Duration(value) * time.Minute)
+}
@@ -0,0 +1,29 @@
+package effect
+
+import (
+	"strings"
+
+	"pets-server/internal/domain/item"
+)
+
+// RenamePetEffect 改名卡效果
+// 任何阶段都可以改名，新名字由玩家在使用道具时提供
+type RenamePetEffect struct{}
+
+// NewRenamePetEffect 创建改名效果
+func NewRenamePetEffect() *RenamePetEffect {
+	return &RenamePetEffect{}
+}
+
+// Validate 检查是否提供了新名字
+func (e *RenamePetEffect) Validate(ctx *item.EffectContext, _ int) error {
+	if strings.TrimSpace(ctx.Args.NewName) == "" {
+		return ErrNameRequired
+	}
+	return nil
+}
+
+// Apply 改名
+func (e *RenamePetEffect) Apply(ctx *item.EffectContext, _ int) error {
+	return ctx.Pet.Rename(ctx.Args.NewName)
+}
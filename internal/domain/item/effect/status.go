@@ -0,0 +1,33 @@
+package effect
+
+import (
+	"pets-server/internal/domain/item"
+	"pets-server/internal/domain/pet"
+)
+
+// RestoreStatusEffect 状态恢复效果（饱食度、快乐度、清洁度、精力）
+// 蛋阶段的宠物无法恢复状态
+type RestoreStatusEffect struct {
+	status pet.StatusType
+}
+
+// NewRestoreStatusEffect 创建状态恢复效果
+func NewRestoreStatusEffect(status pet.StatusType) *RestoreStatusEffect {
+	return &RestoreStatusEffect{status: status}
+}
+
+// Validate 检查宠物阶段与数值
+func (e *RestoreStatusEffect) Validate(ctx *item.EffectContext, value int) error {
+	if value <= 0 {
+		return item.ErrInvalidEffect
+	}
+	if ctx.Pet.Stage == pet.StageEgg {
+		return pet.ErrPetIsEgg
+	}
+	return nil
+}
+
+// Apply 恢复状态
+func (e *RestoreStatusEffect) Apply(ctx *item.EffectContext, value int) error {
+	return ctx.Pet.RestoreStatus(e.status, value)
+}
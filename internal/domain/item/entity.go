@@ -18,6 +18,37 @@ const (
 	ItemTypeSpecial    ItemType = 5 // 特殊道具
 )
 
+// Name 获取道具类型名称
+func (t ItemType) Name() string {
+	names := map[ItemType]string{
+		ItemTypeFood:       "食物",
+		ItemTypeClean:      "清洁用品",
+		ItemTypeToy:        "玩具",
+		ItemTypeDecoration: "装饰品",
+		ItemTypeSpecial:    "特殊道具",
+	}
+	if name, ok := names[t]; ok {
+		return name
+	}
+	return "未知"
+}
+
+// Currency 购买道具使用的货币
+type Currency int
+
+const (
+	CurrencyCoins    Currency = 0 // 金币
+	CurrencyDiamonds Currency = 1 // 钻石
+)
+
+// Name 获取货币名称
+func (c Currency) Name() string {
+	if c == CurrencyDiamonds {
+		return "钻石"
+	}
+	return "金币"
+}
+
 // ItemDefinition 道具定义（值对象）
 // 定义道具的基本属性，不可变
 type ItemDefinition struct {
@@ -25,10 +56,28 @@ type ItemDefinition struct {
 	Name        string
 	Description string
 	Type        ItemType
-	EffectType  string // 效果类型
-	EffectValue int    // 效果数值
-	Price       int    // 购买价格
-	Rarity      int    // 稀有度 1-4
+	EffectType  string   // 效果类型，多个效果用逗号分隔，如 "restore_hunger:30,add_exp:10"
+	EffectValue int      // 效果数值
+	Price       int      // 购买价格（0 表示商店不出售）
+	Currency    Currency // 购买货币
+	Rarity      int      // 稀有度 1-4
+	Slot        string   // 装饰品槽位（仅装饰品）: head, body, accessory
+}
+
+// IsForSale 是否在商店出售
+func (d *ItemDefinition) IsForSale() bool {
+	return d.Price > 0
+}
+
+// TotalPrice 购买指定数量的总价
+func (d *ItemDefinition) TotalPrice(quantity int) (int, error) {
+	if quantity <= 0 {
+		return 0, ErrInvalidAmount
+	}
+	if !d.IsForSale() {
+		return 0, ErrItemNotForSale
+	}
+	return d.Price * quantity, nil
 }
 
 // UserItem 用户道具（实体）
@@ -36,8 +85,8 @@ type ItemDefinition struct {
 type UserItem struct {
 	ID        int
 	UserID    int
-	ItemID    int      // 对应 ItemDefinition.ID
-	Quantity  int      // 数量
+	ItemID    int // 对应 ItemDefinition.ID
+	Quantity  int // 数量
 	CreatedAt time.Time
 }
 
@@ -90,5 +139,13 @@ var (
 	ErrInvalidAmount    = errors.New("无效的数量")
 	ErrInsufficientItem = errors.New("道具数量不足")
 	ErrItemNotFound     = errors.New("道具不存在")
-)
+	ErrItemNotForSale   = errors.New("该道具不在商店出售")
+	ErrItemNotUsable    = errors.New("该道具不能直接使用")
+	ErrUnknownEffect    = errors.New("未知的道具效果")
+	ErrInvalidEffect    = errors.New("无效的道具效果配置")
 
+	ErrDecorationNotFound = errors.New("该槽位没有装饰")
+	ErrNotDecoration      = errors.New("该道具不是装饰品")
+	ErrInvalidSlot        = errors.New("无效的装饰槽位")
+	ErrSlotOccupied       = errors.New("该槽位已有装饰，请先卸下")
+)
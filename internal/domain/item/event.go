@@ -0,0 +1,51 @@
+// Package item 道具领域
+// 领域事件定义
+package item
+
+import "time"
+
+// ItemPurchasedEvent 道具购买事件
+type ItemPurchasedEvent struct {
+	UserID    int       `json:"user_id"`
+	ItemID    int       `json:"item_id"`
+	Quantity  int       `json:"quantity"`
+	Currency  int       `json:"currency"`
+	Cost      int       `json:"cost"`
+	Timestamp time.Time `json:"timestamp"`
+}
+
+func (e ItemPurchasedEvent) EventName() string { return "item.purchased" }
+
+// ItemUsedEvent 道具使用事件
+type ItemUsedEvent struct {
+	UserID      int       `json:"user_id"`
+	PetID       int       `json:"pet_id"`
+	ItemID      int       `json:"item_id"`
+	EffectType  string    `json:"effect_type"`
+	EffectValue int       `json:"effect_value"`
+	Timestamp   time.Time `json:"timestamp"`
+}
+
+func (e ItemUsedEvent) EventName() string { return "item.used" }
+
+// DecorationEquippedEvent 装饰品穿戴事件
+type DecorationEquippedEvent struct {
+	UserID    int       `json:"user_id"`
+	PetID     int       `json:"pet_id"`
+	ItemID    int       `json:"item_id"`
+	Slot      string    `json:"slot"`
+	Timestamp time.Time `json:"timestamp"`
+}
+
+func (e DecorationEquippedEvent) EventName() string { return "item.decoration_equipped" }
+
+// DecorationUnequippedEvent 装饰品卸下事件
+type DecorationUnequippedEvent struct {
+	UserID    int       `json:"user_id"`
+	PetID     int       `json:"pet_id"`
+	ItemID    int       `json:"item_id"`
+	Slot      string    `json:"slot"`
+	Timestamp time.Time `json:"timestamp"`
+}
+
+func (e DecorationUnequippedEvent) EventName() string { return "item.decoration_unequipped" }
@@ -14,6 +14,10 @@ type Repository interface {
 	// FindByUserAndItem 根据用户ID和道具ID查找
 	FindByUserAndItem(ctx context.Context, userID int, itemID int) (*UserItem, error)
 
+	// FindByUserAndItemForUpdate 根据用户ID和道具ID查找并锁定（须在事务中调用）
+	// 读取数量后再扣减保存的流程需要先加锁，避免并发扣减重复消耗
+	FindByUserAndItemForUpdate(ctx context.Context, userID int, itemID int) (*UserItem, error)
+
 	// FindByUserID 获取用户所有道具
 	FindByUserID(ctx context.Context, userID int) ([]*UserItem, error)
 
@@ -0,0 +1,115 @@
+// Package pet 宠物领域
+// Ability 特殊能力 - 由基因特殊能力位解码的被动效果
+package pet
+
+// Ability 特殊能力
+type Ability int
+
+const (
+	AbilityNone        Ability = iota // 无
+	AbilityCoinFinder                 // 寻宝：获得金币时更容易额外获得
+	AbilityFertile                    // 多产：繁殖冷却缩短
+	AbilityIronStomach                // 铁胃：饱食度衰减减缓
+	AbilityImmune                     // 强健：患病概率减半
+	AbilityFastLearner                // 聪慧：技能经验增加
+)
+
+// 特殊能力参数
+const (
+	CoinFinderChance     = 15   // 寻宝能力额外获得金币的概率（%）
+	FertileCooldownRate  = 0.7  // 多产能力的繁殖冷却倍数
+	IronStomachDecayRate = 0.85 // 铁胃能力的饱食度衰减倍数
+	ImmuneIllnessRate    = 0.5  // 强健能力的患病概率倍数
+	FastLearnerSkillExp  = 1.25 // 聪慧能力的技能经验倍数
+	LuckyBaseChance      = 10   // 幸运技能额外获得金币的基础概率（%）
+	LuckyChancePerLevel  = 5    // 幸运技能每级增加的概率（%）
+)
+
+// AbilityFromGene 将特殊能力基因位（0-15）解码为能力
+// 0-5 没有能力，其余每两个值对应一种能力
+func AbilityFromGene(raw int) Ability {
+	if raw < 6 {
+		return AbilityNone
+	}
+	return Ability((raw-6)/2 + 1)
+}
+
+// Name 能力名称
+func (a Ability) Name() string {
+	names := map[Ability]string{
+		AbilityNone:        "无",
+		AbilityCoinFinder:  "寻宝",
+		AbilityFertile:     "多产",
+		AbilityIronStomach: "铁胃",
+		AbilityImmune:      "强健",
+		AbilityFastLearner: "聪慧",
+	}
+	if name, ok := names[a]; ok {
+		return name
+	}
+	return "未知"
+}
+
+// Description 能力描述
+func (a Ability) Description() string {
+	descs := map[Ability]string{
+		AbilityNone:        "没有特殊能力",
+		AbilityCoinFinder:  "获得金币时有概率额外获得",
+		AbilityFertile:     "繁殖冷却时间缩短",
+		AbilityIronStomach: "饱食度衰减减缓",
+		AbilityImmune:      "不容易生病",
+		AbilityFastLearner: "技能训练更快",
+	}
+	if desc, ok := descs[a]; ok {
+		return desc
+	}
+	return "未知能力"
+}
+
+// Abilities 宠物的特殊能力（最多两个，重复的只算一个）
+// 蛋阶段能力尚未觉醒
+func (p *Pet) Abilities() []Ability {
+	if p.Stage == StageEgg {
+		return nil
+	}
+	var abilities []Ability
+	for _, raw := range []int{p.Gene.AbilityA(), p.Gene.AbilityB()} {
+		a := AbilityFromGene(raw)
+		if a == AbilityNone || (len(abilities) > 0 && abilities[0] == a) {
+			continue
+		}
+		abilities = append(abilities, a)
+	}
+	return abilities
+}
+
+// HasAbility 是否拥有指定能力
+func (p *Pet) HasAbility(ability Ability) bool {
+	for _, a := range p.Abilities() {
+		if a == ability {
+			return true
+		}
+	}
+	return false
+}
+
+// BonusCoinChance 获得金币时额外获得的概率（%）
+// 来自幸运技能（主技能或副技能）和寻宝能力
+func (p *Pet) BonusCoinChance() int {
+	chance := 0
+	if skill, ok := p.activeSkill(SkillTypeLucky); ok {
+		chance += LuckyBaseChance + skill.Level*LuckyChancePerLevel
+	}
+	if p.HasAbility(AbilityCoinFinder) {
+		chance += CoinFinderChance
+	}
+	return minInt(chance, 100)
+}
+
+// RollBonusCoins 获得金币时掷骰，命中时额外获得一半金币（至少1枚）
+func (p *Pet) RollBonusCoins(coins int) int {
+	if coins <= 0 || randomInt(100) >= p.BonusCoinChance() {
+		return 0
+	}
+	return maxInt(coins/2, 1)
+}
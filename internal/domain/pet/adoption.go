@@ -0,0 +1,260 @@
+// Package pet 宠物领域
+// Adoption 领养市场 - 宠物在主人之间转让
+package pet
+
+import (
+	"errors"
+	"time"
+)
+
+// AdoptionCurrency 领养标价货币
+type AdoptionCurrency int
+
+const (
+	AdoptionCurrencyCoins    AdoptionCurrency = 0 // 金币
+	AdoptionCurrencyDiamonds AdoptionCurrency = 1 // 钻石
+	AdoptionCurrencyFree     AdoptionCurrency = 2 // 免费赠送（仅限好友）
+)
+
+// Name 获取货币名称
+func (c AdoptionCurrency) Name() string {
+	names := []string{"金币", "钻石", "赠送"}
+	if c.IsValid() {
+		return names[c]
+	}
+	return "未知"
+}
+
+// IsValid 是否为合法的标价货币
+func (c AdoptionCurrency) IsValid() bool {
+	return c >= AdoptionCurrencyCoins && c <= AdoptionCurrencyFree
+}
+
+// AdoptionStatus 领养挂单状态
+type AdoptionStatus int
+
+const (
+	AdoptionStatusOpen      AdoptionStatus = 0 // 挂单中
+	AdoptionStatusCompleted AdoptionStatus = 1 // 已被领养
+	AdoptionStatusCancelled AdoptionStatus = 2 // 已撤回
+)
+
+// Name 获取状态名称
+func (s AdoptionStatus) Name() string {
+	names := []string{"挂单中", "已领养", "已撤回"}
+	if int(s) >= 0 && int(s) < len(names) {
+		return names[s]
+	}
+	return "未知"
+}
+
+// TransferMethod 宠物易主方式
+type TransferMethod int
+
+const (
+	TransferMethodSale TransferMethod = 0 // 出售
+	TransferMethodGift TransferMethod = 1 // 赠送
+)
+
+// Name 获取易主方式名称
+func (m TransferMethod) Name() string {
+	if m == TransferMethodGift {
+		return "赠送"
+	}
+	return "出售"
+}
+
+// AdoptionListing 领养挂单实体
+// 主人把宠物挂到领养市场出售，或指定赠送给某位好友
+type AdoptionListing struct {
+	ID          int
+	PetID       int
+	SellerID    int              // 原主人
+	Currency    AdoptionCurrency // 标价货币
+	Price       int              // 标价（赠送为0）
+	RecipientID *int             // 指定领养人（赠送时必填）
+	BuyerID     *int             // 实际领养人
+	Status      AdoptionStatus
+	CreatedAt   time.Time
+	CompletedAt *time.Time
+
+	events []any
+}
+
+// NewAdoptionListing 创建出售挂单
+func NewAdoptionListing(petID, sellerID int, currency AdoptionCurrency, price int) (*AdoptionListing, error) {
+	if currency != AdoptionCurrencyCoins && currency != AdoptionCurrencyDiamonds {
+		return nil, ErrInvalidAdoptionPrice
+	}
+	if price <= 0 {
+		return nil, ErrInvalidAdoptionPrice
+	}
+
+	return &AdoptionListing{
+		PetID:     petID,
+		SellerID:  sellerID,
+		Currency:  currency,
+		Price:     price,
+		Status:    AdoptionStatusOpen,
+		CreatedAt: time.Now(),
+	}, nil
+}
+
+// NewGiftListing 创建赠送挂单，只有指定的好友可以领养
+func NewGiftListing(petID, sellerID, recipientID int) (*AdoptionListing, error) {
+	if sellerID == recipientID {
+		return nil, ErrAdoptSelf
+	}
+
+	return &AdoptionListing{
+		PetID:       petID,
+		SellerID:    sellerID,
+		Currency:    AdoptionCurrencyFree,
+		RecipientID: &recipientID,
+		Status:      AdoptionStatusOpen,
+		CreatedAt:   time.Now(),
+	}, nil
+}
+
+// Listed 记录挂单事件（保存获得ID后调用）
+func (l *AdoptionListing) Listed() {
+	l.addEvent(AdoptionListedEvent{
+		ListingID:   l.ID,
+		PetID:       l.PetID,
+		SellerID:    l.SellerID,
+		RecipientID: l.RecipientID,
+		Currency:    int(l.Currency),
+		Price:       l.Price,
+		Timestamp:   l.CreatedAt,
+	})
+}
+
+// IsGift 是否为赠送
+func (l *AdoptionListing) IsGift() bool {
+	return l.Currency == AdoptionCurrencyFree
+}
+
+// Method 易主方式
+func (l *AdoptionListing) Method() TransferMethod {
+	if l.IsGift() {
+		return TransferMethodGift
+	}
+	return TransferMethodSale
+}
+
+// CanAdopt 检查用户能否领养该挂单
+func (l *AdoptionListing) CanAdopt(buyerID int) error {
+	if l.Status != AdoptionStatusOpen {
+		return ErrInvalidAdoptionStatus
+	}
+	if buyerID == l.SellerID {
+		return ErrAdoptSelf
+	}
+	if l.RecipientID != nil && *l.RecipientID != buyerID {
+		return ErrNotAdoptionRecipient
+	}
+	return nil
+}
+
+// Complete 完成领养
+func (l *AdoptionListing) Complete(buyerID int) error {
+	if err := l.CanAdopt(buyerID); err != nil {
+		return err
+	}
+
+	now := time.Now()
+	l.Status = AdoptionStatusCompleted
+	l.BuyerID = &buyerID
+	l.CompletedAt = &now
+
+	l.addEvent(AdoptionCompletedEvent{
+		ListingID: l.ID,
+		PetID:     l.PetID,
+		SellerID:  l.SellerID,
+		BuyerID:   buyerID,
+		Currency:  int(l.Currency),
+		Price:     l.Price,
+		Timestamp: now,
+	})
+	return nil
+}
+
+// Cancel 撤回挂单（仅原主人）
+func (l *AdoptionListing) Cancel(byUserID int) error {
+	if byUserID != l.SellerID {
+		return ErrNotAdoptionSeller
+	}
+	if l.Status != AdoptionStatusOpen {
+		return ErrInvalidAdoptionStatus
+	}
+
+	now := time.Now()
+	l.Status = AdoptionStatusCancelled
+	l.CompletedAt = &now
+
+	l.addEvent(AdoptionCancelledEvent{
+		ListingID: l.ID,
+		PetID:     l.PetID,
+		SellerID:  l.SellerID,
+		Timestamp: now,
+	})
+	return nil
+}
+
+// --- 领域事件 ---
+
+func (l *AdoptionListing) addEvent(event any) {
+	l.events = append(l.events, event)
+}
+
+// Events 获取并清空事件
+func (l *AdoptionListing) Events() []any {
+	events := l.events
+	l.events = nil
+	return events
+}
+
+// OwnershipRecord 宠物易主记录（值对象）
+// 按时间顺序记录宠物经历过的每一任主人，用于追溯来历
+type OwnershipRecord struct {
+	ID            int
+	PetID         int
+	FromUserID    int
+	ToUserID      int
+	Method        TransferMethod
+	Currency      AdoptionCurrency
+	Price         int
+	ListingID     int
+	TransferredAt time.Time
+}
+
+// NewOwnershipRecord 根据完成的挂单生成易主记录
+func NewOwnershipRecord(l *AdoptionListing) OwnershipRecord {
+	record := OwnershipRecord{
+		PetID:         l.PetID,
+		FromUserID:    l.SellerID,
+		Method:        l.Method(),
+		Currency:      l.Currency,
+		Price:         l.Price,
+		ListingID:     l.ID,
+		TransferredAt: time.Now(),
+	}
+	if l.BuyerID != nil {
+		record.ToUserID = *l.BuyerID
+	}
+	if l.CompletedAt != nil {
+		record.TransferredAt = *l.CompletedAt
+	}
+	return record
+}
+
+// 领养相关错误
+var (
+	ErrAdoptionNotFound      = errors.New("领养挂单不存在")
+	ErrInvalidAdoptionStatus = errors.New("无效的挂单状态")
+	ErrInvalidAdoptionPrice  = errors.New("无效的领养标价")
+	ErrAdoptSelf             = errors.New("不能领养自己的宠物")
+	ErrNotAdoptionRecipient  = errors.New("该宠物只赠送给指定好友")
+	ErrNotAdoptionSeller     = errors.New("非挂单主人")
+	ErrPetAlreadyListed      = errors.New("该宠物已在领养市场挂单")
+)
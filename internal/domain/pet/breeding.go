@@ -10,13 +10,15 @@ import (
 type BreedingService struct {
 	speciesRegistry *SpeciesRegistry
 	fusionRegistry  *SpeciesFusionRegistry
+	inbreedingRules InbreedingRules
 }
 
 // NewBreedingService 创建繁衍服务
-func NewBreedingService(speciesRegistry *SpeciesRegistry, fusionRegistry *SpeciesFusionRegistry) *BreedingService {
+func NewBreedingService(speciesRegistry *SpeciesRegistry, fusionRegistry *SpeciesFusionRegistry, inbreedingRules InbreedingRules) *BreedingService {
 	return &BreedingService{
 		speciesRegistry: speciesRegistry,
 		fusionRegistry:  fusionRegistry,
+		inbreedingRules: inbreedingRules.Normalize(),
 	}
 }
 
@@ -26,6 +28,19 @@ type BreedingRequest struct {
 	Parent2   *Pet   // 母方 (分裂繁殖时为nil)
 	ChildName string // 子代名称
 	OwnerID   int    // 子代所有者ID
+
+	// Litter 一窝多胎时每只子代的所有者和名称（为空时只产一只，归 OwnerID）
+	// 仅有性繁殖支持，用于跨主人繁殖契约分配后代
+	Litter []LitterSlot
+
+	// Ancestry 双亲的祖先图谱，用于计算近交系数（为空时只识别双亲间的直接亲缘）
+	Ancestry *LineageGraph
+}
+
+// LitterSlot 同窝单只子代的归属
+type LitterSlot struct {
+	OwnerID   int    // 所有者ID
+	ChildName string // 子代名称
 }
 
 // BreedingResult 繁殖结果
@@ -35,6 +50,8 @@ type BreedingResult struct {
 	Gender      Gender    // 子代性别
 	IsHidden    bool      // 是否触发隐藏物种
 	FusionFrom  []SpeciesID // 融合来源物种
+	Litter      []*Pet      // 同窝全部子代（第一只即 Child）
+	Inbreeding  Inbreeding  // 近亲程度及其对后代基因的影响
 }
 
 // Breed 执行繁殖
@@ -66,8 +83,9 @@ func (s *BreedingService) selfBreed(req BreedingRequest) (*BreedingResult, error
 		return nil, err
 	}
 
-	// 自我复制基因（15%突变率）
+	// 自我复制基因（15%突变率），启用孟德尔遗传时突变后重新整理显隐性
 	childGene := SelfReplicate(parent.Gene, 0.15)
+	childGene = species.Genetics.Normalize(childGene)
 
 	// 确定性别（分裂繁殖继承相同性别/无性别）
 	childGender := parent.Gender
@@ -88,6 +106,7 @@ func (s *BreedingService) selfBreed(req BreedingRequest) (*BreedingResult, error
 	if interpreter, ok := s.speciesRegistry.GetInterpreter(parent.SpeciesID); ok {
 		child.SetSpecialAppearance(interpreter.InterpretSpecialFeatures(childGene))
 	}
+	s.speciesRegistry.InitLifecycle(child)
 
 	// 标记父方已繁殖
 	parent.MarkBred()
@@ -97,6 +116,7 @@ func (s *BreedingService) selfBreed(req BreedingRequest) (*BreedingResult, error
 		SpeciesID: parent.SpeciesID,
 		Gender:    childGender,
 		IsHidden:  false,
+		Litter:    []*Pet{child},
 	}, nil
 }
 
@@ -128,6 +148,44 @@ func (s *BreedingService) sexualBreed(req BreedingRequest) (*BreedingResult, err
 		return nil, err
 	}
 
+	// 检查近亲程度
+	inbreeding := s.AssessInbreeding(parent1, parent2, req.Ancestry)
+	if inbreeding.Blocked {
+		return nil, ErrTooInbred
+	}
+
+	slots := req.Litter
+	if len(slots) == 0 {
+		slots = []LitterSlot{{OwnerID: req.OwnerID, ChildName: req.ChildName}}
+	}
+
+	var result *BreedingResult
+	for _, slot := range slots {
+		child, isHidden := s.breedChild(parent1, parent2, species1, slot, inbreeding)
+		if result == nil {
+			result = &BreedingResult{
+				Child:      child,
+				SpeciesID:  child.SpeciesID,
+				Gender:     child.Gender,
+				Inbreeding: inbreeding,
+			}
+		}
+		if isHidden {
+			result.IsHidden = true
+			result.FusionFrom = []SpeciesID{parent1.SpeciesID, parent2.SpeciesID}
+		}
+		result.Litter = append(result.Litter, child)
+	}
+
+	// 标记双亲已繁殖（一窝只计一次）
+	parent1.MarkBred()
+	parent2.MarkBred()
+
+	return result, nil
+}
+
+// breedChild 由双亲生成单只子代
+func (s *BreedingService) breedChild(parent1, parent2 *Pet, species1 *Species, slot LitterSlot, inbreeding Inbreeding) (*Pet, bool) {
 	// 基因遗传
 	childGene := InheritFrom(parent1.Gene, parent2.Gene)
 
@@ -140,16 +198,24 @@ func (s *BreedingService) sexualBreed(req BreedingRequest) (*BreedingResult, err
 		// 如果隐藏物种不存在，回退到父方物种
 		childSpeciesID = parent1.SpeciesID
 		childSpecies = species1
+		isHidden = false
 	}
 
+	// 子代物种启用孟德尔遗传时，性状组按双亲的等位基因重新遗传
+	childGene = childSpecies.Genetics.Inherit(childGene, parent1.Gene, parent2.Gene)
+
+	// 近亲繁殖时额外突变并降低抗性，在性状组遗传之后施加以免被覆盖；
+	// 突变后重新整理性状组，保证显性等位基因仍在显现位
+	childGene = childSpecies.Genetics.Normalize(inbreeding.ApplyTo(childGene))
+
 	// 确定子代性别
 	childGender := DetermineChildGender(parent1, parent2, childGene, childSpecies.GenderRule)
 
 	// 创建子代
 	generation := maxInt(parent1.Generation, parent2.Generation) + 1
 	child := NewPetFromBreeding(
-		req.OwnerID,
-		req.ChildName,
+		slot.OwnerID,
+		slot.ChildName,
 		childSpeciesID,
 		childGene,
 		childGender,
@@ -162,24 +228,9 @@ func (s *BreedingService) sexualBreed(req BreedingRequest) (*BreedingResult, err
 	if interpreter, ok := s.speciesRegistry.GetInterpreter(childSpeciesID); ok {
 		child.SetSpecialAppearance(interpreter.InterpretSpecialFeatures(childGene))
 	}
+	s.speciesRegistry.InitLifecycle(child)
 
-	// 标记双亲已繁殖
-	parent1.MarkBred()
-	parent2.MarkBred()
-
-	// 构建结果
-	result := &BreedingResult{
-		Child:     child,
-		SpeciesID: childSpeciesID,
-		Gender:    childGender,
-		IsHidden:  isHidden,
-	}
-
-	if isHidden {
-		result.FusionFrom = []SpeciesID{parent1.SpeciesID, parent2.SpeciesID}
-	}
-
-	return result, nil
+	return child, isHidden
 }
 
 // determineChildSpecies 确定子代物种
@@ -217,7 +268,8 @@ func (s *BreedingService) determineChildSpecies(parent1, parent2 *Pet, childGene
 }
 
 // CanBreedPair 检查两只宠物是否可以繁殖
-func (s *BreedingService) CanBreedPair(parent1, parent2 *Pet) error {
+// ancestry 为双亲的祖先图谱，为空时只识别双亲间的直接亲缘
+func (s *BreedingService) CanBreedPair(parent1, parent2 *Pet, ancestry *LineageGraph) error {
 	// 获取物种定义
 	species1, ok := s.speciesRegistry.Get(parent1.SpeciesID)
 	if !ok {
@@ -241,9 +293,24 @@ func (s *BreedingService) CanBreedPair(parent1, parent2 *Pet) error {
 		return err
 	}
 
+	// 检查近亲程度
+	if s.AssessInbreeding(parent1, parent2, ancestry).Blocked {
+		return ErrTooInbred
+	}
+
 	return nil
 }
 
+// AssessInbreeding 评估两只宠物繁殖的近亲程度
+// ancestry 为双亲的祖先图谱，为空时按双亲自身记录的父母构建，只能识别亲子、同胞等直接亲缘
+func (s *BreedingService) AssessInbreeding(parent1, parent2 *Pet, ancestry *LineageGraph) Inbreeding {
+	if ancestry == nil {
+		ancestry = NewLineageGraph(LineageNodeFromPet(parent1, 0))
+		ancestry.AddNode(LineageNodeFromPet(parent2, 0))
+	}
+	return s.inbreedingRules.Assess(ancestry.InbreedingOf(parent1.ID, parent2.ID))
+}
+
 // CanSelfBreed 检查宠物是否可以分裂繁殖
 func (s *BreedingService) CanSelfBreed(pet *Pet) error {
 	species, ok := s.speciesRegistry.Get(pet.SpeciesID)
@@ -269,14 +336,7 @@ func (s *BreedingService) GetBreedingCooldown(pet *Pet) time.Duration {
 		return 0
 	}
 
-	var cooldownHours int
-	if pet.Gender == GenderNone {
-		cooldownHours = species.BreedRules.SelfBreedCooldownHours
-	} else {
-		cooldownHours = species.BreedRules.CooldownHours
-	}
-
-	cooldown := time.Duration(cooldownHours) * time.Hour
+	cooldown := pet.BreedCooldown(species.BreedRules)
 	elapsed := time.Since(*pet.LastBreedAt)
 
 	if elapsed >= cooldown {
@@ -285,15 +345,24 @@ func (s *BreedingService) GetBreedingCooldown(pet *Pet) time.Duration {
 	return cooldown - elapsed
 }
 
-// PredictOffspringSpecies 预测后代可能的物种
-func (s *BreedingService) PredictOffspringSpecies(parent1, parent2 *Pet) []SpeciesProbability {
+// PredictOffspringSpecies 预测后代可能的物种及近亲程度（分裂繁殖不计近交系数）
+func (s *BreedingService) PredictOffspringSpecies(parent1, parent2 *Pet, ancestry *LineageGraph) ([]SpeciesProbability, Inbreeding) {
 	var result []SpeciesProbability
 
+	// 分裂繁殖
+	if parent2 == nil {
+		return []SpeciesProbability{
+			{SpeciesID: parent1.SpeciesID, Probability: 100},
+		}, Inbreeding{}
+	}
+
+	inbreeding := s.AssessInbreeding(parent1, parent2, ancestry)
+
 	// 同物种
 	if parent1.SpeciesID == parent2.SpeciesID {
 		return []SpeciesProbability{
 			{SpeciesID: parent1.SpeciesID, Probability: 100},
-		}
+		}, inbreeding
 	}
 
 	// 跨物种
@@ -328,7 +397,7 @@ func (s *BreedingService) PredictOffspringSpecies(parent1, parent2 *Pet) []Speci
 		}
 	}
 
-	return result
+	return result, inbreeding
 }
 
 // SpeciesProbability 物种概率
@@ -0,0 +1,222 @@
+// Package pet 宠物领域
+// BreedingContract 繁殖契约 - 不同主人之间的宠物繁殖协议
+package pet
+
+import (
+	"errors"
+	"time"
+)
+
+// BreedingContractTTL 契约有效期，超时未处理自动过期
+const BreedingContractTTL = 24 * time.Hour
+
+// ContractStatus 契约状态
+type ContractStatus int
+
+const (
+	ContractStatusPending  ContractStatus = 0 // 待对方确认
+	ContractStatusAccepted ContractStatus = 1 // 已接受（已繁殖）
+	ContractStatusRejected ContractStatus = 2 // 已拒绝
+	ContractStatusExpired  ContractStatus = 3 // 已过期
+)
+
+// Name 获取状态名称
+func (s ContractStatus) Name() string {
+	names := []string{"待确认", "已接受", "已拒绝", "已过期"}
+	if int(s) >= 0 && int(s) < len(names) {
+		return names[s]
+	}
+	return "未知"
+}
+
+// OffspringAssignment 后代归属方式
+type OffspringAssignment int
+
+const (
+	OffspringToProposer OffspringAssignment = 0 // 后代归发起方
+	OffspringToPartner  OffspringAssignment = 1 // 后代归接受方
+	OffspringSplit      OffspringAssignment = 2 // 一窝双胎，双方各得一只
+)
+
+// Name 获取归属方式名称
+func (a OffspringAssignment) Name() string {
+	names := []string{"归发起方", "归接受方", "双方各一只"}
+	if a.IsValid() {
+		return names[a]
+	}
+	return "未知"
+}
+
+// IsValid 是否为合法的归属方式
+func (a OffspringAssignment) IsValid() bool {
+	return a >= OffspringToProposer && a <= OffspringSplit
+}
+
+// BreedingContract 繁殖契约实体
+// 发起方用自己的宠物向好友的宠物发起繁殖邀约，双方都同意后才会真正繁殖
+type BreedingContract struct {
+	ID                int
+	ProposerID        int                 // 发起方用户ID
+	ProposerPetID     int                 // 发起方宠物ID
+	PartnerID         int                 // 接受方用户ID
+	PartnerPetID      int                 // 接受方宠物ID
+	Assignment        OffspringAssignment // 后代归属
+	ProposerChildName string              // 发起方为后代取的名字
+	PartnerChildName  string              // 接受方为后代取的名字（接受时填写）
+	Status            ContractStatus
+	OffspringIDs      []int // 繁殖出的后代ID
+	CreatedAt         time.Time
+	ExpiresAt         time.Time
+	RespondedAt       *time.Time
+
+	events []any
+}
+
+// NewBreedingContract 创建繁殖契约
+func NewBreedingContract(proposerID, proposerPetID, partnerID, partnerPetID int, assignment OffspringAssignment, childName string) (*BreedingContract, error) {
+	if proposerID == partnerID {
+		return nil, ErrContractSelf
+	}
+	if !assignment.IsValid() {
+		return nil, ErrInvalidOffspringAssignment
+	}
+
+	now := time.Now()
+	return &BreedingContract{
+		ProposerID:        proposerID,
+		ProposerPetID:     proposerPetID,
+		PartnerID:         partnerID,
+		PartnerPetID:      partnerPetID,
+		Assignment:        assignment,
+		ProposerChildName: childName,
+		Status:            ContractStatusPending,
+		CreatedAt:         now,
+		ExpiresAt:         now.Add(BreedingContractTTL),
+	}, nil
+}
+
+// Proposed 记录契约发起事件（保存获得ID后调用）
+func (c *BreedingContract) Proposed() {
+	c.addEvent(BreedingContractProposedEvent{
+		ContractID:    c.ID,
+		ProposerID:    c.ProposerID,
+		PartnerID:     c.PartnerID,
+		ProposerPetID: c.ProposerPetID,
+		PartnerPetID:  c.PartnerPetID,
+		Assignment:    int(c.Assignment),
+		Timestamp:     c.CreatedAt,
+	})
+}
+
+// IsParty 用户是否为契约一方
+func (c *BreedingContract) IsParty(userID int) bool {
+	return userID == c.ProposerID || userID == c.PartnerID
+}
+
+// OwnerIDs 按归属方式返回后代所有者列表（每个元素对应一只后代）
+func (c *BreedingContract) OwnerIDs() []int {
+	switch c.Assignment {
+	case OffspringToPartner:
+		return []int{c.PartnerID}
+	case OffspringSplit:
+		return []int{c.ProposerID, c.PartnerID}
+	default:
+		return []int{c.ProposerID}
+	}
+}
+
+// ChildNameFor 获取指定所有者为后代取的名字
+func (c *BreedingContract) ChildNameFor(ownerID int) string {
+	if ownerID == c.PartnerID && c.PartnerChildName != "" {
+		return c.PartnerChildName
+	}
+	return c.ProposerChildName
+}
+
+// IsExpired 检查是否过期
+func (c *BreedingContract) IsExpired(now time.Time) bool {
+	return c.Status == ContractStatusPending && now.After(c.ExpiresAt)
+}
+
+// Accept 接受契约并记录后代
+func (c *BreedingContract) Accept(partnerChildName string, offspringIDs []int) error {
+	if c.Status != ContractStatusPending {
+		return ErrInvalidContractStatus
+	}
+	now := time.Now()
+	if c.IsExpired(now) {
+		return ErrContractExpired
+	}
+
+	c.Status = ContractStatusAccepted
+	c.PartnerChildName = partnerChildName
+	c.OffspringIDs = offspringIDs
+	c.RespondedAt = &now
+
+	c.addEvent(BreedingContractAcceptedEvent{
+		ContractID:   c.ID,
+		ProposerID:   c.ProposerID,
+		PartnerID:    c.PartnerID,
+		OffspringIDs: offspringIDs,
+		Timestamp:    now,
+	})
+	return nil
+}
+
+// Reject 拒绝契约
+func (c *BreedingContract) Reject() error {
+	if c.Status != ContractStatusPending {
+		return ErrInvalidContractStatus
+	}
+	now := time.Now()
+	c.Status = ContractStatusRejected
+	c.RespondedAt = &now
+
+	c.addEvent(BreedingContractRejectedEvent{
+		ContractID: c.ID,
+		ProposerID: c.ProposerID,
+		PartnerID:  c.PartnerID,
+		Timestamp:  now,
+	})
+	return nil
+}
+
+// MarkExpired 标记为过期
+func (c *BreedingContract) MarkExpired() {
+	if c.Status != ContractStatusPending {
+		return
+	}
+	now := time.Now()
+	c.Status = ContractStatusExpired
+	c.RespondedAt = &now
+
+	c.addEvent(BreedingContractExpiredEvent{
+		ContractID: c.ID,
+		ProposerID: c.ProposerID,
+		PartnerID:  c.PartnerID,
+		Timestamp:  now,
+	})
+}
+
+// --- 领域事件 ---
+
+func (c *BreedingContract) addEvent(event any) {
+	c.events = append(c.events, event)
+}
+
+// Events 获取并清空事件
+func (c *BreedingContract) Events() []any {
+	events := c.events
+	c.events = nil
+	return events
+}
+
+// 契约相关错误
+var (
+	ErrContractNotFound           = errors.New("繁殖契约不存在")
+	ErrContractExpired            = errors.New("繁殖契约已过期")
+	ErrInvalidContractStatus      = errors.New("无效的契约状态")
+	ErrContractSelf               = errors.New("不能和自己签订繁殖契约")
+	ErrInvalidOffspringAssignment = errors.New("无效的后代归属方式")
+	ErrInvalidLitter              = errors.New("无效的同窝后代分配")
+)
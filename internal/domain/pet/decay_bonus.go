@@ -0,0 +1,56 @@
+// Package pet 宠物领域
+// DecayBonus 状态衰减减免 - 由穿戴的装饰品提供
+package pet
+
+// MaxDecayBonus 单项衰减减免上限（百分比）
+const MaxDecayBonus = 50
+
+// DecayBonus 状态衰减减免（值对象）
+// 数值为减免百分比，例如 Hunger=20 表示饱食度衰减速度降低20%
+type DecayBonus struct {
+	Hunger      int `json:"hunger"`
+	Happiness   int `json:"happiness"`
+	Cleanliness int `json:"cleanliness"`
+}
+
+// Add 叠加另一份减免
+func (b DecayBonus) Add(other DecayBonus) DecayBonus {
+	return DecayBonus{
+		Hunger:      b.Hunger + other.Hunger,
+		Happiness:   b.Happiness + other.Happiness,
+		Cleanliness: b.Cleanliness + other.Cleanliness,
+	}
+}
+
+// Capped 返回限制在 [0, MaxDecayBonus] 范围内的减免
+func (b DecayBonus) Capped() DecayBonus {
+	return DecayBonus{
+		Hunger:      clampBonus(b.Hunger),
+		Happiness:   clampBonus(b.Happiness),
+		Cleanliness: clampBonus(b.Cleanliness),
+	}
+}
+
+// IsZero 是否没有任何减免
+func (b DecayBonus) IsZero() bool {
+	return b.Hunger == 0 && b.Happiness == 0 && b.Cleanliness == 0
+}
+
+// HungerRate 饱食度衰减倍数
+func (b DecayBonus) HungerRate() float64 {
+	return 1 - float64(clampBonus(b.Hunger))/100
+}
+
+// HappinessRate 快乐度衰减倍数
+func (b DecayBonus) HappinessRate() float64 {
+	return 1 - float64(clampBonus(b.Happiness))/100
+}
+
+// CleanlinessRate 清洁度衰减倍数
+func (b DecayBonus) CleanlinessRate() float64 {
+	return 1 - float64(clampBonus(b.Cleanliness))/100
+}
+
+func clampBonus(v int) int {
+	return minInt(maxInt(v, 0), MaxDecayBonus)
+}
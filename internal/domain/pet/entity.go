@@ -4,7 +4,9 @@ package pet
 
 import (
 	"errors"
+	"strings"
 	"time"
+	"unicode/utf8"
 
 	"github.com/google/uuid"
 )
@@ -29,6 +31,24 @@ func (s Stage) Name() string {
 	return "未知"
 }
 
+// ParseStage 按配置中的英文标识解析成长阶段（egg、child、teen、adult、elderly）
+func ParseStage(s string) (Stage, bool) {
+	switch strings.ToLower(s) {
+	case "egg":
+		return StageEgg, true
+	case "child":
+		return StageChild, true
+	case "teen":
+		return StageTeen, true
+	case "adult":
+		return StageAdult, true
+	case "elderly":
+		return StageElderly, true
+	default:
+		return StageAdult, false
+	}
+}
+
 // FoodType 食物类型
 type FoodType int
 
@@ -69,11 +89,23 @@ type Pet struct {
 	SpecialAppearance SpecialAppearance // 物种特有外观
 	Personality       Personality       // 性格
 	Skill             Skill             // 技能
+	SecondarySkill    Skill             // 副技能（成长期解锁）
 
 	// 成长状态
-	Stage Stage
-	Exp   int
-	Level int
+	Stage   Stage
+	Exp     int
+	Level   int
+	HatchAt *time.Time  // 蛋：预计孵化时间；已孵化：实际孵化时间
+	Growth  GrowthCurve // 成长曲线（由物种决定，加载时注入）
+
+	// 进化形态
+	Form  string          // 进化形态ID（进入成熟期时确定）
+	Forms []EvolutionForm // 物种可进化的形态（由物种决定，加载时注入）
+	Care  CareHistory     // 养育记录
+
+	// 寿命
+	Lifespan time.Duration // 老年期寿命（物种寿命 × 基因寿命修正）
+	PassesAt *time.Time    // 寿终时间（进入老年期后确定）
 
 	// 实时状态 (0-100)
 	Hunger      int
@@ -81,6 +113,21 @@ type Pet struct {
 	Cleanliness int
 	Energy      int
 
+	// 健康
+	Illness         Illness    // 当前疾病
+	IllAt           *time.Time // 生病时间
+	HealthCheckedAt time.Time  // 上次健康检查时间
+
+	// 情绪
+	Mood     Mood // 当前情绪
+	MoodCalm int  // 闹情绪时还需安抚的次数
+
+	// 装饰品带来的衰减减免
+	DecayBonus DecayBonus
+
+	// 宠物栏排序（从 1 开始，0 表示未排序）
+	RosterOrder int
+
 	// 繁衍相关
 	Parent1ID   *int       // 父方ID (可为空)
 	Parent2ID   *int       // 母方ID (可为空)
@@ -91,10 +138,11 @@ type Pet struct {
 	LastFedAt       time.Time
 	LastPlayedAt    time.Time
 	LastCleanedAt   time.Time
+	LastWarmedAt    time.Time
 	BornAt          time.Time
 	CreatedAt       time.Time
 	StatusUpdatedAt time.Time
-	Revision        int64
+	Revision        int64 // 版本号，每次落库递增，仓储据此做乐观锁校验
 
 	// 领域事件收集
 	events []any
@@ -136,6 +184,7 @@ func NewPetWithSpecies(userID int, name string, speciesID SpeciesID, genderRule
 		Happiness:         50,
 		Cleanliness:       50,
 		Energy:            100,
+		Mood:              MoodContent,
 		Generation:        0,
 		BornAt:            now,
 		CreatedAt:         now,
@@ -165,6 +214,7 @@ func NewPetFromBreeding(userID int, name string, speciesID SpeciesID, gene Gene,
 		Happiness:         50,
 		Cleanliness:       50,
 		Energy:            100,
+		Mood:              MoodContent,
 		Parent1ID:         &parent1ID,
 		Parent2ID:         &parent2ID,
 		Generation:        generation,
@@ -182,13 +232,13 @@ func (p *Pet) SetSpecialAppearance(special SpecialAppearance) {
 
 // --- 核心业务方法 ---
 
-// Feed 喂食
-func (p *Pet) Feed(foodType FoodType) error {
+// Feed 喂食，返回实际获得的经验
+func (p *Pet) Feed(foodType FoodType) (int, error) {
 	if p.Stage == StageEgg {
-		return ErrPetIsEgg
+		return 0, ErrPetIsEgg
 	}
 	if p.Hunger >= 100 {
-		return ErrPetIsFull
+		return 0, ErrPetIsFull
 	}
 
 	// 计算恢复量
@@ -200,18 +250,20 @@ func (p *Pet) Feed(foodType FoodType) error {
 	}
 
 	// 技能加成
-	if p.Skill.Type == SkillTypeGluttony {
-		restore = int(float64(restore) * p.Skill.EffectMultiplier())
+	if skill, ok := p.activeSkill(SkillTypeGluttony); ok {
+		restore = int(float64(restore) * skill.EffectMultiplier())
 	}
 
-	// 更新状态
+	// 记录养育经历，再更新状态（带来的快乐受情绪影响）
+	now := time.Now()
+	p.recordCare(TrainingFeed)
 	p.Hunger = minInt(p.Hunger+restore, 100)
-	p.Happiness = minInt(p.Happiness+5, 100)
-	p.LastFedAt = time.Now()
+	p.Happiness = minInt(p.Happiness+int(5*p.Mood.CareEffect()), 100)
+	p.LastFedAt = now
 
 	// 获得经验
-	exp := int(10 * p.Personality.FeedExpBonus())
-	p.addExp(exp)
+	exp := p.addExp(int(10 * p.Personality.FeedExpBonus()))
+	p.TrainSkill(TrainingFeed)
 
 	// 记录事件
 	p.addEvent(PetFedEvent{
@@ -221,40 +273,51 @@ func (p *Pet) Feed(foodType FoodType) error {
 		ExpGained: exp,
 	})
 
-	return nil
+	p.soothe(now)
+	return exp, nil
 }
 
-// Play 玩耍
-func (p *Pet) Play() error {
+// Play 玩耍，返回实际获得的经验
+func (p *Pet) Play() (int, error) {
 	if p.Stage == StageEgg {
-		return ErrPetIsEgg
+		return 0, ErrPetIsEgg
 	}
 	if p.Happiness >= 100 {
-		return ErrPetIsHappy
+		return 0, ErrPetIsHappy
 	}
 	if p.Energy < 10 {
-		return ErrPetIsTired
+		return 0, ErrPetIsTired
+	}
+	if p.IsSick() {
+		return 0, ErrPetIsSick
+	}
+	if p.Mood == MoodSulking {
+		return 0, ErrPetIsSulking
 	}
 
-	// 计算恢复量
+	// 计算恢复量（受情绪影响）
 	restore := 20
 	if p.Personality.Activity > 70 {
 		restore = int(float64(restore) * 1.2)
 	}
-	if p.Skill.Type == SkillTypePlayful {
-		restore = int(float64(restore) * p.Skill.EffectMultiplier())
+	if skill, ok := p.activeSkill(SkillTypePlayful); ok {
+		restore = int(float64(restore) * skill.EffectMultiplier())
 	}
+	restore = int(float64(restore) * p.Mood.CareEffect())
 
-	// 更新状态
+	// 记录养育经历，再更新状态
+	now := time.Now()
+	p.recordCare(TrainingPlay)
 	p.Happiness = minInt(p.Happiness+restore, 100)
 	p.Energy = maxInt(p.Energy-15, 0)
-	p.LastPlayedAt = time.Now()
+	p.LastPlayedAt = now
 
 	// 获得经验
-	exp := int(10 * p.Personality.PlayExpBonus())
-	p.addExp(exp)
+	exp := p.addExp(int(10 * p.Personality.PlayExpBonus()))
+	p.TrainSkill(TrainingPlay)
 
-	return nil
+	p.soothe(now)
+	return exp, nil
 }
 
 // Clean 清洁
@@ -267,16 +330,20 @@ func (p *Pet) Clean() error {
 	}
 
 	restore := 30
-	if p.Skill.Type == SkillTypeCleanlover {
-		restore = int(float64(restore) * p.Skill.EffectMultiplier())
+	if skill, ok := p.activeSkill(SkillTypeCleanlover); ok {
+		restore = int(float64(restore) * skill.EffectMultiplier())
 	}
 
+	now := time.Now()
+	p.recordCare(TrainingClean)
 	p.Cleanliness = minInt(p.Cleanliness+restore, 100)
-	p.LastCleanedAt = time.Now()
+	p.LastCleanedAt = now
 
 	// 清洁也给少量经验
 	p.addExp(5)
+	p.TrainSkill(TrainingClean)
 
+	p.soothe(now)
 	return nil
 }
 
@@ -285,6 +352,144 @@ func (p *Pet) Rest() {
 	p.Energy = minInt(p.Energy+30, 100)
 }
 
+// --- 道具效果 ---
+
+// StatusType 可被直接恢复的状态项
+type StatusType string
+
+const (
+	StatusHunger      StatusType = "hunger"      // 饱食度
+	StatusHappiness   StatusType = "happiness"   // 快乐度
+	StatusCleanliness StatusType = "cleanliness" // 清洁度
+	StatusEnergy      StatusType = "energy"      // 精力
+)
+
+// MaxPetNameLength 宠物名字最大长度（字符数）
+const MaxPetNameLength = 20
+
+// RestoreStatus 直接恢复某项状态（道具效果，不享受性格和技能加成）
+func (p *Pet) RestoreStatus(status StatusType, amount int) error {
+	if p.Stage == StageEgg {
+		return ErrPetIsEgg
+	}
+	if amount <= 0 {
+		return ErrInvalidStatusAmount
+	}
+
+	switch status {
+	case StatusHunger:
+		if p.Hunger >= 100 {
+			return ErrPetIsFull
+		}
+		p.Hunger = minInt(p.Hunger+amount, 100)
+	case StatusHappiness:
+		if p.Happiness >= 100 {
+			return ErrPetIsHappy
+		}
+		p.Happiness = minInt(p.Happiness+amount, 100)
+	case StatusCleanliness:
+		if p.Cleanliness >= 100 {
+			return ErrPetIsClean
+		}
+		p.Cleanliness = minInt(p.Cleanliness+amount, 100)
+	case StatusEnergy:
+		if p.Energy >= 100 {
+			return ErrPetIsEnergetic
+		}
+		p.Energy = minInt(p.Energy+amount, 100)
+	default:
+		return ErrUnknownStatus
+	}
+
+	p.addEvent(PetStatusRestoredEvent{
+		PetID:     p.ID,
+		UserID:    p.UserID,
+		Status:    string(status),
+		Amount:    amount,
+		Timestamp: time.Now(),
+	})
+	return nil
+}
+
+// GainExp 直接获得经验（道具效果），可能触发升级和进化，返回按成长速率修正后实际获得的经验
+func (p *Pet) GainExp(exp int) (int, error) {
+	if exp <= 0 {
+		return 0, ErrInvalidStatusAmount
+	}
+	exp = p.addExp(exp)
+
+	p.addEvent(PetExpGainedEvent{
+		PetID:     p.ID,
+		UserID:    p.UserID,
+		Exp:       exp,
+		Timestamp: time.Now(),
+	})
+	return exp, nil
+}
+
+// ResetBreedCooldown 清除繁殖冷却（道具效果）
+func (p *Pet) ResetBreedCooldown() error {
+	if p.LastBreedAt == nil {
+		return ErrNoBreedCooldown
+	}
+	p.LastBreedAt = nil
+
+	p.addEvent(PetBreedCooldownResetEvent{
+		PetID:     p.ID,
+		UserID:    p.UserID,
+		Timestamp: time.Now(),
+	})
+	return nil
+}
+
+// Rename 改名
+func (p *Pet) Rename(name string) error {
+	name = strings.TrimSpace(name)
+	if n := utf8.RuneCountInString(name); n < 1 || n > MaxPetNameLength {
+		return ErrInvalidPetName
+	}
+	if name == p.Name {
+		return ErrSamePetName
+	}
+
+	oldName := p.Name
+	p.Name = name
+
+	p.addEvent(PetRenamedEvent{
+		PetID:     p.ID,
+		UserID:    p.UserID,
+		OldName:   oldName,
+		NewName:   name,
+		Timestamp: time.Now(),
+	})
+	return nil
+}
+
+// RerollSkill 重新随机主技能（改写技能基因位，技能等级重置为1）
+func (p *Pet) RerollSkill() error {
+	if p.Stage == StageEgg {
+		return ErrPetIsEgg
+	}
+
+	oldSkill := p.Skill.Type
+	p.Gene = p.Gene.WithRerolledSkill()
+	p.Skill = NewSkillFromGene(p.Gene)
+	if p.Stage >= StageTeen {
+		// 副技能不能与新的主技能重复
+		p.SecondarySkill = NewSecondarySkillFromGene(p.Gene)
+	}
+
+	p.addEvent(PetSkillRerolledEvent{
+		PetID:     p.ID,
+		UserID:    p.UserID,
+		OldSkill:  int(oldSkill),
+		NewSkill:  int(p.Skill.Type),
+		Strength:  p.Skill.Strength,
+		Timestamp: time.Now(),
+	})
+	return nil
+}
+
 // --- 繁殖相关 ---
 
 // CanBreed 检查是否可以繁殖
@@ -292,24 +497,33 @@ func (p *Pet) CanBreed(breedRules BreedingRules) error {
 	if p.Stage < breedRules.MinStage {
 		return ErrPetNotMature
 	}
+	if p.IsSick() {
+		return ErrPetIsSick
+	}
 	if p.Level < breedRules.MinLevel {
 		return ErrPetLevelTooLow
 	}
 	if p.Happiness < breedRules.MinHappiness {
 		return ErrPetUnhappy
 	}
-	if p.LastBreedAt != nil {
-		cooldown := time.Duration(breedRules.CooldownHours) * time.Hour
-		if p.Gender == GenderNone {
-			cooldown = time.Duration(breedRules.SelfBreedCooldownHours) * time.Hour
-		}
-		if time.Since(*p.LastBreedAt) < cooldown {
-			return ErrBreedCooldown
-		}
+	if p.LastBreedAt != nil && time.Since(*p.LastBreedAt) < p.BreedCooldown(breedRules) {
+		return ErrBreedCooldown
 	}
 	return nil
 }
 
+// BreedCooldown 繁殖冷却时长（多产能力缩短）
+func (p *Pet) BreedCooldown(breedRules BreedingRules) time.Duration {
+	cooldown := time.Duration(breedRules.CooldownHours) * time.Hour
+	if p.Gender == GenderNone {
+		cooldown = time.Duration(breedRules.SelfBreedCooldownHours) * time.Hour
+	}
+	if p.HasAbility(AbilityFertile) {
+		cooldown = time.Duration(float64(cooldown) * FertileCooldownRate)
+	}
+	return cooldown
+}
+
 // CanBreedWith 检查是否可以与另一只宠物繁殖
 func (p *Pet) CanBreedWith(other *Pet) error {
 	if !CanBreedWith(p.Gender, other.Gender) {
@@ -324,6 +538,33 @@ func (p *Pet) MarkBred() {
 	p.LastBreedAt = &now
 }
 
+// TransferTo 宠物易主
+// 装饰品随原主人保留，新主人接手时不再享有衰减减免，并排在新主人宠物栏的最后
+func (p *Pet) TransferTo(newOwnerID int) error {
+	if newOwnerID == p.UserID {
+		return ErrAdoptSelf
+	}
+
+	oldOwnerID := p.UserID
+	p.UserID = newOwnerID
+	p.DecayBonus = DecayBonus{}
+	p.RosterOrder = 0
+
+	p.addEvent(PetTransferredEvent{
+		PetID:      p.ID,
+		FromUserID: oldOwnerID,
+		ToUserID:   newOwnerID,
+		Timestamp:  time.Now(),
+	})
+	return nil
+}
+
+// SetDecayBonus 更新装饰品带来的衰减减免
+// 调用前应先补算状态，避免新的减免作用到过去的时间段
+func (p *Pet) SetDecayBonus(bonus DecayBonus) {
+	p.DecayBonus = bonus.Capped()
+}
+
 // --- 状态衰减（由定时任务调用） ---
 
 // DecayStatus 状态衰减
@@ -336,24 +577,31 @@ func (p *Pet) DecayStatus(hours float64) {
 	baseDecay := 5.0
 
 	// 耐力技能减缓衰减
-	if p.Skill.Type == SkillTypeEndurance {
-		baseDecay = baseDecay / p.Skill.EffectMultiplier()
+	if skill, ok := p.activeSkill(SkillTypeEndurance); ok {
+		baseDecay = baseDecay / skill.EffectMultiplier()
 	}
 
-	// 饥饿衰减
-	hungerDecay := int(baseDecay * p.Personality.HungerDecayRate() * hours)
+	// 生病时衰减加快
+	baseDecay *= p.sickDecayRate()
+
+	// 饥饿衰减（铁胃能力减缓）
+	hungerRate := p.Personality.HungerDecayRate() * p.DecayBonus.HungerRate()
+	if p.HasAbility(AbilityIronStomach) {
+		hungerRate *= IronStomachDecayRate
+	}
+	hungerDecay := int(baseDecay * hungerRate * hours)
 	p.Hunger = maxInt(p.Hunger-hungerDecay, 0)
 
 	// 快乐衰减
-	happinessDecay := int(baseDecay * p.Personality.HappinessDecayRate() * hours)
+	happinessDecay := int(baseDecay * p.Personality.HappinessDecayRate() * p.DecayBonus.HappinessRate() * hours)
 	p.Happiness = maxInt(p.Happiness-happinessDecay, 0)
 
 	// 清洁衰减
-	cleanlinessDecay := int(baseDecay * hours)
+	cleanlinessDecay := int(baseDecay * p.DecayBonus.CleanlinessRate() * hours)
 	p.Cleanliness = maxInt(p.Cleanliness-cleanlinessDecay, 0)
 
-	// 能量恢复（休息时）
-	p.Energy = minInt(p.Energy+int(10*hours), 100)
+	// 能量恢复（休息时，生病时恢复变慢）
+	p.Energy = minInt(p.Energy+int(10*p.sickEnergyRate()*hours), 100)
 }
 
 // StatusAt 获取指定时间点的状态快照（不修改实体）
@@ -376,19 +624,50 @@ func (p *Pet) StatusAt(now time.Time) (hunger, happiness, cleanliness, energy in
 	return snapshot.Hunger, snapshot.Happiness, snapshot.Cleanliness, snapshot.Energy
 }
 
+// ApplyStatusAt 将按时间差计算出的状态回填到实体（写操作前补算）
+// 零值锚点、状态、健康或情绪发生变化时推进锚点，版本号在落库时推进
+func (p *Pet) ApplyStatusAt(now time.Time) {
+	hunger, happiness, cleanliness, energy := p.StatusAt(now)
+	changed := p.Hunger != hunger ||
+		p.Happiness != happiness ||
+		p.Cleanliness != cleanliness ||
+		p.Energy != energy
+
+	// 在回填状态前做健康检查，本次生病只影响之后的衰减
+	// 健康检查时间与状态锚点各自独立，只有生病才推进状态锚点
+	illness := p.Illness
+	p.CheckHealth(now)
+	healthChanged := p.Illness != illness
+
+	p.Hunger = hunger
+	p.Happiness = happiness
+	p.Cleanliness = cleanliness
+	p.Energy = energy
+
+	// 按补算后的状态推进情绪
+	moodChanged := p.UpdateMood(now)
+
+	if p.StatusUpdatedAt.IsZero() || changed || healthChanged || moodChanged {
+		p.StatusUpdatedAt = now
+	}
+}
+
 // --- 成长与进化 ---
 
 // addExp 增加经验
-func (p *Pet) addExp(exp int) {
-	p.Exp += exp
+// 经验按基因成长速率修正，返回实际获得的经验
+func (p *Pet) addExp(exp int) int {
+	gained := p.scaleExp(exp)
+	p.Exp += gained
 	p.checkLevelUp()
 	p.checkEvolution()
+	return gained
 }
 
 // checkLevelUp 检查升级
 func (p *Pet) checkLevelUp() {
 	for {
-		required := p.requiredExpForLevel()
+		required := p.RequiredExp()
 		if p.Exp < required {
 			break
 		}
@@ -403,46 +682,70 @@ func (p *Pet) checkLevelUp() {
 	}
 }
 
-// requiredExpForLevel 当前等级所需经验
-func (p *Pet) requiredExpForLevel() int {
-	return p.Level * 100
-}
-
 // checkEvolution 检查进化
+// 进化等级由物种成长曲线决定；蛋的孵化只由孵化时间决定，见 Hatch
 func (p *Pet) checkEvolution() {
 	var evolved bool
+	var form EvolutionForm
 	switch p.Stage {
-	case StageEgg:
-		if p.Level >= 3 {
-			p.Stage = StageChild
-			evolved = true
-		}
 	case StageChild:
-		if p.Level >= 10 {
+		if p.Level >= p.Growth.StageLevel(StageTeen) {
 			p.Stage = StageTeen
+			p.unlockSecondarySkill()
 			evolved = true
 		}
 	case StageTeen:
-		if p.Level >= 25 {
+		if p.Level >= p.Growth.StageLevel(StageAdult) {
 			p.Stage = StageAdult
+			form, _ = p.evolveForm()
 			evolved = true
 		}
 	case StageAdult:
-		if p.Level >= 50 {
+		if p.Level >= p.Growth.StageLevel(StageElderly) {
 			p.Stage = StageElderly
+			p.startElderly(time.Now())
 			evolved = true
 		}
 	}
 
 	if evolved {
 		p.addEvent(PetEvolvedEvent{
-			PetID:    p.ID,
-			UserID:   p.UserID,
-			NewStage: int(p.Stage),
+			PetID:     p.ID,
+			UserID:    p.UserID,
+			NewStage:  int(p.Stage),
+			Form:      form.ID,
+			FormName:  form.Name,
+			Timestamp: time.Now(),
 		})
 	}
 }
 
+// unlockSecondarySkill 进入成长期时解锁副技能
+func (p *Pet) unlockSecondarySkill() {
+	p.SecondarySkill = NewSecondarySkillFromGene(p.Gene)
+	if p.SecondarySkill.Type == SkillTypeNone {
+		return
+	}
+
+	p.addEvent(PetSecondarySkillUnlockedEvent{
+		PetID:     p.ID,
+		UserID:    p.UserID,
+		SkillType: int(p.SecondarySkill.Type),
+		Timestamp: time.Now(),
+	})
+}
+
+// activeSkill 获取生效的指定类型技能，主技能优先，其次是已解锁的副技能
+func (p *Pet) activeSkill(skillType SkillType) (Skill, bool) {
+	if p.Skill.Type == skillType {
+		return p.Skill, true
+	}
+	if p.Stage >= StageTeen && p.SecondarySkill.Type == skillType && skillType != SkillTypeNone {
+		return p.SecondarySkill, true
+	}
+	return Skill{}, false
+}
+
 // --- 状态查询 ---
 
 // IsHungry 是否饥饿
@@ -490,17 +793,35 @@ func (p *Pet) Events() []any {
 
 // 领域错误
 var (
-	ErrPetIsEgg           = errors.New("宠物还在蛋里")
-	ErrPetIsFull          = errors.New("宠物已经很饱了")
-	ErrPetIsHappy         = errors.New("宠物已经很开心了")
-	ErrPetIsClean         = errors.New("宠物已经很干净了")
-	ErrPetIsTired         = errors.New("宠物太累了需要休息")
-	ErrPetNotFound        = errors.New("宠物不存在")
-	ErrPetNotMature       = errors.New("宠物还未成年")
-	ErrPetLevelTooLow     = errors.New("宠物等级不足")
-	ErrPetUnhappy         = errors.New("宠物不够开心")
-	ErrBreedCooldown      = errors.New("繁殖冷却中")
-	ErrIncompatibleGender = errors.New("性别不兼容")
-	ErrCannotSelfBreed    = errors.New("该物种不能自我繁殖")
-	ErrSpeciesNotFound    = errors.New("物种不存在")
+	ErrPetIsEgg            = errors.New("宠物还在蛋里")
+	ErrPetIsFull           = errors.New("宠物已经很饱了")
+	ErrPetIsHappy          = errors.New("宠物已经很开心了")
+	ErrPetIsClean          = errors.New("宠物已经很干净了")
+	ErrPetIsTired          = errors.New("宠物太累了需要休息")
+	ErrPetNotFound         = errors.New("宠物不存在")
+	ErrPetNotMature        = errors.New("宠物还未成年")
+	ErrPetLevelTooLow      = errors.New("宠物等级不足")
+	ErrPetUnhappy          = errors.New("宠物不够开心")
+	ErrBreedCooldown       = errors.New("繁殖冷却中")
+	ErrIncompatibleGender  = errors.New("性别不兼容")
+	ErrCannotSelfBreed     = errors.New("该物种不能自我繁殖")
+	ErrSpeciesNotFound     = errors.New("物种不存在")
+	ErrPetIsEnergetic      = errors.New("宠物精力充沛")
+	ErrUnknownStatus       = errors.New("未知的状态类型")
+	ErrInvalidStatusAmount = errors.New("无效的状态数值")
+	ErrNoBreedCooldown     = errors.New("宠物不在繁殖冷却中")
+	ErrInvalidPetName      = errors.New("宠物名称长度需为1-20个字符")
+	ErrSamePetName         = errors.New("新名字与原名字相同")
+	ErrPetNotEgg           = errors.New("宠物已经孵化了")
+	ErrEggNotReady         = errors.New("蛋还没到孵化时间")
+	ErrEggReady            = errors.New("蛋已经可以孵化了")
+	ErrPetModified         = errors.New("宠物已被其他操作更新，请重试")
+	ErrWarmCooldown        = errors.New("刚保温过，请稍后再来")
+	ErrLifespanNotOver     = errors.New("宠物寿命未尽")
+	ErrMemorialNotFound    = errors.New("纪念记录不存在")
+	ErrPetIsSick           = errors.New("宠物生病了，先治好它吧")
+	ErrPetNotSick          = errors.New("宠物没有生病")
+	ErrNoSkill             = errors.New("宠物没有技能")
+	ErrSkillMaxLevel       = errors.New("技能已满级")
+	ErrPetIsSulking        = errors.New("宠物在闹别扭，不想玩，先喂喂它或帮它洗洗吧")
 )
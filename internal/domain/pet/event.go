@@ -30,11 +30,108 @@ type PetEvolvedEvent struct {
 	PetID     int       `json:"pet_id"`
 	UserID    int       `json:"user_id"`
 	NewStage  int       `json:"new_stage"`
+	Form      string    `json:"form,omitempty"`      // 进入成熟期时的进化形态
+	FormName  string    `json:"form_name,omitempty"` // 进化形态名称
 	Timestamp time.Time `json:"timestamp"`
 }
 
 func (e PetEvolvedEvent) EventName() string { return "pet.evolved" }
 
+// PetHatchedEvent 宠物孵化事件
+type PetHatchedEvent struct {
+	PetID     int       `json:"pet_id"`
+	UserID    int       `json:"user_id"`
+	SpeciesID int       `json:"species_id"`
+	Timestamp time.Time `json:"timestamp"`
+}
+
+func (e PetHatchedEvent) EventName() string { return "pet.hatched" }
+
+// PetIncubationShortenedEvent 孵化时间缩短事件（保温或孵化器）
+type PetIncubationShortenedEvent struct {
+	PetID     int       `json:"pet_id"`
+	UserID    int       `json:"user_id"`
+	Minutes   int       `json:"minutes"`
+	HatchAt   time.Time `json:"hatch_at"`
+	Timestamp time.Time `json:"timestamp"`
+}
+
+func (e PetIncubationShortenedEvent) EventName() string { return "pet.incubation_shortened" }
+
+// PetPassedOnEvent 宠物寿终事件
+type PetPassedOnEvent struct {
+	PetID     int       `json:"pet_id"`
+	UserID    int       `json:"user_id"`
+	Name      string    `json:"name"`
+	LifeDays  int       `json:"life_days"`
+	Timestamp time.Time `json:"timestamp"`
+}
+
+func (e PetPassedOnEvent) EventName() string { return "pet.passed_on" }
+
+// PetFellIllEvent 宠物生病事件
+type PetFellIllEvent struct {
+	PetID     int       `json:"pet_id"`
+	UserID    int       `json:"user_id"`
+	Illness   int       `json:"illness"` // 1:感冒 2:营养不良
+	Timestamp time.Time `json:"timestamp"`
+}
+
+func (e PetFellIllEvent) EventName() string { return "pet.fell_ill" }
+
+// PetReleasedEvent 宠物被放生事件
+type PetReleasedEvent struct {
+	PetID     int       `json:"pet_id"`
+	UserID    int       `json:"user_id"`
+	Name      string    `json:"name"`
+	LifeDays  int       `json:"life_days"`
+	Timestamp time.Time `json:"timestamp"`
+}
+
+func (e PetReleasedEvent) EventName() string { return "pet.released" }
+
+// PetMoodChangedEvent 宠物情绪变化事件
+type PetMoodChangedEvent struct {
+	PetID     int       `json:"pet_id"`
+	UserID    int       `json:"user_id"`
+	From      string    `json:"from"` // 原情绪，首次计算时为空
+	To        string    `json:"to"`   // 新情绪
+	Timestamp time.Time `json:"timestamp"`
+}
+
+func (e PetMoodChangedEvent) EventName() string { return "pet.mood_changed" }
+
+// PetRecoveredEvent 宠物康复事件
+type PetRecoveredEvent struct {
+	PetID     int       `json:"pet_id"`
+	UserID    int       `json:"user_id"`
+	Illness   int       `json:"illness"` // 治愈的疾病
+	Timestamp time.Time `json:"timestamp"`
+}
+
+func (e PetRecoveredEvent) EventName() string { return "pet.recovered" }
+
+// PetSkillLeveledUpEvent 技能升级事件
+type PetSkillLeveledUpEvent struct {
+	PetID     int       `json:"pet_id"`
+	UserID    int       `json:"user_id"`
+	SkillType int       `json:"skill_type"`
+	Level     int       `json:"level"` // 升级后的等级
+	Timestamp time.Time `json:"timestamp"`
+}
+
+func (e PetSkillLeveledUpEvent) EventName() string { return "pet.skill_leveled_up" }
+
+// PetSecondarySkillUnlockedEvent 副技能解锁事件
+type PetSecondarySkillUnlockedEvent struct {
+	PetID     int       `json:"pet_id"`
+	UserID    int       `json:"user_id"`
+	SkillType int       `json:"skill_type"`
+	Timestamp time.Time `json:"timestamp"`
+}
+
+func (e PetSecondarySkillUnlockedEvent) EventName() string { return "pet.secondary_skill_unlocked" }
+
 // PetStatusWarningEvent 宠物状态警告事件
 type PetStatusWarningEvent struct {
 	PetID       int       `json:"pet_id"`
@@ -56,3 +153,168 @@ type PetCreatedEvent struct {
 
 func (e PetCreatedEvent) EventName() string { return "pet.created" }
 
+// BreedingContractProposedEvent 繁殖契约发起事件（通知双方）
+type BreedingContractProposedEvent struct {
+	ContractID    int       `json:"contract_id"`
+	ProposerID    int       `json:"proposer_id"`
+	PartnerID     int       `json:"partner_id"`
+	ProposerPetID int       `json:"proposer_pet_id"`
+	PartnerPetID  int       `json:"partner_pet_id"`
+	Assignment    int       `json:"assignment"`
+	Timestamp     time.Time `json:"timestamp"`
+}
+
+func (e BreedingContractProposedEvent) EventName() string { return "pet.breeding_contract_proposed" }
+
+// BreedingContractAcceptedEvent 繁殖契约接受事件（通知双方）
+type BreedingContractAcceptedEvent struct {
+	ContractID   int       `json:"contract_id"`
+	ProposerID   int       `json:"proposer_id"`
+	PartnerID    int       `json:"partner_id"`
+	OffspringIDs []int     `json:"offspring_ids"`
+	Timestamp    time.Time `json:"timestamp"`
+}
+
+func (e BreedingContractAcceptedEvent) EventName() string { return "pet.breeding_contract_accepted" }
+
+// BreedingContractRejectedEvent 繁殖契约拒绝事件（通知双方）
+type BreedingContractRejectedEvent struct {
+	ContractID int       `json:"contract_id"`
+	ProposerID int       `json:"proposer_id"`
+	PartnerID  int       `json:"partner_id"`
+	Timestamp  time.Time `json:"timestamp"`
+}
+
+func (e BreedingContractRejectedEvent) EventName() string { return "pet.breeding_contract_rejected" }
+
+// BreedingContractExpiredEvent 繁殖契约过期事件（通知双方）
+type BreedingContractExpiredEvent struct {
+	ContractID int       `json:"contract_id"`
+	ProposerID int       `json:"proposer_id"`
+	PartnerID  int       `json:"partner_id"`
+	Timestamp  time.Time `json:"timestamp"`
+}
+
+func (e BreedingContractExpiredEvent) EventName() string { return "pet.breeding_contract_expired" }
+
+// PetStatusRestoredEvent 宠物状态被道具恢复事件
+type PetStatusRestoredEvent struct {
+	PetID     int       `json:"pet_id"`
+	UserID    int       `json:"user_id"`
+	Status    string    `json:"status"` // hunger, happiness, cleanliness, energy
+	Amount    int       `json:"amount"`
+	Timestamp time.Time `json:"timestamp"`
+}
+
+func (e PetStatusRestoredEvent) EventName() string { return "pet.status_restored" }
+
+// PetExpGainedEvent 宠物通过道具获得经验事件
+type PetExpGainedEvent struct {
+	PetID     int       `json:"pet_id"`
+	UserID    int       `json:"user_id"`
+	Exp       int       `json:"exp"`
+	Timestamp time.Time `json:"timestamp"`
+}
+
+func (e PetExpGainedEvent) EventName() string { return "pet.exp_gained" }
+
+// PetBreedCooldownResetEvent 宠物繁殖冷却被清除事件
+type PetBreedCooldownResetEvent struct {
+	PetID     int       `json:"pet_id"`
+	UserID    int       `json:"user_id"`
+	Timestamp time.Time `json:"timestamp"`
+}
+
+func (e PetBreedCooldownResetEvent) EventName() string { return "pet.breed_cooldown_reset" }
+
+// PetRenamedEvent 宠物改名事件
+type PetRenamedEvent struct {
+	PetID     int       `json:"pet_id"`
+	UserID    int       `json:"user_id"`
+	OldName   string    `json:"old_name"`
+	NewName   string    `json:"new_name"`
+	Timestamp time.Time `json:"timestamp"`
+}
+
+func (e PetRenamedEvent) EventName() string { return "pet.renamed" }
+
+// PetSkillRerolledEvent 宠物技能重置事件
+type PetSkillRerolledEvent struct {
+	PetID     int       `json:"pet_id"`
+	UserID    int       `json:"user_id"`
+	OldSkill  int       `json:"old_skill"`
+	NewSkill  int       `json:"new_skill"`
+	Strength  int       `json:"strength"`
+	Timestamp time.Time `json:"timestamp"`
+}
+
+func (e PetSkillRerolledEvent) EventName() string { return "pet.skill_rerolled" }
+
+// PetTransferredEvent 宠物易主事件
+type PetTransferredEvent struct {
+	PetID      int       `json:"pet_id"`
+	FromUserID int       `json:"from_user_id"`
+	ToUserID   int       `json:"to_user_id"`
+	Timestamp  time.Time `json:"timestamp"`
+}
+
+func (e PetTransferredEvent) EventName() string { return "pet.transferred" }
+
+// AdoptionListedEvent 宠物挂单领养事件
+type AdoptionListedEvent struct {
+	ListingID   int       `json:"listing_id"`
+	PetID       int       `json:"pet_id"`
+	SellerID    int       `json:"seller_id"`
+	RecipientID *int      `json:"recipient_id,omitempty"` // 赠送对象
+	Currency    int       `json:"currency"`
+	Price       int       `json:"price"`
+	Timestamp   time.Time `json:"timestamp"`
+}
+
+func (e AdoptionListedEvent) EventName() string { return "pet.adoption_listed" }
+
+// AdoptionCompletedEvent 宠物被领养事件（通知原主人）
+type AdoptionCompletedEvent struct {
+	ListingID int       `json:"listing_id"`
+	PetID     int       `json:"pet_id"`
+	SellerID  int       `json:"seller_id"`
+	BuyerID   int       `json:"buyer_id"`
+	Currency  int       `json:"currency"`
+	Price     int       `json:"price"`
+	Timestamp time.Time `json:"timestamp"`
+}
+
+func (e AdoptionCompletedEvent) EventName() string { return "pet.adoption_completed" }
+
+// AdoptionCancelledEvent 领养挂单撤回事件
+type AdoptionCancelledEvent struct {
+	ListingID int       `json:"listing_id"`
+	PetID     int       `json:"pet_id"`
+	SellerID  int       `json:"seller_id"`
+	Timestamp time.Time `json:"timestamp"`
+}
+
+func (e AdoptionCancelledEvent) EventName() string { return "pet.adoption_cancelled" }
+
+// PetCaredByFriendEvent 宠物被好友照顾事件（通知主人）
+type PetCaredByFriendEvent struct {
+	PetID     int       `json:"pet_id"`
+	UserID    int       `json:"user_id"`   // 宠物主人
+	FriendID  int       `json:"friend_id"` // 照顾者
+	Action    string    `json:"action"`    // feed, play, clean
+	Timestamp time.Time `json:"timestamp"`
+}
+
+func (e PetCaredByFriendEvent) EventName() string { return "pet.cared_by_friend" }
+
+// PetRandomEventEvent 宠物触发随机事件
+type PetRandomEventEvent struct {
+	PetID     int       `json:"pet_id"`
+	UserID    int       `json:"user_id"`
+	EventID   string    `json:"event_id"` // 随机事件配置ID
+	Effect    string    `json:"effect"`   // coins, item, status
+	Amount    int       `json:"amount"`
+	Timestamp time.Time `json:"timestamp"`
+}
+
+func (e PetRandomEventEvent) EventName() string { return "pet.random_event" }
@@ -0,0 +1,136 @@
+// Package pet 宠物领域
+// EvolutionForm 进化形态 - 进入成熟期时按基因进化倾向和养育经历分支进化
+package pet
+
+// EvolutionForm 进化形态（值对象）
+// 物种可配置多个形态，进入成熟期时按配置顺序匹配第一个满足条件的形态
+type EvolutionForm struct {
+	ID        string           // 形态标识
+	Name      string           // 显示名称
+	Condition FormCondition    // 进化条件
+	Features  []PartAppearance // 形态外观，覆盖同类型部位（样式由物种解释器解析）
+}
+
+// FormCondition 进化形态条件
+// 上限为 0 表示不限；不设任何条件的形态可作为默认形态放在最后
+type FormCondition struct {
+	MinTendency    int // 基因进化倾向下限（0-255）
+	MaxTendency    int // 基因进化倾向上限
+	MinHappiness   int // 平均快乐度下限
+	MaxHappiness   int // 平均快乐度上限
+	MinCleanliness int // 平均清洁度下限
+	MaxCleanliness int // 平均清洁度上限
+	MinFeeds       int // 喂食次数下限
+	MinPlays       int // 玩耍次数下限
+	MinCleans      int // 清洁次数下限
+}
+
+// Matches 基因进化倾向和养育记录是否满足条件
+func (c FormCondition) Matches(tendency int, care CareHistory) bool {
+	happiness := care.AverageHappiness()
+	cleanliness := care.AverageCleanliness()
+	return inRange(tendency, c.MinTendency, c.MaxTendency) &&
+		inRange(happiness, c.MinHappiness, c.MaxHappiness) &&
+		inRange(cleanliness, c.MinCleanliness, c.MaxCleanliness) &&
+		care.FeedCount >= c.MinFeeds &&
+		care.PlayCount >= c.MinPlays &&
+		care.CleanCount >= c.MinCleans
+}
+
+// inRange 是否在 [min, max] 范围内，max 为 0 表示不限
+func inRange(v, min, max int) bool {
+	return v >= min && (max == 0 || v <= max)
+}
+
+// CareHistory 养育记录（值对象）
+// 每次照顾时记录照顾前的快乐度和清洁度，反映主人平时的照料水平
+type CareHistory struct {
+	FeedCount      int `json:"feed_count"`
+	PlayCount      int `json:"play_count"`
+	CleanCount     int `json:"clean_count"`
+	HappinessSum   int `json:"happiness_sum"`
+	CleanlinessSum int `json:"cleanliness_sum"`
+	Samples        int `json:"samples"`
+}
+
+// AverageHappiness 平均快乐度，没有记录时为 0
+func (c CareHistory) AverageHappiness() int {
+	if c.Samples == 0 {
+		return 0
+	}
+	return c.HappinessSum / c.Samples
+}
+
+// AverageCleanliness 平均清洁度，没有记录时为 0
+func (c CareHistory) AverageCleanliness() int {
+	if c.Samples == 0 {
+		return 0
+	}
+	return c.CleanlinessSum / c.Samples
+}
+
+// recordCare 记录一次主人或好友的照顾
+func (p *Pet) recordCare(action TrainingAction) {
+	switch action {
+	case TrainingFeed:
+		p.Care.FeedCount++
+	case TrainingPlay:
+		p.Care.PlayCount++
+	case TrainingClean:
+		p.Care.CleanCount++
+	default:
+		return
+	}
+	p.Care.HappinessSum += p.Happiness
+	p.Care.CleanlinessSum += p.Cleanliness
+	p.Care.Samples++
+}
+
+// evolveForm 进入成熟期时选择进化形态并改变物种特有外观
+// 物种未配置形态或没有满足条件的形态时保持原样
+func (p *Pet) evolveForm() (EvolutionForm, bool) {
+	tendency := p.Gene.EvolutionTendency()
+	for _, form := range p.Forms {
+		if form.Condition.Matches(tendency, p.Care) {
+			p.Form = form.ID
+			p.SpecialAppearance = p.SpecialAppearance.WithFeatures(form.Features)
+			return form, true
+		}
+	}
+	return EvolutionForm{}, false
+}
+
+// CurrentForm 当前进化形态
+func (p *Pet) CurrentForm() (EvolutionForm, bool) {
+	if p.Form == "" {
+		return EvolutionForm{}, false
+	}
+	for _, form := range p.Forms {
+		if form.ID == p.Form {
+			return form, true
+		}
+	}
+	return EvolutionForm{}, false
+}
+
+// WithFeatures 返回用指定部位外观覆盖后的物种特有外观
+// 同类型部位被替换，原本没有的部位追加在末尾
+func (s SpecialAppearance) WithFeatures(features []PartAppearance) SpecialAppearance {
+	result := NewSpecialAppearance()
+	result.Parts = append(result.Parts, s.Parts...)
+	for _, feature := range features {
+		replaced := false
+		for i, part := range result.Parts {
+			if part.PartType == feature.PartType {
+				feature.Modifier = part.Modifier
+				result.Parts[i] = feature
+				replaced = true
+				break
+			}
+		}
+		if !replaced {
+			result.AddPart(feature)
+		}
+	}
+	return result
+}
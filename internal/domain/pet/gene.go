@@ -59,9 +59,9 @@ const (
 	GenePosHiddenSpecies = 34 // 隐藏物种触发
 	GenePosHiddenTrait   = 35 // 隐藏特质
 	GenePosBreedBonus    = 36 // 繁殖加成
-	GenePosRecessiveA    = 37 // 隐性基因A
-	GenePosRecessiveB    = 38 // 隐性基因B
-	GenePosRecessiveC    = 39 // 隐性基因C
+	GenePosRecessiveA    = 37 // 隐性基因A（物种启用孟德尔遗传时可存放性状组的第二个等位基因）
+	GenePosRecessiveB    = 38 // 隐性基因B（同上）
+	GenePosRecessiveC    = 39 // 隐性基因C（同上）
 )
 
 // Gene 基因值对象（不可变）
@@ -258,6 +258,14 @@ func (g Gene) SkillID(totalSkills int) int {
 	return g.SkillPrimaryID() % totalSkills
 }
 
+// WithRerolledSkill 重新随机主技能基因位（技能ID与强度），返回新基因
+func (g Gene) WithRerolledSkill() Gene {
+	code := []byte(g.code)
+	code[GenePosSkillPrimary] = hexChar(randomInt(16))
+	code[GenePosSkillStrength] = hexChar(randomInt(16))
+	return Gene{code: string(code)}
+}
+
 // SkillStrength 技能强度 (1-5星)
 func (g Gene) SkillStrength() int {
 	raw := g.HexAt(GenePosSkillStrength)
@@ -296,6 +304,20 @@ func (g Gene) Resistance() int {
 	return g.HexAt(GenePosResistance)
 }
 
+// WithResistance 设置抗性基因位（限制在 0-15），返回新基因
+func (g Gene) WithResistance(value int) Gene {
+	code := []byte(g.code)
+	code[GenePosResistance] = hexChar(minInt(maxInt(value, 0), 15))
+	return Gene{code: string(code)}
+}
+
+// withHexAt 设置单个基因位（0-15），返回新基因
+func (g Gene) withHexAt(pos, value int) Gene {
+	code := []byte(g.code)
+	code[pos] = hexChar(value)
+	return Gene{code: string(code)}
+}
+
 // --- 遗传隐藏解析 (位置 32-39) ---
 
 // MutationFactor 突变因子 (0-255)
@@ -0,0 +1,227 @@
+// Package pet 宠物领域
+// Genetics 孟德尔遗传 - 可选的二倍体等位基因模型，隐性花色可隔代重现
+package pet
+
+import (
+	"errors"
+	"strings"
+)
+
+// AlleleMutationRate 每个传给子代的等位基因随机突变的概率（百分比）
+const AlleleMutationRate = 3
+
+// alleleTraitDef 可按孟德尔规律遗传的性状组
+type alleleTraitDef struct {
+	Position int
+	Name     string
+}
+
+// alleleTraitDefs 性状组标识 → 显现位（外观与物种特征基因位）
+var alleleTraitDefs = map[string]alleleTraitDef{
+	"color_primary":   {GenePosColorPrimary, "主色"},
+	"color_secondary": {GenePosColorSecondary, "副色"},
+	"eye_shape":       {GenePosEyeShape, "眼型"},
+	"eye_color":       {GenePosEyeColor, "瞳色"},
+	"pattern":         {GenePosBasePattern, "花纹"},
+	"special_a":       {GenePosSpecialA, "特征A"},
+	"special_b":       {GenePosSpecialB, "特征B"},
+	"special_c":       {GenePosSpecialC, "特征C"},
+	"special_d":       {GenePosSpecialD, "特征D"},
+}
+
+// alleleSlots 隐性槽位标识 → 基因位
+var alleleSlots = map[string]int{
+	"a": GenePosRecessiveA,
+	"b": GenePosRecessiveB,
+	"c": GenePosRecessiveC,
+}
+
+// AlleleTrait 按孟德尔规律遗传的性状组（值对象，来自物种配置）
+// 二倍体：显现位保存表现出来的等位基因，隐性槽位保存另一个，基因码仍为 40 位
+type AlleleTrait struct {
+	Key       string       // 性状组标识（如 pattern）
+	Name      string       // 性状组名称
+	Position  int          // 显现位
+	Slot      int          // 第二个等位基因所在的隐性槽位（37-39）
+	Recessive map[int]bool // 隐性等位基因（十六进制值 0-15），其余为显性
+}
+
+// NewAlleleTrait 创建性状组
+// key 为性状组标识，slot 为隐性槽位 a/b/c，recessive 为隐性等位基因
+func NewAlleleTrait(key, slot string, recessive []int) (AlleleTrait, error) {
+	def, ok := alleleTraitDefs[strings.ToLower(key)]
+	if !ok {
+		return AlleleTrait{}, ErrUnknownAlleleTrait
+	}
+	slotPos, ok := alleleSlots[strings.ToLower(slot)]
+	if !ok {
+		return AlleleTrait{}, ErrInvalidAlleleSlot
+	}
+	if len(recessive) == 0 {
+		return AlleleTrait{}, ErrInvalidAllele
+	}
+
+	trait := AlleleTrait{
+		Key:       strings.ToLower(key),
+		Name:      def.Name,
+		Position:  def.Position,
+		Slot:      slotPos,
+		Recessive: make(map[int]bool, len(recessive)),
+	}
+	for _, v := range recessive {
+		if v < 0 || v > 15 {
+			return AlleleTrait{}, ErrInvalidAllele
+		}
+		trait.Recessive[v] = true
+	}
+	// 全部为隐性时没有显隐性之分
+	if len(trait.Recessive) == 16 {
+		return AlleleTrait{}, ErrInvalidAllele
+	}
+	return trait, nil
+}
+
+// IsRecessive 等位基因是否为隐性
+func (t AlleleTrait) IsRecessive(allele int) bool {
+	return t.Recessive[allele]
+}
+
+// SlotName 隐性槽位标识（a/b/c），也用作基因型记号的字母
+func (t AlleleTrait) SlotName() string {
+	for name, pos := range alleleSlots {
+		if pos == t.Slot {
+			return name
+		}
+	}
+	return "?"
+}
+
+// alleles 宠物在该性状组上的两个等位基因（显现位、隐性槽位）
+func (t AlleleTrait) alleles(gene Gene) (int, int) {
+	return gene.HexAt(t.Position), gene.HexAt(t.Slot)
+}
+
+// express 按显隐性排列两个等位基因：显性压过隐性，同为显性或同为隐性时保持原顺序
+func (t AlleleTrait) express(a, b int) (int, int) {
+	if t.IsRecessive(a) && !t.IsRecessive(b) {
+		return b, a
+	}
+	return a, b
+}
+
+// Genotype 一个性状组的基因型与表现型
+type Genotype struct {
+	Trait     AlleleTrait
+	Expressed int // 表现出来的等位基因（显现位的值，即外观所见）
+	Hidden    int // 未表现的等位基因（隐性槽位的值）
+}
+
+// Dominant 表现型是否为显性
+func (g Genotype) Dominant() bool {
+	return !g.Trait.IsRecessive(g.Expressed)
+}
+
+// Carrier 是否为携带者：表现为显性，但携带一个隐性等位基因
+func (g Genotype) Carrier() bool {
+	return g.Dominant() && g.Trait.IsRecessive(g.Hidden)
+}
+
+// Homozygous 两个等位基因是否相同
+func (g Genotype) Homozygous() bool {
+	return g.Expressed == g.Hidden
+}
+
+// Notation 基因型记号：显性大写、隐性小写，显性在前（如 Aa 为携带者，aa 表现隐性）
+func (g Genotype) Notation() string {
+	letter := func(allele int) string {
+		if g.Trait.IsRecessive(allele) {
+			return strings.ToLower(g.Trait.SlotName())
+		}
+		return strings.ToUpper(g.Trait.SlotName())
+	}
+	first, second := letter(g.Expressed), letter(g.Hidden)
+	if first > second {
+		first, second = second, first
+	}
+	return first + second
+}
+
+// Genetics 物种的孟德尔遗传模型（值对象，来自物种配置）
+// 未配置性状组时不启用，全部基因位沿用 InheritFrom 的逐位遗传
+type Genetics struct {
+	Traits []AlleleTrait
+}
+
+// NewGenetics 创建孟德尔遗传模型，同一显现位或隐性槽位只能属于一个性状组
+func NewGenetics(traits []AlleleTrait) (Genetics, error) {
+	positions := make(map[int]bool, len(traits)*2)
+	for _, t := range traits {
+		if positions[t.Position] || positions[t.Slot] {
+			return Genetics{}, ErrDuplicateAlleleTrait
+		}
+		positions[t.Position] = true
+		positions[t.Slot] = true
+	}
+	return Genetics{Traits: traits}, nil
+}
+
+// Enabled 是否启用孟德尔遗传
+func (g Genetics) Enabled() bool {
+	return len(g.Traits) > 0
+}
+
+// Genotypes 宠物各性状组的基因型
+func (g Genetics) Genotypes(gene Gene) []Genotype {
+	result := make([]Genotype, 0, len(g.Traits))
+	for _, t := range g.Traits {
+		expressed, hidden := t.alleles(gene)
+		result = append(result, Genotype{Trait: t, Expressed: expressed, Hidden: hidden})
+	}
+	return result
+}
+
+// Normalize 整理基因：显性等位基因放到显现位，保证外观与基因型一致
+// 用于随机生成的基因和分裂繁殖的后代
+func (g Genetics) Normalize(gene Gene) Gene {
+	for _, t := range g.Traits {
+		expressed, hidden := t.express(t.alleles(gene))
+		gene = gene.withHexAt(t.Position, expressed).withHexAt(t.Slot, hidden)
+	}
+	return gene
+}
+
+// Inherit 按孟德尔规律重新遗传各性状组，其余基因位保持 child 不变
+// 双亲各随机传出一个等位基因（小概率突变），显性的放到显现位
+func (g Genetics) Inherit(child, parent1, parent2 Gene) Gene {
+	for _, t := range g.Traits {
+		a := transmitAllele(t, parent1)
+		b := transmitAllele(t, parent2)
+		// 同为显性或同为隐性时随机决定表现哪一个
+		if randomInt(2) == 0 {
+			a, b = b, a
+		}
+		expressed, hidden := t.express(a, b)
+		child = child.withHexAt(t.Position, expressed).withHexAt(t.Slot, hidden)
+	}
+	return child
+}
+
+// transmitAllele 亲本随机传出的一个等位基因
+func transmitAllele(t AlleleTrait, parent Gene) int {
+	if randomInt(100) < AlleleMutationRate {
+		return randomInt(16)
+	}
+	expressed, hidden := t.alleles(parent)
+	if randomInt(2) == 0 {
+		return expressed
+	}
+	return hidden
+}
+
+// 孟德尔遗传相关错误
+var (
+	ErrUnknownAlleleTrait   = errors.New("未知的性状组")
+	ErrInvalidAlleleSlot    = errors.New("隐性槽位须为 a、b 或 c")
+	ErrInvalidAllele        = errors.New("隐性等位基因须为 0-15，且不能全部为隐性")
+	ErrDuplicateAlleleTrait = errors.New("性状组的显现位或隐性槽位重复")
+)
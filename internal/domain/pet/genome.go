@@ -0,0 +1,220 @@
+// Package pet 宠物领域
+// Genome 基因组报告 - 把 40 位基因码拆成五个片段逐位解读，并给出每一位在全部宠物中的百分位
+package pet
+
+import "fmt"
+
+// GeneSegment 基因片段
+type GeneSegment struct {
+	Key    string // 片段标识
+	Name   string // 片段名称
+	Start  int    // 起始基因位
+	End    int    // 结束基因位（不含）
+	Hidden bool   // 是否为隐藏片段（需要基因扫描仪才能查看）
+}
+
+// GeneSegments 基因码的五个片段
+var GeneSegments = []GeneSegment{
+	{Key: "appearance", Name: "通用外观", Start: 0, End: 8},
+	{Key: "species", Name: "物种特征", Start: 8, End: 16},
+	{Key: "personality", Name: "性格", Start: 16, End: 24},
+	{Key: "skill", Name: "技能/能力", Start: 24, End: 32},
+	{Key: "hidden", Name: "遗传隐藏", Start: 32, End: 40, Hidden: true},
+}
+
+// genomeTraitDef 基因位的名称与解读方式（物种特征片段由物种解释器解读）
+type genomeTraitDef struct {
+	Name   string
+	Decode func(g Gene) string
+}
+
+// genomeTraitDefs 基因位 → 解读方式
+var genomeTraitDefs = map[int]genomeTraitDef{
+	GenePosColorPrimary:    {"主色色相", func(g Gene) string { return fmt.Sprintf("色相 %d°", g.PrimaryColorHue()) }},
+	GenePosColorSaturation: {"主色饱和度", func(g Gene) string { return fmt.Sprintf("饱和度 %d%%", g.PrimarySaturation()) }},
+	GenePosColorSecondary:  {"副色色相", func(g Gene) string { return fmt.Sprintf("色相 %d°", g.SecondaryColorHue()) }},
+	GenePosBodySize:        {"体型大小", func(g Gene) string { return Appearance{BodyType: g.BodyType()}.BodyTypeName() }},
+	GenePosEyeShape:        {"眼睛形状", func(g Gene) string { return Appearance{EyeShape: g.EyeShape()}.EyeShapeName() }},
+	GenePosEyeColor:        {"眼睛颜色", func(g Gene) string { return fmt.Sprintf("瞳色 %d 号", g.EyeColor()) }},
+	GenePosBasePattern:     {"基础花纹", func(g Gene) string { return Appearance{PatternType: g.BasePattern()}.PatternTypeName() }},
+	GenePosPatternDensity:  {"花纹密度", func(g Gene) string { return fmt.Sprintf("密度 %d/15", g.PatternDensity()) }},
+
+	GenePosActivity:     {"活跃度", func(g Gene) string { return fmt.Sprintf("%d/100", g.ActivityTrait()) }},
+	GenePosAppetite:     {"贪吃度", func(g Gene) string { return fmt.Sprintf("%d/100", g.AppetiteTrait()) }},
+	GenePosSocial:       {"社交度", func(g Gene) string { return fmt.Sprintf("%d/100", g.SocialTrait()) }},
+	GenePosCuriosity:    {"好奇度", func(g Gene) string { return fmt.Sprintf("%d/100", g.CuriosityTrait()) }},
+	GenePosTemper:       {"脾气", func(g Gene) string { return fmt.Sprintf("%d/100", g.TemperTrait()) }},
+	GenePosLoyalty:      {"忠诚度", func(g Gene) string { return fmt.Sprintf("%d/100", g.LoyaltyTrait()) }},
+	GenePosIntelligence: {"智力", func(g Gene) string { return fmt.Sprintf("%d/100", g.IntelligenceTrait()) }},
+	GenePosPlayfulness:  {"玩乐度", func(g Gene) string { return fmt.Sprintf("%d/100", g.PlayfulnessTrait()) }},
+
+	GenePosSkillPrimary:   {"主技能", func(g Gene) string { return NewSkillFromGene(g).Name() }},
+	GenePosSkillStrength:  {"主技能强度", func(g Gene) string { return fmt.Sprintf("%d 星", g.SkillStrength()) }},
+	GenePosSkillSecondary: {"副技能", func(g Gene) string { return NewSecondarySkillFromGene(g).Name() }},
+	GenePosAbilityA:       {"特殊能力A", func(g Gene) string { return AbilityFromGene(g.AbilityA()).Name() }},
+	GenePosAbilityB:       {"特殊能力B", func(g Gene) string { return AbilityFromGene(g.AbilityB()).Name() }},
+	GenePosGrowthRate:     {"成长速率", func(g Gene) string { return fmt.Sprintf("×%.3f", g.GrowthRate()) }},
+	GenePosLifespan:       {"寿命倾向", func(g Gene) string { return fmt.Sprintf("×%.2f", g.LifespanModifier()) }},
+	GenePosResistance:     {"抗性", func(g Gene) string { return fmt.Sprintf("%d/15", g.Resistance()) }},
+
+	GenePosMutation:      {"突变因子", func(g Gene) string { return fmt.Sprintf("%d/255（与下一位组合）", g.MutationFactor()) }},
+	GenePosEvolution:     {"进化倾向", func(g Gene) string { return fmt.Sprintf("%d/255（与下一位组合）", g.EvolutionTendency()) }},
+	GenePosHiddenSpecies: {"隐藏物种触发", func(g Gene) string { return fmt.Sprintf("%d/255（与下一位组合）", g.HiddenSpeciesTrigger()) }},
+	GenePosHiddenTrait:   {"隐藏特质", func(g Gene) string { return fmt.Sprintf("%d/15", g.HiddenTrait()) }},
+	GenePosBreedBonus:    {"繁殖加成", func(g Gene) string { return fmt.Sprintf("%d/15", g.BreedBonus()) }},
+	GenePosRecessiveA:    {"隐性基因A", func(g Gene) string { return fmt.Sprintf("%d/15", g.RecessiveA()) }},
+	GenePosRecessiveB:    {"隐性基因B", func(g Gene) string { return fmt.Sprintf("%d/15", g.RecessiveB()) }},
+	GenePosRecessiveC:    {"隐性基因C", func(g Gene) string { return fmt.Sprintf("%d/15", g.RecessiveC()) }},
+}
+
+// GenePool 全部宠物在每个基因位上的取值分布（用于计算百分位）
+type GenePool struct {
+	Total  int                 // 参与统计的宠物数
+	Counts [GeneLength][16]int // 每个基因位上各取值（0-15）的宠物数
+}
+
+// NewGenePool 创建空的基因分布
+func NewGenePool() *GenePool {
+	return &GenePool{}
+}
+
+// Add 记录 count 只宠物在 pos 位上取值为 value，Total 由调用方设置
+func (p *GenePool) Add(pos, value, count int) {
+	if pos < 0 || pos >= GeneLength || value < 0 || value > 15 {
+		return
+	}
+	p.Counts[pos][value] += count
+}
+
+// Percentile 取值在该基因位上的百分位（0-100）：低于它的宠物占比加上相同取值的一半
+// 没有统计数据时返回 0
+func (p *GenePool) Percentile(pos, value int) int {
+	if p == nil || p.Total <= 0 || pos < 0 || pos >= GeneLength {
+		return 0
+	}
+	below, same := 0, 0
+	for v, count := range p.Counts[pos] {
+		switch {
+		case v < value:
+			below += count
+		case v == value:
+			same += count
+		}
+	}
+	return minInt((below*200+same*100)/(p.Total*2), 100)
+}
+
+// GenomeTrait 单个基因位的解读
+type GenomeTrait struct {
+	Position   int    // 基因位
+	Name       string // 含义
+	Raw        int    // 原始值（0-15）
+	Meaning    string // 解读结果
+	Percentile int    // 在全部宠物中的百分位（0-100）
+}
+
+// GenomeSegment 基因片段的解读
+type GenomeSegment struct {
+	Segment GeneSegment
+	Masked  bool          // 隐藏片段且没有基因扫描仪，不给出数值和解读
+	Traits  []GenomeTrait // 逐位解读，被遮蔽时为空
+}
+
+// GenomeReport 宠物的基因组报告
+type GenomeReport struct {
+	Code     string // 基因码，被遮蔽的位用 * 代替
+	Segments []GenomeSegment
+	PoolSize int // 计算百分位时参与统计的宠物数
+}
+
+// InspectGenome 解读基因组
+// 物种特征片段由物种解释器解读（可为 nil）；启用孟德尔遗传的性状组附带基因型；
+// revealHidden 为 false 时隐藏片段被遮蔽
+func InspectGenome(gene Gene, interpreter GeneInterpreter, genetics Genetics, pool *GenePool, revealHidden bool) GenomeReport {
+	names, meanings := genomeTraitMeanings(gene, interpreter)
+	for _, gt := range genetics.Genotypes(gene) {
+		// 基因型和携带隐性会暴露隐藏片段中的等位基因，遮蔽时不标注
+		if revealHidden {
+			note := fmt.Sprintf("（基因型 %s", gt.Notation())
+			if gt.Carrier() {
+				note += "，携带隐性"
+			}
+			note += "）"
+			meanings[gt.Trait.Position] += note
+		}
+		names[gt.Trait.Slot] = gt.Trait.Name + "的第二个等位基因"
+		meanings[gt.Trait.Slot] = fmt.Sprintf("%d/15（基因型 %s）", gt.Hidden, gt.Notation())
+	}
+
+	code := []byte(gene.String())
+	report := GenomeReport{Segments: make([]GenomeSegment, 0, len(GeneSegments))}
+	if pool != nil {
+		report.PoolSize = pool.Total
+	}
+	for _, seg := range GeneSegments {
+		segment := GenomeSegment{Segment: seg, Masked: seg.Hidden && !revealHidden}
+		for pos := seg.Start; pos < seg.End; pos++ {
+			if segment.Masked {
+				if pos < len(code) {
+					code[pos] = '*'
+				}
+				continue
+			}
+			raw := gene.HexAt(pos)
+			segment.Traits = append(segment.Traits, GenomeTrait{
+				Position:   pos,
+				Name:       names[pos],
+				Raw:        raw,
+				Meaning:    meanings[pos],
+				Percentile: pool.Percentile(pos, raw),
+			})
+		}
+		report.Segments = append(report.Segments, segment)
+	}
+	report.Code = string(code)
+	return report
+}
+
+// MaskedGeneCode 遮蔽隐藏片段后的基因码，被遮蔽的位用 * 代替
+func MaskedGeneCode(gene Gene) string {
+	code := []byte(gene.String())
+	for _, seg := range GeneSegments {
+		if !seg.Hidden {
+			continue
+		}
+		for pos := seg.Start; pos < seg.End && pos < len(code); pos++ {
+			code[pos] = '*'
+		}
+	}
+	return string(code)
+}
+
+// genomeTraitMeanings 各基因位的名称与解读
+// 物种特征片段：特征位（8-11）按解释器给出的部位样式解读，修饰位（12-15）为对应部位的修饰值
+func genomeTraitMeanings(gene Gene, interpreter GeneInterpreter) (map[int]string, map[int]string) {
+	names := make(map[int]string, GeneLength)
+	meanings := make(map[int]string, GeneLength)
+	for pos, def := range genomeTraitDefs {
+		names[pos] = def.Name
+		meanings[pos] = def.Decode(gene)
+	}
+
+	var parts []PartAppearance
+	if interpreter != nil {
+		parts = interpreter.InterpretSpecialFeatures(gene).Parts
+	}
+	for i, label := range []string{"A", "B", "C", "D"} {
+		featurePos, modPos := GenePosSpecialA+i, GenePosSpecialModA+i
+		if i < len(parts) {
+			names[featurePos] = parts[i].PartType.Name()
+			meanings[featurePos] = parts[i].Style
+			names[modPos] = parts[i].PartType.Name() + "修饰"
+		} else {
+			names[featurePos] = "特征" + label
+			meanings[featurePos] = "该物种不解读此位"
+			names[modPos] = "特征" + label + "修饰"
+		}
+		meanings[modPos] = fmt.Sprintf("修饰值 %d/15", gene.HexAt(modPos))
+	}
+	return names, meanings
+}
@@ -0,0 +1,131 @@
+// Package pet 宠物领域
+// Growth 成长曲线 - 物种决定升级经验和进化等级，基因决定成长速度
+package pet
+
+import "math"
+
+// 默认成长曲线（物种未配置时使用）
+const (
+	DefaultBaseExp      = 100 // 每级所需经验基数
+	DefaultExpExponent  = 1.0 // 经验曲线指数
+	DefaultTeenLevel    = 10  // 进入成长期的等级
+	DefaultAdultLevel   = 25  // 进入成熟期的等级
+	DefaultElderlyLevel = 50  // 进入老年期的等级
+)
+
+// GrowthCurve 成长曲线（值对象）
+// 从 n 级升到 n+1 级所需经验 = BaseExp × n^ExpExponent
+type GrowthCurve struct {
+	BaseExp      int     // 每级所需经验基数
+	ExpExponent  float64 // 经验曲线指数，1 为线性增长
+	TeenLevel    int     // 进入成长期的等级
+	AdultLevel   int     // 进入成熟期的等级
+	ElderlyLevel int     // 进入老年期的等级
+}
+
+// DefaultGrowthCurve 默认成长曲线
+func DefaultGrowthCurve() GrowthCurve {
+	return GrowthCurve{
+		BaseExp:      DefaultBaseExp,
+		ExpExponent:  DefaultExpExponent,
+		TeenLevel:    DefaultTeenLevel,
+		AdultLevel:   DefaultAdultLevel,
+		ElderlyLevel: DefaultElderlyLevel,
+	}
+}
+
+// Normalize 未配置的字段使用默认值
+func (c GrowthCurve) Normalize() GrowthCurve {
+	if c.BaseExp <= 0 {
+		c.BaseExp = DefaultBaseExp
+	}
+	if c.ExpExponent <= 0 {
+		c.ExpExponent = DefaultExpExponent
+	}
+	if c.TeenLevel <= 0 {
+		c.TeenLevel = DefaultTeenLevel
+	}
+	if c.AdultLevel <= 0 {
+		c.AdultLevel = DefaultAdultLevel
+	}
+	if c.ElderlyLevel <= 0 {
+		c.ElderlyLevel = DefaultElderlyLevel
+	}
+	return c
+}
+
+// IsValid 进化等级是否按阶段递增
+func (c GrowthCurve) IsValid() bool {
+	c = c.Normalize()
+	return c.TeenLevel > 1 && c.TeenLevel < c.AdultLevel && c.AdultLevel < c.ElderlyLevel
+}
+
+// RequiredExp 从 level 级升到下一级所需经验
+func (c GrowthCurve) RequiredExp(level int) int {
+	c = c.Normalize()
+	if level < 1 {
+		level = 1
+	}
+	return int(math.Round(float64(c.BaseExp) * math.Pow(float64(level), c.ExpExponent)))
+}
+
+// TotalExp 从 from 级升到 to 级累计所需经验
+func (c GrowthCurve) TotalExp(from, to int) int {
+	total := 0
+	for level := from; level < to; level++ {
+		total += c.RequiredExp(level)
+	}
+	return total
+}
+
+// StageLevel 进入某阶段所需等级
+// 蛋和幼年期不由等级决定（孵化只看时间），返回 0
+func (c GrowthCurve) StageLevel(stage Stage) int {
+	c = c.Normalize()
+	switch stage {
+	case StageTeen:
+		return c.TeenLevel
+	case StageAdult:
+		return c.AdultLevel
+	case StageElderly:
+		return c.ElderlyLevel
+	default:
+		return 0
+	}
+}
+
+// NextStage 由等级决定的下一阶段及其所需等级
+// 蛋（等待孵化）和老年期没有下一阶段
+func (c GrowthCurve) NextStage(stage Stage) (Stage, int, bool) {
+	switch stage {
+	case StageChild, StageTeen, StageAdult:
+		next := stage + 1
+		return next, c.StageLevel(next), true
+	default:
+		return 0, 0, false
+	}
+}
+
+// RequiredExp 当前等级升级所需经验
+func (p *Pet) RequiredExp() int {
+	return p.Growth.RequiredExp(p.Level)
+}
+
+// ExpToNextStage 距离下一阶段还需的经验（未计入基因成长速率）
+// 没有由等级决定的下一阶段时返回 false
+func (p *Pet) ExpToNextStage() (Stage, int, bool) {
+	next, level, ok := p.Growth.NextStage(p.Stage)
+	if !ok {
+		return 0, 0, false
+	}
+	if p.Level >= level {
+		return next, 0, true
+	}
+	remaining := p.RequiredExp() - p.Exp + p.Growth.TotalExp(p.Level+1, level)
+	return next, maxInt(remaining, 0), true
+}
+
+// scaleExp 按基因成长速率修正获得的经验，至少获得 1 点
+func (p *Pet) scaleExp(exp int) int {
+	return maxInt(int(math.Round(float64(exp)*p.Gene.GrowthRate())), 1)
+}
@@ -0,0 +1,151 @@
+// Package pet 宠物领域
+// Health 健康 - 长期饥饿或不清洁可能生病，抗性基因降低患病概率
+package pet
+
+import "time"
+
+// Illness 疾病
+type Illness int
+
+const (
+	IllnessNone         Illness = iota // 健康
+	IllnessCold                        // 感冒（长期不清洁）
+	IllnessMalnutrition                // 营养不良（长期饥饿）
+)
+
+// Name 疾病名称
+func (i Illness) Name() string {
+	names := []string{"健康", "感冒", "营养不良"}
+	if int(i) < len(names) {
+		return names[i]
+	}
+	return "未知"
+}
+
+// 健康参数
+const (
+	IllnessRiskThreshold = 20  // 饱食度或清洁度低于该值时有患病风险
+	IllnessBaseChance    = 20  // 每小时基础患病概率（%），抗性满值时降为四分之一
+	IllnessMaxRollHours  = 24  // 一次检查最多补算的小时数
+	SickDecayMultiplier  = 1.5 // 生病时饱食度、快乐度、清洁度衰减倍数
+	SickEnergyMultiplier = 0.5 // 生病时精力恢复倍数
+)
+
+// IsSick 是否生病
+func (p *Pet) IsSick() bool {
+	return p.Illness != IllnessNone
+}
+
+// IllnessChance 每小时的患病概率（%）
+// 抗性 0-15，每点降低 1/20 的基础概率；强健能力再减半
+func (p *Pet) IllnessChance() int {
+	chance := IllnessBaseChance * (20 - p.Gene.Resistance()) / 20
+	if p.HasAbility(AbilityImmune) {
+		chance = int(float64(chance) * ImmuneIllnessRate)
+	}
+	return chance
+}
+
+// illnessRisk 当前状态可能引发的疾病，没有风险时返回 IllnessNone
+func illnessRisk(hunger, cleanliness int) Illness {
+	if cleanliness < IllnessRiskThreshold {
+		return IllnessCold
+	}
+	if hunger < IllnessRiskThreshold {
+		return IllnessMalnutrition
+	}
+	return IllnessNone
+}
+
+// CheckHealth 健康检查，按距上次检查的整小时数逐小时掷骰判定是否患病
+// 蛋和已生病的宠物不检查；没有患病风险时只推进检查锚点
+// 返回是否在本次检查中生病
+func (p *Pet) CheckHealth(now time.Time) bool {
+	if p.Stage == StageEgg || p.IsSick() {
+		return false
+	}
+	if p.HealthCheckedAt.IsZero() || now.Before(p.HealthCheckedAt) {
+		p.HealthCheckedAt = now
+		return false
+	}
+
+	hunger, _, cleanliness, _ := p.StatusAt(now)
+	illness := illnessRisk(hunger, cleanliness)
+	if illness == IllnessNone {
+		p.HealthCheckedAt = now
+		return false
+	}
+
+	// 不足一小时的部分留到下次检查
+	hours := int(now.Sub(p.HealthCheckedAt).Hours())
+	if hours < 1 {
+		return false
+	}
+	p.HealthCheckedAt = p.HealthCheckedAt.Add(time.Duration(hours) * time.Hour)
+	if hours > IllnessMaxRollHours {
+		hours = IllnessMaxRollHours
+	}
+
+	chance := p.IllnessChance()
+	for i := 0; i < hours; i++ {
+		if randomInt(100) < chance {
+			p.fallIll(illness, now)
+			return true
+		}
+	}
+	return false
+}
+
+// fallIll 生病
+func (p *Pet) fallIll(illness Illness, now time.Time) {
+	p.Illness = illness
+	p.IllAt = &now
+
+	p.addEvent(PetFellIllEvent{
+		PetID:     p.ID,
+		UserID:    p.UserID,
+		Illness:   int(illness),
+		Timestamp: now,
+	})
+}
+
+// Cure 治愈（药品道具效果）
+// 治愈后重新开始计算患病风险
+func (p *Pet) Cure() error {
+	if !p.IsSick() {
+		return ErrPetNotSick
+	}
+
+	now := time.Now()
+	illness := p.Illness
+	p.Illness = IllnessNone
+	p.IllAt = nil
+	p.HealthCheckedAt = now
+
+	p.addEvent(PetRecoveredEvent{
+		PetID:     p.ID,
+		UserID:    p.UserID,
+		Illness:   int(illness),
+		Timestamp: now,
+	})
+
+	// 熬过疾病锻炼耐力
+	p.TrainSkill(TrainingRecover)
+	return nil
+}
+
+// sickDecayRate 生病时的状态衰减倍数
+func (p *Pet) sickDecayRate() float64 {
+	if p.IsSick() {
+		return SickDecayMultiplier
+	}
+	return 1
+}
+
+// sickEnergyRate 生病时的精力恢复倍数
+func (p *Pet) sickEnergyRate() float64 {
+	if p.IsSick() {
+		return SickEnergyMultiplier
+	}
+	return 1
+}
@@ -0,0 +1,162 @@
+// Package pet 宠物领域
+// Inbreeding 近亲繁殖 - 由血统图谱计算近交系数，系数越高后代越容易突变、抗性越低
+package pet
+
+import (
+	"errors"
+	"math"
+)
+
+// 近交系数参数
+const (
+	InbreedingDepth = 5 // 计算近交系数时向上追溯的代数
+
+	DefaultInbreedingWarnThreshold     = 0.125 // 默认提醒阈值（半同胞后代为 0.125）
+	DefaultInbreedingMutationBonus     = 20    // 默认近交系数为 1 时额外增加的突变率（百分比）
+	DefaultInbreedingResistancePenalty = 16    // 默认近交系数为 1 时抗性基因（0-15）降低的值
+)
+
+// InbreedingRules 近亲繁殖规则（值对象，来自配置）
+type InbreedingRules struct {
+	WarnThreshold     float64 // 达到该系数时提醒玩家
+	BlockThreshold    float64 // 达到该系数时禁止繁殖，0 表示不禁止
+	MutationBonus     int     // 近交系数为 1 时额外增加的突变率（百分比），按系数线性折算
+	ResistancePenalty int     // 近交系数为 1 时抗性基因降低的值，按系数线性折算
+}
+
+// DefaultInbreedingRules 默认近亲繁殖规则（不禁止繁殖）
+func DefaultInbreedingRules() InbreedingRules {
+	return InbreedingRules{
+		WarnThreshold:     DefaultInbreedingWarnThreshold,
+		MutationBonus:     DefaultInbreedingMutationBonus,
+		ResistancePenalty: DefaultInbreedingResistancePenalty,
+	}
+}
+
+// Normalize 未配置的字段使用默认值
+func (r InbreedingRules) Normalize() InbreedingRules {
+	if r.WarnThreshold <= 0 {
+		r.WarnThreshold = DefaultInbreedingWarnThreshold
+	}
+	if r.BlockThreshold < 0 {
+		r.BlockThreshold = 0
+	}
+	if r.MutationBonus <= 0 {
+		r.MutationBonus = DefaultInbreedingMutationBonus
+	}
+	if r.ResistancePenalty <= 0 {
+		r.ResistancePenalty = DefaultInbreedingResistancePenalty
+	}
+	return r
+}
+
+// Assess 评估近交系数带来的影响
+func (r InbreedingRules) Assess(coefficient float64) Inbreeding {
+	if coefficient <= 0 {
+		return Inbreeding{}
+	}
+	return Inbreeding{
+		Coefficient:       coefficient,
+		Warning:           coefficient >= r.WarnThreshold,
+		Blocked:           r.BlockThreshold > 0 && coefficient >= r.BlockThreshold,
+		ExtraMutation:     int(math.Round(coefficient * float64(r.MutationBonus))),
+		ResistancePenalty: int(math.Round(coefficient * float64(r.ResistancePenalty))),
+	}
+}
+
+// Inbreeding 一次繁殖的近亲程度及其影响（值对象）
+type Inbreeding struct {
+	Coefficient       float64 // 近交系数（0-1）：全同胞或亲子为 0.25，半同胞为 0.125
+	Warning           bool    // 是否需要提醒玩家
+	Blocked           bool    // 是否禁止繁殖
+	ExtraMutation     int     // 后代额外的突变率（百分比）
+	ResistancePenalty int     // 后代抗性基因降低的值
+}
+
+// ApplyTo 将近亲繁殖的影响作用于后代基因：额外突变后降低抗性
+func (i Inbreeding) ApplyTo(gene Gene) Gene {
+	if i.ExtraMutation > 0 {
+		gene = SelfReplicate(gene, float64(i.ExtraMutation)/100)
+	}
+	if i.ResistancePenalty > 0 {
+		gene = gene.WithResistance(gene.Resistance() - i.ResistancePenalty)
+	}
+	return gene
+}
+
+// InbreedingOf 两只宠物繁殖的后代的近交系数，即双亲的亲缘系数
+// 图谱中没有的宠物视为无亲缘关系
+func (g *LineageGraph) InbreedingOf(parent1ID, parent2ID int) float64 {
+	return g.Kinship(parent1ID, parent2ID)
+}
+
+// Kinship 两只宠物的亲缘系数（从两者各随机取一个基因，二者同源的概率）
+// 按递归法计算：展开代数较大的一方的亲本，分裂繁殖的后代视为亲本与自身繁殖
+func (g *LineageGraph) Kinship(a, b int) float64 {
+	return g.kinship(a, b, make(map[[2]int]float64))
+}
+
+func (g *LineageGraph) kinship(a, b int, memo map[[2]int]float64) float64 {
+	if a > b {
+		a, b = b, a
+	}
+	key := [2]int{a, b}
+	if v, ok := memo[key]; ok {
+		return v
+	}
+
+	na, okA := g.nodes[a]
+	nb, okB := g.nodes[b]
+	var result float64
+	switch {
+	case !okA || !okB:
+		result = 0
+	case a == b:
+		result = (1 + g.inbreedingOfNode(na, memo)) / 2
+	default:
+		// 祖先的代数总是小于后代，展开代数较大的一方
+		if na.Generation < nb.Generation {
+			na, nb = nb, na
+		}
+		// 图谱中没有的亲本与对方无亲缘关系，计为 0
+		if len(na.ParentIDs) > 0 {
+			sum := 0.0
+			for _, parentID := range g.knownParents(na) {
+				sum += g.kinship(parentID, nb.PetID, memo)
+			}
+			result = sum / float64(len(na.ParentIDs))
+		}
+	}
+
+	memo[key] = result
+	return result
+}
+
+// inbreedingOfNode 图谱中宠物自身的近交系数
+func (g *LineageGraph) inbreedingOfNode(n LineageNode, memo map[[2]int]float64) float64 {
+	parents := g.knownParents(n)
+	switch {
+	case len(n.ParentIDs) == 1 && len(parents) == 1:
+		return g.kinship(parents[0], parents[0], memo)
+	case len(parents) == 2:
+		return g.kinship(parents[0], parents[1], memo)
+	default:
+		return 0
+	}
+}
+
+// knownParents 图谱中已有的亲本ID（代数须小于宠物自身，防止脏数据成环）
+func (g *LineageGraph) knownParents(n LineageNode) []int {
+	var ids []int
+	for _, id := range n.ParentIDs {
+		if parent, ok := g.nodes[id]; ok && parent.Generation < n.Generation {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
+
+// 近亲繁殖相关错误
+var (
+	ErrTooInbred = errors.New("双亲血缘太近，不能繁殖")
+)
@@ -0,0 +1,114 @@
+// Package pet 宠物领域
+// Incubation 孵化 - 蛋经过物种孵化时间后孵化，保温和孵化器可缩短等待
+package pet
+
+import "time"
+
+// 孵化参数
+const (
+	DefaultIncubationHours = 24               // 物种未配置时的默认孵化时间（小时）
+	WarmReduction          = 30 * time.Minute // 每次保温缩短的孵化时间
+	WarmCooldown           = time.Hour        // 两次保温的最短间隔
+)
+
+// SetIncubation 按物种孵化时间设置预计孵化时间（从出生时间起算）
+// 只对蛋有效，由领域服务在创建宠物时调用
+func (p *Pet) SetIncubation(d time.Duration) {
+	if p.Stage != StageEgg {
+		return
+	}
+	hatchAt := p.BornAt.Add(d)
+	p.HatchAt = &hatchAt
+}
+
+// HatchTime 孵化时间
+// 蛋为预计孵化时间，已孵化的宠物为实际孵化时间；老数据未记录时按默认孵化时间推算
+func (p *Pet) HatchTime() time.Time {
+	if p.HatchAt != nil {
+		return *p.HatchAt
+	}
+	return p.BornAt.Add(DefaultIncubationHours * time.Hour)
+}
+
+// IncubationRemaining 距离可孵化的剩余时间
+func (p *Pet) IncubationRemaining(now time.Time) time.Duration {
+	if p.Stage != StageEgg {
+		return 0
+	}
+	remaining := p.HatchTime().Sub(now)
+	if remaining < 0 {
+		return 0
+	}
+	return remaining
+}
+
+// CanHatch 是否已可孵化
+func (p *Pet) CanHatch(now time.Time) bool {
+	return p.Stage == StageEgg && !now.Before(p.HatchTime())
+}
+
+// Warm 给蛋保温，缩短孵化时间
+func (p *Pet) Warm(now time.Time) error {
+	if p.Stage != StageEgg {
+		return ErrPetNotEgg
+	}
+	if !p.LastWarmedAt.IsZero() && now.Sub(p.LastWarmedAt) < WarmCooldown {
+		return ErrWarmCooldown
+	}
+	if p.CanHatch(now) {
+		return ErrEggReady
+	}
+
+	p.LastWarmedAt = now
+	return p.ShortenIncubation(WarmReduction)
+}
+
+// ShortenIncubation 缩短孵化时间（保温或孵化器道具）
+// 最多缩短到立即可孵化
+func (p *Pet) ShortenIncubation(d time.Duration) error {
+	if p.Stage != StageEgg {
+		return ErrPetNotEgg
+	}
+	if d <= 0 {
+		return ErrInvalidStatusAmount
+	}
+
+	now := time.Now()
+	hatchAt := p.HatchTime().Add(-d)
+	if hatchAt.Before(now) {
+		hatchAt = now
+	}
+	p.HatchAt = &hatchAt
+
+	p.addEvent(PetIncubationShortenedEvent{
+		PetID:     p.ID,
+		UserID:    p.UserID,
+		Minutes:   int(d.Minutes()),
+		HatchAt:   hatchAt,
+		Timestamp: now,
+	})
+	return nil
+}
+
+// Hatch 孵化，进入幼年期
+// 蛋期间状态不衰减，孵化时重置状态锚点，从此刻开始计算衰减
+func (p *Pet) Hatch(now time.Time) error {
+	if p.Stage != StageEgg {
+		return ErrPetNotEgg
+	}
+	if !p.CanHatch(now) {
+		return ErrEggNotReady
+	}
+
+	p.Stage = StageChild
+	p.HatchAt = &now
+	p.StatusUpdatedAt = now
+
+	p.addEvent(PetHatchedEvent{
+		PetID:     p.ID,
+		UserID:    p.UserID,
+		SpeciesID: int(p.SpeciesID),
+		Timestamp: now,
+	})
+	return nil
+}
@@ -3,6 +3,7 @@ package interpreter
 
 import (
 	"fmt"
+	"sort"
 	"strings"
 
 	"pets-server/internal/domain/pet"
@@ -68,17 +69,41 @@ func buildSpecies(entry config.SpeciesEntry, factory *InterpreterFactory) (*pet.
 	// 解析繁衍规则
 	breedRules := parseBreedRules(entry.BreedRules)
 
+	// 解析成长曲线
+	growth := parseGrowth(entry.Growth)
+	if !growth.IsValid() {
+		return nil, fmt.Errorf("invalid growth levels: teen %d, adult %d, elderly %d",
+			growth.TeenLevel, growth.AdultLevel, growth.ElderlyLevel)
+	}
+
+	// 解析进化形态
+	forms, err := parseForms(entry.EvolutionForms, interpreter)
+	if err != nil {
+		return nil, err
+	}
+
+	// 解析孟德尔遗传模型
+	genetics, err := parseGenetics(entry.Genetics)
+	if err != nil {
+		return nil, err
+	}
+
 	return &pet.Species{
-		ID:           pet.SpeciesID(entry.ID),
-		Name:         entry.Name,
-		Category:     category,
-		BaseParts:    baseParts,
-		SpecialParts: specialParts,
-		Rarity:       entry.Rarity,
-		IsHidden:     entry.IsHidden,
-		GenderRule:   genderRule,
-		BreedRules:   breedRules,
-		Interpreter:  interpreter,
+		ID:              pet.SpeciesID(entry.ID),
+		Name:            entry.Name,
+		Category:        category,
+		BaseParts:       baseParts,
+		SpecialParts:    specialParts,
+		Rarity:          entry.Rarity,
+		IsHidden:        entry.IsHidden,
+		GenderRule:      genderRule,
+		BreedRules:      breedRules,
+		Interpreter:     interpreter,
+		IncubationHours: entry.IncubationHours,
+		LifespanDays:    entry.LifespanDays,
+		Growth:          growth,
+		Forms:           forms,
+		Genetics:        genetics,
 	}, nil
 }
 
@@ -193,20 +218,115 @@ func parseBreedRules(cfg config.BreedRulesCfg) pet.BreedingRules {
 	return rules
 }
 
-// parseStage 解析成长阶段
-func parseStage(s string) pet.Stage {
-	switch strings.ToLower(s) {
-	case "egg":
-		return pet.StageEgg
-	case "child":
-		return pet.StageChild
-	case "teen":
-		return pet.StageTeen
-	case "adult":
-		return pet.StageAdult
-	case "elderly":
-		return pet.StageElderly
-	default:
-		return pet.StageAdult
+// parseGrowth 解析成长曲线，未配置的字段使用默认值
+func parseGrowth(cfg config.GrowthCfg) pet.GrowthCurve {
+	return pet.GrowthCurve{
+		BaseExp:      cfg.BaseExp,
+		ExpExponent:  cfg.ExpExponent,
+		TeenLevel:    cfg.TeenLevel,
+		AdultLevel:   cfg.AdultLevel,
+		ElderlyLevel: cfg.ElderlyLevel,
+	}.Normalize()
+}
+
+// parseForms 解析进化形态，形态外观的样式由物种解释器解析
+func parseForms(cfgs []config.EvolutionFormCfg, interpreter pet.GeneInterpreter) ([]pet.EvolutionForm, error) {
+	forms := make([]pet.EvolutionForm, 0, len(cfgs))
+	seen := make(map[string]bool, len(cfgs))
+	for _, cfg := range cfgs {
+		if cfg.ID == "" {
+			return nil, fmt.Errorf("evolution form without id")
+		}
+		if seen[cfg.ID] {
+			return nil, fmt.Errorf("duplicate evolution form: %s", cfg.ID)
+		}
+		seen[cfg.ID] = true
+
+		features, err := parseFormFeatures(cfg.Features, interpreter)
+		if err != nil {
+			return nil, fmt.Errorf("evolution form %s: %w", cfg.ID, err)
+		}
+
+		forms = append(forms, pet.EvolutionForm{
+			ID:   cfg.ID,
+			Name: cfg.Name,
+			Condition: pet.FormCondition{
+				MinTendency:    cfg.MinTendency,
+				MaxTendency:    cfg.MaxTendency,
+				MinHappiness:   cfg.MinHappiness,
+				MaxHappiness:   cfg.MaxHappiness,
+				MinCleanliness: cfg.MinCleanliness,
+				MaxCleanliness: cfg.MaxCleanliness,
+				MinFeeds:       cfg.MinFeeds,
+				MinPlays:       cfg.MinPlays,
+				MinCleans:      cfg.MinCleans,
+			},
+			Features: features,
+		})
 	}
+	return forms, nil
+}
+
+// parseGenetics 解析孟德尔遗传的性状组，未配置时不启用
+func parseGenetics(cfgs []config.AlleleTraitCfg) (pet.Genetics, error) {
+	traits := make([]pet.AlleleTrait, 0, len(cfgs))
+	for _, cfg := range cfgs {
+		trait, err := pet.NewAlleleTrait(cfg.Trait, cfg.Slot, cfg.Recessive)
+		if err != nil {
+			return pet.Genetics{}, fmt.Errorf("genetics trait %s: %w", cfg.Trait, err)
+		}
+		traits = append(traits, trait)
+	}
+	genetics, err := pet.NewGenetics(traits)
+	if err != nil {
+		return pet.Genetics{}, fmt.Errorf("genetics: %w", err)
+	}
+	return genetics, nil
+}
+
+// parseFormFeatures 按解释器的样式表解析形态外观
+func parseFormFeatures(cfg map[string]string, interpreter pet.GeneInterpreter) ([]pet.PartAppearance, error) {
+	if len(cfg) == 0 {
+		return nil, nil
+	}
+	if interpreter == nil {
+		return nil, fmt.Errorf("features require an interpreter")
+	}
+
+	names := interpreter.GetFeatureNames()
+	features := make([]pet.PartAppearance, 0, len(cfg))
+	for part, style := range cfg {
+		partType := parsePartType(part)
+		value := indexOf(names[partType], style)
+		if value < 0 {
+			return nil, fmt.Errorf("unknown %s style: %s", part, style)
+		}
+		features = append(features, pet.PartAppearance{
+			PartType: partType,
+			Style:    style,
+			Value:    value,
+		})
+	}
+
+	// 配置是无序的，按部位类型排序保证外观顺序稳定
+	sort.Slice(features, func(i, j int) bool {
+		return features[i].PartType < features[j].PartType
+	})
+	return features, nil
+}
+
+// indexOf 样式在样式表中的位置，不存在时返回 -1
+func indexOf(styles []string, style string) int {
+	for i, s := range styles {
+		if s == style {
+			return i
+		}
+	}
+	return -1
+}
+
+// parseStage 解析成长阶段，未知阶段按成熟期处理
+func parseStage(s string) pet.Stage {
+	stage, _ := pet.ParseStage(s)
+	return stage
 }
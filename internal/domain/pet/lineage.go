@@ -0,0 +1,220 @@
+// Package pet 宠物领域
+// Lineage 血统图谱 - 由父母ID串起的祖先与后代关系，分裂繁殖的后代只有一位亲本
+package pet
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// 血统查询参数
+const (
+	DefaultLineageDepth = 3   // 默认向上追溯的祖先代数
+	MaxLineageDepth     = 10  // 向上追溯的最大代数
+	MaxLineageNodes     = 500 // 图谱最多包含的宠物数，防止大家族查询失控
+)
+
+// ParentIDs 宠物的亲本ID（去掉空值和 0，分裂繁殖时只有一位）
+func ParentIDs(parent1ID, parent2ID *int) []int {
+	var ids []int
+	for _, id := range []*int{parent1ID, parent2ID} {
+		if id == nil || *id <= 0 {
+			continue
+		}
+		if len(ids) > 0 && ids[0] == *id {
+			continue
+		}
+		ids = append(ids, *id)
+	}
+	return ids
+}
+
+// LineageNode 血统图谱中的一只宠物（在世的或已离开的）
+type LineageNode struct {
+	PetID      int
+	Name       string
+	SpeciesID  SpeciesID
+	Gender     Gender
+	OwnerID    int
+	Generation int
+	Depth      int   // 相对查询宠物的代差：祖先为负，后代为正
+	ParentIDs  []int // 亲本ID，分裂繁殖时只有一位
+	Departed   bool  // 是否已离开（寿终或放生），数据来自纪念记录
+	Cause      MemorialCause
+}
+
+// LineageNodeFromPet 由在世的宠物生成节点
+func LineageNodeFromPet(p *Pet, depth int) LineageNode {
+	return LineageNode{
+		PetID:      p.ID,
+		Name:       p.Name,
+		SpeciesID:  p.SpeciesID,
+		Gender:     p.Gender,
+		OwnerID:    p.UserID,
+		Generation: p.Generation,
+		Depth:      depth,
+		ParentIDs:  ParentIDs(p.Parent1ID, p.Parent2ID),
+	}
+}
+
+// LineageNodeFromMemorial 由纪念记录生成节点
+func LineageNodeFromMemorial(m *Memorial, depth int) LineageNode {
+	return LineageNode{
+		PetID:      m.PetID,
+		Name:       m.Name,
+		SpeciesID:  m.SpeciesID,
+		Gender:     m.Gender,
+		OwnerID:    m.UserID,
+		Generation: m.Generation,
+		Depth:      depth,
+		ParentIDs:  ParentIDs(m.Parent1ID, m.Parent2ID),
+		Departed:   true,
+		Cause:      m.Cause,
+	}
+}
+
+// LineageEdge 亲子关系（亲本 → 后代）
+type LineageEdge struct {
+	ParentID int
+	ChildID  int
+}
+
+// LineageGraph 血统图谱
+// 近亲繁殖时同一祖先可能经多条路径到达，节点和边都只记录一次
+type LineageGraph struct {
+	RootID    int
+	nodes     map[int]LineageNode
+	edges     map[LineageEdge]bool
+	truncated bool
+}
+
+// NewLineageGraph 创建以 root 为中心的血统图谱
+func NewLineageGraph(root LineageNode) *LineageGraph {
+	g := &LineageGraph{
+		RootID: root.PetID,
+		nodes:  make(map[int]LineageNode),
+		edges:  make(map[LineageEdge]bool),
+	}
+	g.nodes[root.PetID] = root
+	return g
+}
+
+// Has 图谱中是否已有该宠物
+func (g *LineageGraph) Has(petID int) bool {
+	_, ok := g.nodes[petID]
+	return ok
+}
+
+// Node 获取图谱中的宠物
+func (g *LineageGraph) Node(petID int) (LineageNode, bool) {
+	n, ok := g.nodes[petID]
+	return n, ok
+}
+
+// AddNode 加入宠物，已存在或达到数量上限时返回 false
+func (g *LineageGraph) AddNode(n LineageNode) bool {
+	if g.Has(n.PetID) {
+		return false
+	}
+	if len(g.nodes) >= MaxLineageNodes {
+		g.truncated = true
+		return false
+	}
+	g.nodes[n.PetID] = n
+	return true
+}
+
+// AddEdge 记录亲子关系，两端都须已在图谱中
+func (g *LineageGraph) AddEdge(parentID, childID int) {
+	if !g.Has(parentID) || !g.Has(childID) {
+		return
+	}
+	g.edges[LineageEdge{ParentID: parentID, ChildID: childID}] = true
+}
+
+// Truncated 是否因数量上限省略了部分宠物
+func (g *LineageGraph) Truncated() bool {
+	return g.truncated
+}
+
+// Nodes 按代差、ID排序的全部宠物
+func (g *LineageGraph) Nodes() []LineageNode {
+	nodes := make([]LineageNode, 0, len(g.nodes))
+	for _, n := range g.nodes {
+		nodes = append(nodes, n)
+	}
+	sort.Slice(nodes, func(i, j int) bool {
+		if nodes[i].Depth != nodes[j].Depth {
+			return nodes[i].Depth < nodes[j].Depth
+		}
+		return nodes[i].PetID < nodes[j].PetID
+	})
+	return nodes
+}
+
+// Edges 按亲本、后代ID排序的全部亲子关系
+func (g *LineageGraph) Edges() []LineageEdge {
+	edges := make([]LineageEdge, 0, len(g.edges))
+	for e := range g.edges {
+		edges = append(edges, e)
+	}
+	sort.Slice(edges, func(i, j int) bool {
+		if edges[i].ParentID != edges[j].ParentID {
+			return edges[i].ParentID < edges[j].ParentID
+		}
+		return edges[i].ChildID < edges[j].ChildID
+	})
+	return edges
+}
+
+// DOT 输出 Graphviz DOT 格式的图谱，供设计同学用 dot 渲染
+// 同一代的宠物排在同一行；已离开的宠物用虚线框，查询的宠物加粗
+func (g *LineageGraph) DOT() string {
+	var b strings.Builder
+	b.WriteString("digraph lineage {\n")
+	b.WriteString("  rankdir=TB;\n")
+	b.WriteString("  node [shape=box, style=rounded, fontname=\"sans-serif\"];\n")
+
+	nodes := g.Nodes()
+	for _, n := range nodes {
+		label := fmt.Sprintf("%s #%d\\n物种 %d · %s\\n主人 %d · 第%d代",
+			dotEscape(n.Name), n.PetID, n.SpeciesID, n.Gender.Name(), n.OwnerID, n.Generation)
+		var attrs []string
+		attrs = append(attrs, fmt.Sprintf("label=\"%s\"", label))
+		if n.Departed {
+			attrs = append(attrs, "style=\"rounded,dashed\"", "color=gray50")
+		}
+		if n.PetID == g.RootID {
+			attrs = append(attrs, "penwidth=2")
+		}
+		fmt.Fprintf(&b, "  p%d [%s];\n", n.PetID, strings.Join(attrs, ", "))
+	}
+
+	// 同代同行
+	for i := 0; i < len(nodes); {
+		j := i
+		for j < len(nodes) && nodes[j].Depth == nodes[i].Depth {
+			j++
+		}
+		if j-i > 1 {
+			ids := make([]string, 0, j-i)
+			for _, n := range nodes[i:j] {
+				ids = append(ids, fmt.Sprintf("p%d", n.PetID))
+			}
+			fmt.Fprintf(&b, "  { rank=same; %s; }\n", strings.Join(ids, "; "))
+		}
+		i = j
+	}
+
+	for _, e := range g.Edges() {
+		fmt.Fprintf(&b, "  p%d -> p%d;\n", e.ParentID, e.ChildID)
+	}
+	b.WriteString("}\n")
+	return b.String()
+}
+
+// dotEscape 转义 DOT 字符串中的引号和反斜杠
+func dotEscape(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(s)
+}
@@ -0,0 +1,139 @@
+// Package pet 宠物领域
+// Memorial 寿命与纪念 - 老年期宠物寿终后转为纪念记录
+package pet
+
+import "time"
+
+// DefaultLifespanDays 物种未配置时的默认老年期寿命（天）
+const DefaultLifespanDays = 30
+
+// MemorialCause 宠物离开的原因
+type MemorialCause string
+
+const (
+	MemorialCausePassedOn MemorialCause = "passed_on" // 寿终
+	MemorialCauseReleased MemorialCause = "released"  // 被主人放生
+)
+
+// Name 离开原因名称
+func (c MemorialCause) Name() string {
+	if c == MemorialCauseReleased {
+		return "放生"
+	}
+	return "寿终"
+}
+
+// Memorial 纪念记录（实体）
+// 宠物离世或被放生后保留其生平数据、血统与成就，供纪念馆展示和血统查询
+type Memorial struct {
+	ID     int
+	PetID  int // 原宠物ID（宠物记录已删除）
+	UserID int // 离开时的主人
+	Name   string
+	Cause  MemorialCause
+
+	SpeciesID  SpeciesID
+	Gender     Gender
+	Gene       Gene
+	Stage      Stage
+	Level      int
+	SkillType  SkillType
+	SkillLevel int
+
+	// 血统
+	Parent1ID  *int
+	Parent2ID  *int
+	Generation int
+
+	Achievements []string // 生平成就
+
+	BornAt   time.Time
+	HatchAt  *time.Time
+	PassedAt time.Time
+}
+
+// LifeDays 一生的天数（从出生起算）
+func (m *Memorial) LifeDays() int {
+	return int(m.PassedAt.Sub(m.BornAt).Hours() / 24)
+}
+
+// SetLifespan 按物种寿命和基因寿命修正设置老年期寿命
+// 由领域服务在创建宠物时调用
+func (p *Pet) SetLifespan(base time.Duration) {
+	p.Lifespan = time.Duration(float64(base) * p.Gene.LifespanModifier())
+}
+
+// startElderly 进入老年期，开始计算寿命
+func (p *Pet) startElderly(now time.Time) {
+	lifespan := p.Lifespan
+	if lifespan <= 0 {
+		lifespan = time.Duration(float64(DefaultLifespanDays*24*time.Hour) * p.Gene.LifespanModifier())
+	}
+	passesAt := now.Add(lifespan)
+	p.PassesAt = &passesAt
+}
+
+// IsLifespanOver 寿命是否已尽
+func (p *Pet) IsLifespanOver(now time.Time) bool {
+	return p.Stage == StageElderly && p.PassesAt != nil && !now.Before(*p.PassesAt)
+}
+
+// PassOn 寿终，生成纪念记录
+func (p *Pet) PassOn(now time.Time) (*Memorial, error) {
+	if !p.IsLifespanOver(now) {
+		return nil, ErrLifespanNotOver
+	}
+
+	memorial := p.toMemorial(MemorialCausePassedOn, now)
+	memorial.Achievements = append([]string{"安享晚年"}, p.lifeAchievements()...)
+
+	p.addEvent(PetPassedOnEvent{
+		PetID:     p.ID,
+		UserID:    p.UserID,
+		Name:      p.Name,
+		LifeDays:  memorial.LifeDays(),
+		Timestamp: now,
+	})
+	return memorial, nil
+}
+
+// toMemorial 生成纪念记录，保留生平数据与血统
+func (p *Pet) toMemorial(cause MemorialCause, now time.Time) *Memorial {
+	return &Memorial{
+		PetID:      p.ID,
+		UserID:     p.UserID,
+		Name:       p.Name,
+		Cause:      cause,
+		SpeciesID:  p.SpeciesID,
+		Gender:     p.Gender,
+		Gene:       p.Gene,
+		Stage:      p.Stage,
+		Level:      p.Level,
+		SkillType:  p.Skill.Type,
+		SkillLevel: p.Skill.Level,
+		Parent1ID:  p.Parent1ID,
+		Parent2ID:  p.Parent2ID,
+		Generation: p.Generation,
+		BornAt:     p.BornAt,
+		HatchAt:    p.HatchAt,
+		PassedAt:   now,
+	}
+}
+
+// lifeAchievements 根据宠物一生的数据生成成就（不含离开方式）
+func (p *Pet) lifeAchievements() []string {
+	var achievements []string
+	if p.Level >= 80 {
+		achievements = append(achievements, "传奇伙伴")
+	}
+	if p.Skill.Level >= 5 {
+		achievements = append(achievements, "技能大师")
+	}
+	if p.Generation >= 3 {
+		achievements = append(achievements, "名门之后")
+	}
+	if p.Gene.LifespanModifier() >= 1.1 {
+		achievements = append(achievements, "长寿之星")
+	}
+	return achievements
+}
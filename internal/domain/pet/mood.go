@@ -0,0 +1,171 @@
+// Package pet 宠物领域
+// Mood 情绪状态机 - 由实时状态、昼夜和脾气共同驱动，影响照顾的效果
+package pet
+
+import (
+	"time"
+
+	"pets-server/internal/domain/shared"
+)
+
+// Mood 情绪
+type Mood string
+
+const (
+	MoodContent Mood = "content" // 满足（默认）
+	MoodHappy   Mood = "happy"   // 开心
+	MoodExcited Mood = "excited" // 兴奋
+	MoodGrumpy  Mood = "grumpy"  // 烦躁
+	MoodSulking Mood = "sulking" // 闹别扭，拒绝玩耍
+	MoodSleepy  Mood = "sleepy"  // 犯困
+)
+
+// 情绪参数
+const (
+	MoodSulkThreshold     = 15 // 快乐度低于该值时闹别扭
+	MoodHappyThreshold    = 60 // 快乐度达到该值时开心
+	MoodExcitedThreshold  = 85 // 快乐度达到该值且精力充足时兴奋
+	MoodExcitedEnergy     = 60 // 兴奋所需的精力
+	MoodSleepyEnergy      = 20 // 精力低于该值时犯困
+	MoodNightSleepyEnergy = 50 // 夜间精力低于该值时犯困
+	NightStartHour        = 22 // 夜间开始（游戏时区）
+	NightEndHour          = 6  // 夜间结束
+)
+
+// Name 情绪名称
+func (m Mood) Name() string {
+	switch m {
+	case MoodContent:
+		return "满足"
+	case MoodHappy:
+		return "开心"
+	case MoodExcited:
+		return "兴奋"
+	case MoodGrumpy:
+		return "烦躁"
+	case MoodSulking:
+		return "闹别扭"
+	case MoodSleepy:
+		return "犯困"
+	default:
+		return "未知"
+	}
+}
+
+// IsUpset 是否在闹情绪（需要安抚才能恢复）
+func (m Mood) IsUpset() bool {
+	return m == MoodGrumpy || m == MoodSulking
+}
+
+// CareEffect 情绪对喂食、玩耍带来的快乐度的影响倍数
+func (m Mood) CareEffect() float64 {
+	switch m {
+	case MoodExcited:
+		return 1.2
+	case MoodHappy:
+		return 1.1
+	case MoodGrumpy, MoodSleepy:
+		return 0.8
+	case MoodSulking:
+		return 0.5
+	default:
+		return 1.0
+	}
+}
+
+// isNight 是否夜间（按游戏时区，与服务器所在时区无关）
+func isNight(now time.Time) bool {
+	hour := shared.GameTime(now).Hour()
+	return hour >= NightStartHour || hour < NightEndHour
+}
+
+// moodFor 由状态、昼夜和脾气推导出的情绪
+// 脾气越差越早烦躁；两项以上需求未满足或快乐度过低时闹别扭。
+// 已安抚够次数（soothed）时快乐度过低不再闹别扭，降为烦躁以便玩耍把快乐度补回来
+func (p *Pet) moodFor(hunger, happiness, cleanliness, energy int, soothed bool, now time.Time) Mood {
+	if p.Stage == StageEgg {
+		return MoodContent
+	}
+	night := isNight(now)
+	if p.IsSick() || energy < MoodSleepyEnergy || (night && energy < MoodNightSleepyEnergy) {
+		return MoodSleepy
+	}
+
+	threshold := p.Personality.GrumpyThreshold()
+	unmet := 0
+	for _, v := range []int{hunger, happiness, cleanliness} {
+		if v < threshold {
+			unmet++
+		}
+	}
+	if unmet >= 2 || (happiness < MoodSulkThreshold && !soothed) {
+		return MoodSulking
+	}
+	if unmet == 1 {
+		return MoodGrumpy
+	}
+
+	if happiness >= MoodExcitedThreshold && energy >= MoodExcitedEnergy && !night {
+		return MoodExcited
+	}
+	if happiness >= MoodHappyThreshold {
+		return MoodHappy
+	}
+	return MoodContent
+}
+
+// UpdateMood 按当前状态推进情绪状态机，情绪变化时记录事件
+// 闹情绪后即使需求已满足，也要安抚够次数才会恢复（脾气越差次数越多）；
+// 因快乐度过低闹别扭时无法玩耍，安抚够次数后即可退出闹别扭
+// 返回情绪是否变化
+func (p *Pet) UpdateMood(now time.Time) bool {
+	soothed := p.Mood.IsUpset() && p.MoodCalm == 0
+	target := p.moodFor(p.Hunger, p.Happiness, p.Cleanliness, p.Energy, soothed, now)
+	if p.Mood.IsUpset() && !target.IsUpset() && p.MoodCalm > 0 {
+		return false
+	}
+	return p.setMood(target, now)
+}
+
+// MoodAt 获取指定时间点的情绪和剩余安抚次数（不修改实体）
+func (p *Pet) MoodAt(now time.Time) (Mood, int) {
+	snapshot := *p
+	snapshot.events = nil
+	snapshot.Hunger, snapshot.Happiness, snapshot.Cleanliness, snapshot.Energy = p.StatusAt(now)
+	snapshot.UpdateMood(now)
+	return snapshot.Mood, snapshot.MoodCalm
+}
+
+// setMood 切换情绪
+// 开始闹情绪或闹得更凶（进入闹别扭）时重置所需的安抚次数
+func (p *Pet) setMood(mood Mood, now time.Time) bool {
+	if mood == p.Mood {
+		return false
+	}
+
+	from := p.Mood
+	switch {
+	case !mood.IsUpset():
+		p.MoodCalm = 0
+	case !from.IsUpset() || mood == MoodSulking:
+		p.MoodCalm = p.Personality.CalmActions()
+	}
+	p.Mood = mood
+
+	p.addEvent(PetMoodChangedEvent{
+		PetID:     p.ID,
+		UserID:    p.UserID,
+		From:      string(from),
+		To:        string(mood),
+		Timestamp: now,
+	})
+	return true
+}
+
+// soothe 被照顾一次：闹情绪时减少剩余安抚次数，再按新状态更新情绪
+func (p *Pet) soothe(now time.Time) {
+	if p.Mood.IsUpset() && p.MoodCalm > 0 {
+		p.MoodCalm--
+	}
+	p.UpdateMood(now)
+}
@@ -104,18 +104,52 @@ func (p Personality) LoyaltyBonus() float64 {
 	return 1.0
 }
 
+// 脾气反应类型
+const (
+	TemperFurious   = "暴躁"
+	TemperIrritable = "易怒"
+	TemperMild      = "温和"
+	TemperDocile    = "温顺"
+	TemperCalm      = "冷静"
+)
+
 // TemperReaction 脾气反应类型
 func (p Personality) TemperReaction() string {
 	if p.Temper > 80 {
-		return "暴躁"
+		return TemperFurious
 	} else if p.Temper > 60 {
-		return "易怒"
+		return TemperIrritable
 	} else if p.Temper > 40 {
-		return "温和"
+		return TemperMild
 	} else if p.Temper > 20 {
-		return "温顺"
+		return TemperDocile
+	}
+	return TemperCalm
+}
+
+// GrumpyThreshold 饱食度、快乐度、清洁度低于该值时开始烦躁
+// 脾气越差越沉不住气
+func (p Personality) GrumpyThreshold() int {
+	switch p.TemperReaction() {
+	case TemperFurious:
+		return 40
+	case TemperIrritable:
+		return 35
+	case TemperCalm:
+		return 20
+	default:
+		return 30
+	}
+}
+
+// CalmActions 闹情绪后需要安抚（照顾）的次数
+func (p Personality) CalmActions() int {
+	switch p.TemperReaction() {
+	case TemperFurious, TemperIrritable:
+		return 2
+	default:
+		return 1
 	}
-	return "冷静"
 }
 
 // Describe 性格描述
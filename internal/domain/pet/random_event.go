@@ -0,0 +1,264 @@
+// Package pet 宠物领域
+// RandomEvent 随机事件 - 照顾宠物时按性格好奇心和探险家技能概率触发
+package pet
+
+import (
+	"crypto/rand"
+	"errors"
+	"math/big"
+	"time"
+)
+
+// 随机事件参数
+const (
+	DefaultRandomEventChance = 10 // 未配置时每次照顾触发随机事件的基础概率（百分比）
+	MaxRandomEventChance     = 50 // 触发概率上限（百分比）
+)
+
+// RandomEventEffect 随机事件效果类型
+type RandomEventEffect string
+
+const (
+	RandomEffectCoins  RandomEventEffect = "coins"  // 主人获得金币（捡到金币）
+	RandomEffectItem   RandomEventEffect = "item"   // 主人获得道具（挖到道具、叼回礼物）
+	RandomEffectStatus RandomEventEffect = "status" // 宠物状态变化（打嗝等），数量可为负
+)
+
+// RandomEventDef 随机事件定义（值对象，来自配置）
+type RandomEventDef struct {
+	ID        string
+	Name      string
+	Message   string            // 推送给主人的描述
+	Weight    int               // 权重，满足条件的事件按权重抽取
+	Stages    []Stage           // 可触发的阶段，空表示孵化后的所有阶段
+	Species   []SpeciesID       // 可触发的物种，空表示不限
+	Cooldown  time.Duration     // 同一宠物再次触发该事件的最短间隔
+	Effect    RandomEventEffect // 效果类型
+	ItemID    int               // 道具效果的道具ID
+	Status    StatusType        // 状态效果的目标状态
+	MinAmount int               // 金币数/道具数/状态变化量的下限
+	MaxAmount int               // 上限，不大于下限时固定为下限
+}
+
+// Allows 宠物是否满足事件的阶段和物种条件
+func (d RandomEventDef) Allows(p *Pet) bool {
+	if p.Stage == StageEgg {
+		return false
+	}
+	if len(d.Stages) > 0 && !containsStage(d.Stages, p.Stage) {
+		return false
+	}
+	if len(d.Species) > 0 && !containsSpecies(d.Species, p.SpeciesID) {
+		return false
+	}
+	return true
+}
+
+// IsCoolingDown 上次触发后是否仍在冷却中
+func (d RandomEventDef) IsCoolingDown(lastAt time.Time, now time.Time) bool {
+	return d.Cooldown > 0 && !lastAt.IsZero() && now.Sub(lastAt) < d.Cooldown
+}
+
+// Validate 校验事件定义的效果与数量
+func (d RandomEventDef) Validate() error {
+	switch d.Effect {
+	case RandomEffectCoins:
+		if d.MinAmount <= 0 {
+			return ErrRandomEventAmount
+		}
+	case RandomEffectItem:
+		if d.ItemID <= 0 {
+			return ErrRandomEventItem
+		}
+		if d.MinAmount <= 0 {
+			return ErrRandomEventAmount
+		}
+	case RandomEffectStatus:
+		switch d.Status {
+		case StatusHunger, StatusHappiness, StatusCleanliness, StatusEnergy:
+		default:
+			return ErrUnknownStatus
+		}
+	default:
+		return ErrRandomEventEffect
+	}
+	return nil
+}
+
+// rollAmount 在数量范围内取值
+func (d RandomEventDef) rollAmount() int {
+	if d.MaxAmount <= d.MinAmount {
+		return d.MinAmount
+	}
+	return d.MinAmount + randomN(d.MaxAmount-d.MinAmount+1)
+}
+
+// RandomEventTable 随机事件表
+type RandomEventTable struct {
+	baseChance int // 每次照顾触发随机事件的基础概率（百分比）
+	events     []RandomEventDef
+}
+
+// NewRandomEventTable 创建随机事件表
+func NewRandomEventTable(baseChance int, events []RandomEventDef) *RandomEventTable {
+	if baseChance <= 0 {
+		baseChance = DefaultRandomEventChance
+	}
+	return &RandomEventTable{baseChance: baseChance, events: events}
+}
+
+// Events 所有随机事件定义
+func (t *RandomEventTable) Events() []RandomEventDef {
+	return t.events
+}
+
+// ItemIDs 道具效果奖励的道具ID（去重），启动时用于校验道具是否存在
+func (t *RandomEventTable) ItemIDs() []int {
+	seen := make(map[int]bool)
+	var ids []int
+	for _, e := range t.events {
+		if e.Effect == RandomEffectItem && !seen[e.ItemID] {
+			seen[e.ItemID] = true
+			ids = append(ids, e.ItemID)
+		}
+	}
+	return ids
+}
+
+// Chance 宠物每次被照顾时触发随机事件的概率（百分比）
+// 好奇心越高越容易触发，探险家技能进一步提高概率
+func (t *RandomEventTable) Chance(p *Pet) int {
+	chance := float64(t.baseChance) * p.Personality.RandomEventChance()
+	if skill, ok := p.activeSkill(SkillTypeCurious); ok {
+		chance *= skill.EffectMultiplier()
+	}
+	return minInt(int(chance), MaxRandomEventChance)
+}
+
+// Roll 掷骰决定是否触发随机事件
+// lastTriggered 为该宠物各事件上次触发的时间，用于判断冷却
+func (t *RandomEventTable) Roll(p *Pet, lastTriggered map[string]time.Time, now time.Time) (*RandomEvent, bool) {
+	if len(t.events) == 0 || randomN(100) >= t.Chance(p) {
+		return nil, false
+	}
+
+	candidates := make([]RandomEventDef, 0, len(t.events))
+	totalWeight := 0
+	for _, def := range t.events {
+		if def.Weight <= 0 || !def.Allows(p) || def.IsCoolingDown(lastTriggered[def.ID], now) {
+			continue
+		}
+		candidates = append(candidates, def)
+		totalWeight += def.Weight
+	}
+	if totalWeight == 0 {
+		return nil, false
+	}
+
+	pick := randomN(totalWeight)
+	for _, def := range candidates {
+		if pick < def.Weight {
+			return &RandomEvent{
+				PetID:      p.ID,
+				UserID:     p.UserID,
+				EventID:    def.ID,
+				Name:       def.Name,
+				Message:    def.Message,
+				Effect:     def.Effect,
+				ItemID:     def.ItemID,
+				Status:     def.Status,
+				Amount:     def.rollAmount(),
+				OccurredAt: now,
+			}, true
+		}
+		pick -= def.Weight
+	}
+	return nil, false
+}
+
+// RandomEvent 随机事件记录（实体）
+type RandomEvent struct {
+	ID      int
+	PetID   int
+	UserID  int // 触发时的主人（金币和道具发给该用户）
+	EventID string
+	Name    string
+	Message string
+
+	Effect RandomEventEffect
+	ItemID int
+	Status StatusType
+	Amount int
+
+	OccurredAt time.Time
+}
+
+// ExperienceRandomEvent 宠物经历随机事件
+// 状态效果直接作用于宠物，金币和道具由应用层发给主人；经历随机事件锻炼探险家技能
+func (p *Pet) ExperienceRandomEvent(e *RandomEvent) {
+	if e.Effect == RandomEffectStatus {
+		p.adjustStatus(e.Status, e.Amount)
+	}
+	p.TrainSkill(TrainingExplore)
+
+	p.addEvent(PetRandomEventEvent{
+		PetID:     p.ID,
+		UserID:    p.UserID,
+		EventID:   e.EventID,
+		Effect:    string(e.Effect),
+		Amount:    e.Amount,
+		Timestamp: e.OccurredAt,
+	})
+}
+
+// adjustStatus 调整某项状态，限制在 0-100
+func (p *Pet) adjustStatus(status StatusType, delta int) {
+	clamp := func(v int) int { return minInt(maxInt(v, 0), 100) }
+	switch status {
+	case StatusHunger:
+		p.Hunger = clamp(p.Hunger + delta)
+	case StatusHappiness:
+		p.Happiness = clamp(p.Happiness + delta)
+	case StatusCleanliness:
+		p.Cleanliness = clamp(p.Cleanliness + delta)
+	case StatusEnergy:
+		p.Energy = clamp(p.Energy + delta)
+	}
+}
+
+func containsStage(stages []Stage, stage Stage) bool {
+	for _, s := range stages {
+		if s == stage {
+			return true
+		}
+	}
+	return false
+}
+
+func containsSpecies(species []SpeciesID, id SpeciesID) bool {
+	for _, s := range species {
+		if s == id {
+			return true
+		}
+	}
+	return false
+}
+
+// randomN 生成 0 到 n-1 的随机整数（n 可超过 256）
+func randomN(n int) int {
+	if n <= 0 {
+		return 0
+	}
+	v, err := rand.Int(rand.Reader, big.NewInt(int64(n)))
+	if err != nil {
+		return 0
+	}
+	return int(v.Int64())
+}
+
+// 随机事件相关错误
+var (
+	ErrRandomEventEffect = errors.New("未知的随机事件效果")
+	ErrRandomEventAmount = errors.New("金币和道具效果的数量须为正数")
+	ErrRandomEventItem   = errors.New("道具效果须配置道具ID")
+)
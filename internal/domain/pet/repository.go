@@ -2,7 +2,10 @@
 // Repository 仓储接口
 package pet
 
-import "context"
+import (
+	"context"
+	"time"
+)
 
 // Repository 宠物仓储接口
 // 定义在领域层，由基础设施层实现
@@ -13,6 +16,15 @@ type Repository interface {
 	// FindByUserID 根据用户ID查找宠物
 	FindByUserID(ctx context.Context, userID int) (*Pet, error)
 
+	// FindByUserIDAll 根据用户ID查找所有宠物（按宠物栏排序）
+	FindByUserIDAll(ctx context.Context, userID int) ([]*Pet, error)
+
+	// CountByUserID 统计用户拥有的宠物数量
+	CountByUserID(ctx context.Context, userID int) (int, error)
+
+	// FindByParent 根据父母ID查找子代
+	FindByParent(ctx context.Context, parentID int) ([]*Pet, error)
+
 	// Save 保存宠物（新增或更新）
 	Save(ctx context.Context, pet *Pet) error
 
@@ -24,5 +36,97 @@ type Repository interface {
 
 	// CountAll 统计宠物总数
 	CountAll(ctx context.Context) (int, error)
+
+	// GenePool 统计全部宠物在每个基因位上的取值分布（用于基因组报告的百分位）
+	GenePool(ctx context.Context) (*GenePool, error)
+
+	// FindAfterID 按ID游标分批查找宠物（用于定时任务，ID升序）
+	FindAfterID(ctx context.Context, afterID, limit int) ([]*Pet, error)
+
+	// UpdateStatusBatch 批量写入轻量状态
+	// 只更新版本号仍等于快照基准版本的记录，返回实际更新的宠物ID
+	UpdateStatusBatch(ctx context.Context, snapshots []StatusSnapshot) ([]int, error)
+
+	// FindPassedAwayIDs 按ID游标获取一批寿命已尽的老年期宠物ID（不加锁，ID升序）
+	FindPassedAwayIDs(ctx context.Context, now time.Time, afterID, limit int) ([]int, error)
+
+	// LockPassedAway 加锁获取寿命已尽的老年期宠物
+	// 已被其他事务锁定或不再满足寿终条件时返回 ErrPetNotFound
+	LockPassedAway(ctx context.Context, petID int, now time.Time) (*Pet, error)
 }
 
+
+// BreedingContractRepository 繁殖契约仓储接口
+type BreedingContractRepository interface {
+	// FindByID 根据ID查找契约
+	FindByID(ctx context.Context, id int) (*BreedingContract, error)
+
+	// FindByIDForUpdate 根据ID查找并锁定契约（须在事务中调用）
+	FindByIDForUpdate(ctx context.Context, id int) (*BreedingContract, error)
+
+	// FindByUser 获取用户相关的契约（发起或接收）
+	FindByUser(ctx context.Context, userID int) ([]*BreedingContract, error)
+
+	// FindPendingByPet 获取宠物参与的待处理契约
+	FindPendingByPet(ctx context.Context, petID int) ([]*BreedingContract, error)
+
+	// Save 保存契约
+	Save(ctx context.Context, contract *BreedingContract) error
+}
+
+// AdoptionRepository 领养挂单仓储接口
+type AdoptionRepository interface {
+	// FindByID 根据ID查找挂单
+	FindByID(ctx context.Context, id int) (*AdoptionListing, error)
+
+	// FindByIDForUpdate 根据ID查找并锁定挂单（须在事务中调用）
+	FindByIDForUpdate(ctx context.Context, id int) (*AdoptionListing, error)
+
+	// FindOpenByPet 获取宠物当前的挂单
+	FindOpenByPet(ctx context.Context, petID int) (*AdoptionListing, error)
+
+	// FindOpenForUser 获取用户可领养的挂单（公开出售及赠送给该用户的）
+	FindOpenForUser(ctx context.Context, userID, offset, limit int) ([]*AdoptionListing, error)
+
+	// FindBySeller 获取用户发布的挂单
+	FindBySeller(ctx context.Context, sellerID int) ([]*AdoptionListing, error)
+
+	// Save 保存挂单
+	Save(ctx context.Context, listing *AdoptionListing) error
+}
+
+// OwnershipRepository 宠物易主记录仓储接口
+type OwnershipRepository interface {
+	// FindByPetID 获取宠物的易主记录（按时间先后）
+	FindByPetID(ctx context.Context, petID int) ([]OwnershipRecord, error)
+
+	// Save 保存易主记录
+	Save(ctx context.Context, record *OwnershipRecord) error
+}
+
+// MemorialRepository 纪念记录仓储接口
+type MemorialRepository interface {
+	// FindByUserID 获取用户的纪念记录（按离世时间倒序）
+	FindByUserID(ctx context.Context, userID int) ([]*Memorial, error)
+
+	// FindByPetID 根据原宠物ID查找纪念记录
+	FindByPetID(ctx context.Context, petID int) (*Memorial, error)
+
+	// FindByParent 根据父母ID查找已离开的子代
+	FindByParent(ctx context.Context, parentID int) ([]*Memorial, error)
+
+	// Save 保存纪念记录
+	Save(ctx context.Context, memorial *Memorial) error
+}
+
+// RandomEventRepository 随机事件记录仓储接口
+type RandomEventRepository interface {
+	// FindByPetID 获取宠物最近的随机事件（按时间倒序）
+	FindByPetID(ctx context.Context, petID int, limit int) ([]*RandomEvent, error)
+
+	// LastTriggeredAt 获取宠物各随机事件上次触发的时间（事件ID → 时间）
+	LastTriggeredAt(ctx context.Context, petID int) (map[string]time.Time, error)
+
+	// Save 保存随机事件记录
+	Save(ctx context.Context, event *RandomEvent) error
+}
@@ -0,0 +1,126 @@
+// Package pet 宠物领域
+// Roster 宠物栏 - 一个主人可拥有多只宠物，栏位上限可用钻石扩充
+package pet
+
+import (
+	"errors"
+	"strings"
+	"time"
+)
+
+// 默认宠物栏参数（未配置时使用）
+const (
+	DefaultRosterCapacity    = 3  // 初始栏位数
+	DefaultMaxRosterCapacity = 10 // 扩充后的最大栏位数
+	DefaultRosterSlotPrice   = 50 // 扩充一个栏位的钻石价格
+)
+
+// RosterRules 宠物栏规则（值对象，来自配置）
+type RosterRules struct {
+	BaseCapacity int // 初始栏位数
+	MaxCapacity  int // 扩充后的最大栏位数
+	SlotPrice    int // 扩充一个栏位的钻石价格
+}
+
+// DefaultRosterRules 默认宠物栏规则
+func DefaultRosterRules() RosterRules {
+	return RosterRules{
+		BaseCapacity: DefaultRosterCapacity,
+		MaxCapacity:  DefaultMaxRosterCapacity,
+		SlotPrice:    DefaultRosterSlotPrice,
+	}
+}
+
+// Normalize 未配置的字段使用默认值，最大栏位数不小于初始栏位数
+func (r RosterRules) Normalize() RosterRules {
+	if r.BaseCapacity <= 0 {
+		r.BaseCapacity = DefaultRosterCapacity
+	}
+	if r.MaxCapacity <= 0 {
+		r.MaxCapacity = DefaultMaxRosterCapacity
+	}
+	if r.MaxCapacity < r.BaseCapacity {
+		r.MaxCapacity = r.BaseCapacity
+	}
+	if r.SlotPrice <= 0 {
+		r.SlotPrice = DefaultRosterSlotPrice
+	}
+	return r
+}
+
+// Capacity 已扩充 extraSlots 个栏位时的栏位上限
+func (r RosterRules) Capacity(extraSlots int) int {
+	return minInt(r.BaseCapacity+maxInt(extraSlots, 0), r.MaxCapacity)
+}
+
+// CanExpand 是否还能继续扩充
+func (r RosterRules) CanExpand(extraSlots int) error {
+	if r.Capacity(extraSlots) >= r.MaxCapacity {
+		return ErrRosterMaxCapacity
+	}
+	return nil
+}
+
+// CheckRoom 检查宠物栏是否还能容纳 incoming 只宠物
+func (r RosterRules) CheckRoom(owned, extraSlots, incoming int) error {
+	if owned+incoming > r.Capacity(extraSlots) {
+		return ErrRosterFull
+	}
+	return nil
+}
+
+// SetRosterOrder 设置在宠物栏中的排序（从 1 开始，0 表示未排序，排在最后）
+func (p *Pet) SetRosterOrder(order int) {
+	p.RosterOrder = maxInt(order, 0)
+}
+
+// ReorderRoster 按给定的宠物ID顺序重排宠物栏，petIDs 须恰好包含主人的全部宠物
+func ReorderRoster(pets []*Pet, petIDs []int) error {
+	if len(petIDs) != len(pets) {
+		return ErrInvalidRosterOrder
+	}
+	byID := make(map[int]*Pet, len(pets))
+	for _, p := range pets {
+		byID[p.ID] = p
+	}
+	seen := make(map[int]bool, len(petIDs))
+	for _, id := range petIDs {
+		if _, ok := byID[id]; !ok || seen[id] {
+			return ErrInvalidRosterOrder
+		}
+		seen[id] = true
+	}
+
+	for i, id := range petIDs {
+		byID[id].SetRosterOrder(i + 1)
+	}
+	return nil
+}
+
+// Release 放生宠物，生成纪念记录
+// 需要输入宠物名字确认；生平与血统保存在纪念记录中，后代仍可追溯到它
+func (p *Pet) Release(confirmName string, now time.Time) (*Memorial, error) {
+	if strings.TrimSpace(confirmName) != p.Name {
+		return nil, ErrReleaseNotConfirmed
+	}
+
+	memorial := p.toMemorial(MemorialCauseReleased, now)
+	memorial.Achievements = append([]string{"回归自然"}, p.lifeAchievements()...)
+
+	p.addEvent(PetReleasedEvent{
+		PetID:     p.ID,
+		UserID:    p.UserID,
+		Name:      p.Name,
+		LifeDays:  memorial.LifeDays(),
+		Timestamp: now,
+	})
+	return memorial, nil
+}
+
+// 宠物栏相关错误
+var (
+	ErrRosterFull          = errors.New("宠物栏已满，请先扩充栏位")
+	ErrRosterMaxCapacity   = errors.New("宠物栏已扩充到上限")
+	ErrReleaseNotConfirmed = errors.New("请输入宠物的名字确认放生")
+	ErrInvalidRosterOrder  = errors.New("排序需包含全部宠物且不能重复")
+)
@@ -2,6 +2,12 @@
 // 领域服务 - 处理跨实体的复杂业务逻辑
 package pet
 
+import (
+	"context"
+	"errors"
+	"time"
+)
+
 // DomainService 宠物领域服务
 type DomainService struct {
 	repo            Repository
@@ -11,12 +17,12 @@ type DomainService struct {
 }
 
 // NewDomainService 创建领域服务
-func NewDomainService(repo Repository, speciesRegistry *SpeciesRegistry, fusionRegistry *SpeciesFusionRegistry) *DomainService {
+func NewDomainService(repo Repository, speciesRegistry *SpeciesRegistry, fusionRegistry *SpeciesFusionRegistry, inbreedingRules InbreedingRules) *DomainService {
 	return &DomainService{
 		repo:            repo,
 		speciesRegistry: speciesRegistry,
 		fusionRegistry:  fusionRegistry,
-		breedingService: NewBreedingService(speciesRegistry, fusionRegistry),
+		breedingService: NewBreedingService(speciesRegistry, fusionRegistry, inbreedingRules),
 	}
 }
 
@@ -35,6 +41,26 @@ func (s *DomainService) GetBreedingService() *BreedingService {
 	return s.breedingService
 }
 
+// ResolveActivePet 解析用户的主宠物
+// 未设置主宠物（老数据）、主宠物已不存在（如已寿终）或已易主时，退回用户的第一只宠物
+func ResolveActivePet(ctx context.Context, repo Repository, userID int, activePetID *int) (*Pet, error) {
+	if activePetID == nil {
+		return repo.FindByUserID(ctx, userID)
+	}
+
+	p, err := repo.FindByID(ctx, *activePetID)
+	if err != nil {
+		if errors.Is(err, ErrPetNotFound) {
+			return repo.FindByUserID(ctx, userID)
+		}
+		return nil, err
+	}
+	if p.UserID != userID {
+		return repo.FindByUserID(ctx, userID)
+	}
+	return p, nil
+}
+
 // --- 宠物创建 ---
 
 // CreatePet 创建新宠物（指定物种）
@@ -47,12 +73,16 @@ func (s *DomainService) CreatePet(userID int, name string, speciesID SpeciesID)
 
 	// 创建宠物
 	pet := NewPetWithSpecies(userID, name, speciesID, &species.GenderRule)
+	s.speciesRegistry.NormalizeGene(pet)
 
 	// 解析物种特有外观
 	if interpreter, ok := s.speciesRegistry.GetInterpreter(speciesID); ok {
 		pet.SetSpecialAppearance(interpreter.InterpretSpecialFeatures(pet.Gene))
 	}
 
+	// 按物种设置孵化时间和寿命
+	s.speciesRegistry.InitLifecycle(pet)
+
 	return pet, nil
 }
 
@@ -95,24 +125,44 @@ func (s *DomainService) CreateRandomPet(userID int, name string) *Pet {
 	}
 
 	pet := NewPetWithSpecies(userID, name, selectedSpecies.ID, &selectedSpecies.GenderRule)
+	s.speciesRegistry.NormalizeGene(pet)
 
 	// 解析物种特有外观
 	if interpreter, ok := s.speciesRegistry.GetInterpreter(selectedSpecies.ID); ok {
 		pet.SetSpecialAppearance(interpreter.InterpretSpecialFeatures(pet.Gene))
 	}
 
+	// 按物种设置孵化时间和寿命
+	s.speciesRegistry.InitLifecycle(pet)
+
 	return pet
 }
 
 // --- 繁殖相关 ---
 
-// BreedPets 繁殖两只宠物
-func (s *DomainService) BreedPets(parent1, parent2 *Pet, childName string, ownerID int) (*BreedingResult, error) {
+// BreedPets 繁殖两只宠物，ancestry 为双亲的祖先图谱（用于计算近交系数）
+func (s *DomainService) BreedPets(parent1, parent2 *Pet, childName string, ownerID int, ancestry *LineageGraph) (*BreedingResult, error) {
 	return s.breedingService.Breed(BreedingRequest{
 		Parent1:   parent1,
 		Parent2:   parent2,
 		ChildName: childName,
 		OwnerID:   ownerID,
+		Ancestry:  ancestry,
+	})
+}
+
+// BreedLitter 双亲繁殖一窝后代，每只子代按 litter 分配所有者（用于跨主人繁殖契约）
+func (s *DomainService) BreedLitter(parent1, parent2 *Pet, litter []LitterSlot, ancestry *LineageGraph) (*BreedingResult, error) {
+	if parent2 == nil || len(litter) == 0 {
+		return nil, ErrInvalidLitter
+	}
+	return s.breedingService.Breed(BreedingRequest{
+		Parent1:   parent1,
+		Parent2:   parent2,
+		ChildName: litter[0].ChildName,
+		OwnerID:   litter[0].OwnerID,
+		Litter:    litter,
+		Ancestry:  ancestry,
 	})
 }
 
@@ -127,8 +177,8 @@ func (s *DomainService) SelfBreedPet(parent *Pet, childName string, ownerID int)
 }
 
 // CanBreedPair 检查两只宠物是否可以繁殖
-func (s *DomainService) CanBreedPair(parent1, parent2 *Pet) error {
-	return s.breedingService.CanBreedPair(parent1, parent2)
+func (s *DomainService) CanBreedPair(parent1, parent2 *Pet, ancestry *LineageGraph) error {
+	return s.breedingService.CanBreedPair(parent1, parent2, ancestry)
 }
 
 // CanSelfBreed 检查宠物是否可以分裂繁殖
@@ -136,9 +186,14 @@ func (s *DomainService) CanSelfBreed(pet *Pet) error {
 	return s.breedingService.CanSelfBreed(pet)
 }
 
-// PredictOffspringSpecies 预测后代物种
-func (s *DomainService) PredictOffspringSpecies(parent1, parent2 *Pet) []SpeciesProbability {
-	return s.breedingService.PredictOffspringSpecies(parent1, parent2)
+// GetBreedingCooldown 获取繁殖冷却剩余时间
+func (s *DomainService) GetBreedingCooldown(pet *Pet) time.Duration {
+	return s.breedingService.GetBreedingCooldown(pet)
+}
+
+// PredictOffspringSpecies 预测后代物种及近亲程度
+func (s *DomainService) PredictOffspringSpecies(parent1, parent2 *Pet, ancestry *LineageGraph) ([]SpeciesProbability, Inbreeding) {
+	return s.breedingService.PredictOffspringSpecies(parent1, parent2, ancestry)
 }
 
 // --- 评分计算 ---
@@ -187,6 +242,17 @@ func (s *DomainService) GetSpeciesByCategory(category SpeciesCategory) []*Specie
 	return s.speciesRegistry.GetByCategory(category)
 }
 
+// Genotypes 宠物各孟德尔性状组的基因型，物种未启用孟德尔遗传时为空
+func (s *DomainService) Genotypes(pet *Pet) []Genotype {
+	return s.speciesRegistry.GeneticsOf(pet.SpeciesID).Genotypes(pet.Gene)
+}
+
+// InspectGenome 解读宠物的基因组，revealHidden 为 false 时遮蔽隐藏片段
+func (s *DomainService) InspectGenome(pet *Pet, pool *GenePool, revealHidden bool) GenomeReport {
+	interpreter, _ := s.speciesRegistry.GetInterpreter(pet.SpeciesID)
+	return InspectGenome(pet.Gene, interpreter, s.speciesRegistry.GeneticsOf(pet.SpeciesID), pool, revealHidden)
+}
+
 // InterpretPetAppearance 解析宠物的物种特有外观
 func (s *DomainService) InterpretPetAppearance(pet *Pet) SpecialAppearance {
 	return s.speciesRegistry.InterpretGene(pet.SpeciesID, pet.Gene)
@@ -21,6 +21,7 @@ const (
 type Skill struct {
 	Type     SkillType // 技能类型
 	Level    int       // 技能等级 1-5
+	Exp      int       // 当前等级已积累的技能经验
 	Strength int       // 技能强度 (来自基因)
 }
 
@@ -85,13 +86,26 @@ func (s Skill) EffectMultiplier() float64 {
 
 // LevelUp 升级技能
 func (s *Skill) LevelUp() bool {
-	if s.Level >= 5 {
+	if s.Level >= MaxSkillLevel {
 		return false
 	}
 	s.Level++
 	return true
 }
 
+// IsMaxLevel 是否已满级
+func (s Skill) IsMaxLevel() bool {
+	return s.Level >= MaxSkillLevel
+}
+
+// ExpToNext 升到下一级所需的技能经验，满级时为0
+func (s Skill) ExpToNext() int {
+	if s.IsMaxLevel() {
+		return 0
+	}
+	return s.Level * SkillExpPerLevel
+}
+
 // Rarity 技能稀有度描述
 func (s Skill) Rarity() string {
 	if s.Strength >= 4 {
@@ -104,3 +118,18 @@ func (s Skill) Rarity() string {
 	return "普通"
 }
 
+// NewSecondarySkillFromGene 从基因创建副技能
+// 与主技能相同时没有副技能；强度比主技能低一星，不参与训练，固定为1级
+func NewSecondarySkillFromGene(gene Gene) Skill {
+	totalSkills := 9 // 技能总数
+	skillType := SkillType(gene.SkillSecondaryID() % totalSkills)
+	if skillType == SkillType(gene.SkillID(totalSkills)) {
+		skillType = SkillTypeNone
+	}
+
+	return Skill{
+		Type:     skillType,
+		Level:    1,
+		Strength: maxInt(gene.SkillStrength()-1, 1),
+	}
+}
@@ -0,0 +1,103 @@
+// Package pet 宠物领域
+// SkillTraining 技能训练 - 与技能类型相符的行为积累技能经验，学习速度受智力影响
+package pet
+
+import "time"
+
+// TrainingAction 技能训练行为
+type TrainingAction string
+
+const (
+	TrainingFeed          TrainingAction = "feed"            // 喂食
+	TrainingPlay          TrainingAction = "play"            // 玩耍
+	TrainingClean         TrainingAction = "clean"           // 清洁
+	TrainingRecover       TrainingAction = "recover"         // 病愈
+	TrainingVisit         TrainingAction = "visit"           // 拜访好友领取奖励
+	TrainingHosted        TrainingAction = "hosted"          // 被好友拜访
+	TrainingCaredByFriend TrainingAction = "cared_by_friend" // 被好友照顾
+	TrainingExplore       TrainingAction = "explore"         // 经历随机事件
+)
+
+// trainingSkills 训练行为对应的技能类型
+var trainingSkills = map[TrainingAction]SkillType{
+	TrainingFeed:          SkillTypeGluttony,
+	TrainingPlay:          SkillTypePlayful,
+	TrainingClean:         SkillTypeCleanlover,
+	TrainingRecover:       SkillTypeEndurance,
+	TrainingVisit:         SkillTypeLucky,
+	TrainingHosted:        SkillTypeCharming,
+	TrainingCaredByFriend: SkillTypeFriendly,
+	TrainingExplore:       SkillTypeCurious,
+}
+
+// 技能训练参数
+const (
+	MaxSkillLevel     = 5   // 技能最高等级
+	SkillExpPerAction = 5   // 每次相符行为获得的基础技能经验
+	SkillExpPerLevel  = 100 // 每级所需技能经验 = 当前等级 × 该值
+)
+
+// Trains 该行为是否训练指定技能
+func (a TrainingAction) Trains(skill SkillType) bool {
+	trained, ok := trainingSkills[a]
+	return ok && trained == skill
+}
+
+// TrainSkill 行为与技能类型相符时积累技能经验
+// 返回技能是否升级
+func (p *Pet) TrainSkill(action TrainingAction) bool {
+	if !action.Trains(p.Skill.Type) {
+		return false
+	}
+	return p.addSkillExp(SkillExpPerAction)
+}
+
+// GainSkillExp 直接获得技能经验（训练道具效果）
+func (p *Pet) GainSkillExp(exp int) error {
+	if p.Stage == StageEgg {
+		return ErrPetIsEgg
+	}
+	if exp <= 0 {
+		return ErrInvalidStatusAmount
+	}
+	if p.Skill.Type == SkillTypeNone {
+		return ErrNoSkill
+	}
+	if p.Skill.IsMaxLevel() {
+		return ErrSkillMaxLevel
+	}
+	p.addSkillExp(exp)
+	return nil
+}
+
+// addSkillExp 按学习速度增加技能经验，可能连续升级
+func (p *Pet) addSkillExp(base int) bool {
+	if p.Stage == StageEgg || p.Skill.Type == SkillTypeNone || p.Skill.IsMaxLevel() {
+		return false
+	}
+
+	exp := float64(base) * p.Personality.LearningSpeed()
+	if p.HasAbility(AbilityFastLearner) {
+		exp *= FastLearnerSkillExp
+	}
+	p.Skill.Exp += int(exp)
+
+	leveled := false
+	for !p.Skill.IsMaxLevel() && p.Skill.Exp >= p.Skill.ExpToNext() {
+		p.Skill.Exp -= p.Skill.ExpToNext()
+		p.Skill.LevelUp()
+		leveled = true
+
+		p.addEvent(PetSkillLeveledUpEvent{
+			PetID:     p.ID,
+			UserID:    p.UserID,
+			SkillType: int(p.Skill.Type),
+			Level:     p.Skill.Level,
+			Timestamp: time.Now(),
+		})
+	}
+	if p.Skill.IsMaxLevel() {
+		p.Skill.Exp = 0
+	}
+	return leveled
+}
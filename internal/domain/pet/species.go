@@ -2,6 +2,8 @@
 // Species 物种定义 - 定义物种的特征模板和基因解释规则
 package pet
 
+import "time"
+
 // SpeciesID 物种ID类型
 type SpeciesID int
 
@@ -83,6 +85,11 @@ type Species struct {
 	IsHidden     bool               // 是否为隐藏物种
 	GenderRule   GenderRule         // 性别规则
 	BreedRules   BreedingRules      // 繁衍规则
+	IncubationHours int             // 孵化时间（小时）
+	LifespanDays    int             // 老年期寿命（天）
+	Growth          GrowthCurve     // 成长曲线
+	Forms           []EvolutionForm // 成熟期进化形态（按顺序匹配）
+	Genetics        Genetics        // 孟德尔遗传模型（可选，未配置时逐位随机遗传）
 	Interpreter  GeneInterpreter    // 基因解释器
 }
 
@@ -150,6 +157,69 @@ func (r *SpeciesRegistry) GetInterpreter(id SpeciesID) (GeneInterpreter, bool) {
 	return interpreter, ok
 }
 
+// IncubationDuration 获取物种孵化时间，未配置时使用默认值
+func (r *SpeciesRegistry) IncubationDuration(id SpeciesID) time.Duration {
+	if species, ok := r.species[id]; ok && species.IncubationHours > 0 {
+		return time.Duration(species.IncubationHours) * time.Hour
+	}
+	return DefaultIncubationHours * time.Hour
+}
+
+// LifespanDuration 获取物种老年期寿命，未配置时使用默认值
+func (r *SpeciesRegistry) LifespanDuration(id SpeciesID) time.Duration {
+	if species, ok := r.species[id]; ok && species.LifespanDays > 0 {
+		return time.Duration(species.LifespanDays) * 24 * time.Hour
+	}
+	return DefaultLifespanDays * 24 * time.Hour
+}
+
+// GrowthCurve 获取物种成长曲线，未配置时使用默认曲线
+func (r *SpeciesRegistry) GrowthCurve(id SpeciesID) GrowthCurve {
+	if species, ok := r.species[id]; ok {
+		return species.Growth.Normalize()
+	}
+	return DefaultGrowthCurve()
+}
+
+// EvolutionForms 获取物种的进化形态
+func (r *SpeciesRegistry) EvolutionForms(id SpeciesID) []EvolutionForm {
+	if species, ok := r.species[id]; ok {
+		return species.Forms
+	}
+	return nil
+}
+
+// AttachGrowth 为宠物注入物种成长曲线和进化形态（不落库，创建和加载时调用）
+func (r *SpeciesRegistry) AttachGrowth(p *Pet) {
+	p.Growth = r.GrowthCurve(p.SpeciesID)
+	p.Forms = r.EvolutionForms(p.SpeciesID)
+}
+
+// InitLifecycle 按物种设置新宠物的孵化时间、寿命、成长曲线和进化形态
+func (r *SpeciesRegistry) InitLifecycle(p *Pet) {
+	p.SetIncubation(r.IncubationDuration(p.SpeciesID))
+	p.SetLifespan(r.LifespanDuration(p.SpeciesID))
+	r.AttachGrowth(p)
+}
+
+// GeneticsOf 物种的孟德尔遗传模型，未配置时不启用
+func (r *SpeciesRegistry) GeneticsOf(id SpeciesID) Genetics {
+	if species, ok := r.species[id]; ok {
+		return species.Genetics
+	}
+	return Genetics{}
+}
+
+// NormalizeGene 按物种的孟德尔遗传模型整理宠物的基因（显性等位基因放到显现位），外观随之更新（创建和加载时调用）
+func (r *SpeciesRegistry) NormalizeGene(p *Pet) {
+	genetics := r.GeneticsOf(p.SpeciesID)
+	if !genetics.Enabled() {
+		return
+	}
+	p.Gene = genetics.Normalize(p.Gene)
+	p.Appearance = NewAppearanceFromGene(p.Gene)
+}
+
 // GetByCategory 按分类获取物种列表
 func (r *SpeciesRegistry) GetByCategory(category SpeciesCategory) []*Species {
 	var result []*Species
@@ -0,0 +1,138 @@
+// Package pet 宠物领域
+// StatusSnapshot 轻量状态快照 - 供批量落库使用
+package pet
+
+import "time"
+
+// 状态警告类型
+const (
+	WarningHungry  = "hungry"
+	WarningUnhappy = "unhappy"
+	WarningDirty   = "dirty"
+	WarningTired   = "tired"
+)
+
+// StatusSnapshot 宠物轻量状态快照（值对象）
+// 只包含实时状态、健康状态及其锚点，落库时只更新这些列
+type StatusSnapshot struct {
+	PetID           int        `json:"pet_id"`
+	UserID          int        `json:"user_id"`
+	Hunger          int        `json:"hunger"`
+	Happiness       int        `json:"happiness"`
+	Cleanliness     int        `json:"cleanliness"`
+	Energy          int        `json:"energy"`
+	StatusUpdatedAt time.Time  `json:"status_updated_at"`
+	Illness         Illness    `json:"illness"`
+	IllAt           *time.Time `json:"ill_at,omitempty"`
+	HealthCheckedAt time.Time  `json:"health_checked_at"`
+	FellIll         bool       `json:"fell_ill,omitempty"` // 本次健康检查中生病
+	Mood            Mood       `json:"mood"`
+	MoodCalm        int        `json:"mood_calm"`
+	PrevMood        Mood       `json:"prev_mood,omitempty"`
+	MoodChanged     bool       `json:"mood_changed,omitempty"` // 本次情绪发生变化
+	Revision        int64      `json:"revision"`               // 快照对应的新版本号
+	Warnings        []string   `json:"warnings,omitempty"`     // 本次新跨过阈值的警告
+}
+
+// BaseRevision 生成快照时实体的版本号
+// 落库时只有版本号未变化的记录才会被更新，避免覆盖玩家操作写入的新状态
+func (s StatusSnapshot) BaseRevision() int64 {
+	return s.Revision - 1
+}
+
+// WarningEvents 生成本次跨过阈值的警告事件
+func (s StatusSnapshot) WarningEvents() []PetStatusWarningEvent {
+	events := make([]PetStatusWarningEvent, 0, len(s.Warnings))
+	for _, w := range s.Warnings {
+		events = append(events, PetStatusWarningEvent{
+			PetID:       s.PetID,
+			UserID:      s.UserID,
+			WarningType: w,
+			Timestamp:   s.StatusUpdatedAt,
+		})
+	}
+	return events
+}
+
+// FellIllEvent 本次健康检查中生病时生成生病事件
+func (s StatusSnapshot) FellIllEvent() (PetFellIllEvent, bool) {
+	if !s.FellIll {
+		return PetFellIllEvent{}, false
+	}
+	return PetFellIllEvent{
+		PetID:     s.PetID,
+		UserID:    s.UserID,
+		Illness:   int(s.Illness),
+		Timestamp: s.StatusUpdatedAt,
+	}, true
+}
+
+// MoodChangedEvent 本次情绪变化时生成情绪变化事件
+func (s StatusSnapshot) MoodChangedEvent() (PetMoodChangedEvent, bool) {
+	if !s.MoodChanged {
+		return PetMoodChangedEvent{}, false
+	}
+	return PetMoodChangedEvent{
+		PetID:     s.PetID,
+		UserID:    s.UserID,
+		From:      string(s.PrevMood),
+		To:        string(s.Mood),
+		Timestamp: s.StatusUpdatedAt,
+	}, true
+}
+
+// SnapshotAt 计算指定时间点的状态快照（不修改实体）
+// 同时进行健康检查并推进情绪；状态与已保存的值相同且锚点有效时返回 false，无需落库
+// 仅健康检查时间推进不算变化，否则每次扫描都会推进状态锚点，衰减被取整为零
+func (p *Pet) SnapshotAt(now time.Time) (StatusSnapshot, bool) {
+	after := *p
+	after.events = nil
+	fellIll := after.CheckHealth(now)
+	after.Hunger, after.Happiness, after.Cleanliness, after.Energy = p.StatusAt(now)
+	moodChanged := after.UpdateMood(now)
+
+	unchanged := after.Hunger == p.Hunger &&
+		after.Happiness == p.Happiness &&
+		after.Cleanliness == p.Cleanliness &&
+		after.Energy == p.Energy &&
+		after.Illness == p.Illness &&
+		!moodChanged
+	if unchanged && !p.StatusUpdatedAt.IsZero() {
+		return StatusSnapshot{}, false
+	}
+
+	// 只在从正常跨入警告区间时提醒，避免每次刷新重复推送
+	var warnings []string
+	if !p.IsHungry() && after.IsHungry() {
+		warnings = append(warnings, WarningHungry)
+	}
+	if !p.IsUnhappy() && after.IsUnhappy() {
+		warnings = append(warnings, WarningUnhappy)
+	}
+	if !p.IsDirty() && after.IsDirty() {
+		warnings = append(warnings, WarningDirty)
+	}
+	if !p.IsTired() && after.IsTired() {
+		warnings = append(warnings, WarningTired)
+	}
+
+	return StatusSnapshot{
+		PetID:           p.ID,
+		UserID:          p.UserID,
+		Hunger:          after.Hunger,
+		Happiness:       after.Happiness,
+		Cleanliness:     after.Cleanliness,
+		Energy:          after.Energy,
+		StatusUpdatedAt: now,
+		Illness:         after.Illness,
+		IllAt:           after.IllAt,
+		HealthCheckedAt: after.HealthCheckedAt,
+		FellIll:         fellIll,
+		Mood:            after.Mood,
+		MoodCalm:        after.MoodCalm,
+		PrevMood:        p.Mood,
+		MoodChanged:     moodChanged,
+		Revision:        p.Revision + 1,
+		Warnings:        warnings,
+	}, true
+}
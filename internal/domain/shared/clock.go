@@ -0,0 +1,26 @@
+// Package shared 包含跨领域的共享接口和类型
+// 游戏时区 - 每日次数、昼夜等按游戏所在时区计算，与服务器所在时区无关
+package shared
+
+import "time"
+
+// gameLocation 游戏时区，默认为服务器本地时区
+var gameLocation = time.Local
+
+// SetGameLocation 设置游戏时区（启动时按配置设置一次）
+func SetGameLocation(loc *time.Location) {
+	if loc != nil {
+		gameLocation = loc
+	}
+}
+
+// GameTime 将时间转换到游戏时区
+func GameTime(t time.Time) time.Time {
+	return t.In(gameLocation)
+}
+
+// StartOfGameDay 游戏时区内 t 所在日的零点
+func StartOfGameDay(t time.Time) time.Time {
+	y, m, d := GameTime(t).Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, gameLocation)
+}
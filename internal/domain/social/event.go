@@ -0,0 +1,63 @@
+// Package social 社交领域
+// 领域事件定义
+package social
+
+import "time"
+
+// TradeCreatedEvent 交易创建事件（通知接收方）
+type TradeCreatedEvent struct {
+	TradeID         int       `json:"trade_id"`
+	FromUserID      int       `json:"from_user_id"`
+	ToUserID        int       `json:"to_user_id"`
+	OfferItemID     int       `json:"offer_item_id"`
+	OfferQuantity   int       `json:"offer_quantity"`
+	RequestItemID   int       `json:"request_item_id"`
+	RequestQuantity int       `json:"request_quantity"`
+	Timestamp       time.Time `json:"timestamp"`
+}
+
+func (e TradeCreatedEvent) EventName() string { return "social.trade_created" }
+
+// TradeCompletedEvent 交易完成事件（通知双方）
+type TradeCompletedEvent struct {
+	TradeID    int       `json:"trade_id"`
+	FromUserID int       `json:"from_user_id"`
+	ToUserID   int       `json:"to_user_id"`
+	Timestamp  time.Time `json:"timestamp"`
+}
+
+func (e TradeCompletedEvent) EventName() string { return "social.trade_completed" }
+
+// TradeCancelledEvent 交易取消事件（通知双方）
+type TradeCancelledEvent struct {
+	TradeID     int       `json:"trade_id"`
+	FromUserID  int       `json:"from_user_id"`
+	ToUserID    int       `json:"to_user_id"`
+	CancelledBy int       `json:"cancelled_by"`
+	Timestamp   time.Time `json:"timestamp"`
+}
+
+func (e TradeCancelledEvent) EventName() string { return "social.trade_cancelled" }
+
+// TradeExpiredEvent 交易过期事件（通知双方）
+type TradeExpiredEvent struct {
+	TradeID    int       `json:"trade_id"`
+	FromUserID int       `json:"from_user_id"`
+	ToUserID   int       `json:"to_user_id"`
+	Timestamp  time.Time `json:"timestamp"`
+}
+
+func (e TradeExpiredEvent) EventName() string { return "social.trade_expired" }
+
+// FriendVisitedEvent 好友拜访事件（通知主人）
+type FriendVisitedEvent struct {
+	VisitorID    int       `json:"visitor_id"`
+	HostID       int       `json:"host_id"`
+	PetID        int       `json:"pet_id"`
+	VisitorCoins int       `json:"visitor_coins"`
+	HostCoins    int       `json:"host_coins"`
+	Intimacy     int       `json:"intimacy"`
+	Timestamp    time.Time `json:"timestamp"`
+}
+
+func (e FriendVisitedEvent) EventName() string { return "social.friend_visited" }
@@ -88,6 +88,14 @@ func (f *Friendship) IsFriend() bool {
 	return f.Status == FriendStatusAccepted
 }
 
+// BreedingIntimacyThreshold 跨主人繁殖所需的最低亲密度（"朋友"及以上）
+const BreedingIntimacyThreshold = 40
+
+// CanBreedTogether 双方宠物是否可以签订繁殖契约
+func (f *Friendship) CanBreedTogether() bool {
+	return f.IsFriend() && f.Intimacy >= BreedingIntimacyThreshold
+}
+
 func min(a, b int) int {
 	if a < b {
 		return a
@@ -101,5 +109,5 @@ var (
 	ErrAlreadyFriends      = errors.New("已经是好友了")
 	ErrFriendshipNotFound  = errors.New("好友关系不存在")
 	ErrCannotAddSelf       = errors.New("不能添加自己为好友")
+	ErrNotFriends          = errors.New("对方不是你的好友")
 )
-
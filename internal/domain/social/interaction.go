@@ -0,0 +1,78 @@
+// Package social 社交领域
+// Interaction 好友互动 - 帮好友照顾宠物
+package social
+
+import (
+	"errors"
+	"math"
+	"time"
+)
+
+// CareAction 照顾动作
+type CareAction string
+
+const (
+	CareActionFeed  CareAction = "feed"  // 喂食
+	CareActionPlay  CareAction = "play"  // 玩耍
+	CareActionClean CareAction = "clean" // 清洁
+)
+
+// Name 获取动作名称
+func (a CareAction) Name() string {
+	switch a {
+	case CareActionFeed:
+		return "喂食"
+	case CareActionPlay:
+		return "玩耍"
+	case CareActionClean:
+		return "清洁"
+	default:
+		return "未知"
+	}
+}
+
+const (
+	// MaxDailyInteractionsPerFriend 每天最多帮同一位好友照顾宠物的次数
+	MaxDailyInteractionsPerFriend = 3
+
+	// InteractionBaseIntimacy 每次照顾增加的基础亲密度
+	InteractionBaseIntimacy = 2
+)
+
+// Interaction 好友互动记录
+type Interaction struct {
+	ID        int
+	ActorID   int // 动手照顾的用户
+	OwnerID   int // 宠物主人
+	PetID     int
+	Action    CareAction
+	Intimacy  int // 本次增加的亲密度
+	CreatedAt time.Time
+}
+
+// NewInteraction 创建互动记录
+func NewInteraction(actorID, ownerID, petID int, action CareAction, intimacy int) *Interaction {
+	return &Interaction{
+		ActorID:   actorID,
+		OwnerID:   ownerID,
+		PetID:     petID,
+		Action:    action,
+		Intimacy:  intimacy,
+		CreatedAt: time.Now(),
+	}
+}
+
+// CalculateCareIntimacy 计算照顾好友宠物增加的亲密度
+// loyaltyBonus 为好友宠物忠诚度带来的加成倍数
+func CalculateCareIntimacy(loyaltyBonus float64) int {
+	if loyaltyBonus < 1 {
+		loyaltyBonus = 1
+	}
+	return int(math.Round(InteractionBaseIntimacy * loyaltyBonus))
+}
+
+// 互动相关错误
+var (
+	ErrCareOwnPet              = errors.New("不能以好友身份照顾自己的宠物")
+	ErrInteractionLimitReached = errors.New("今天帮这位好友照顾宠物的次数已用完")
+)
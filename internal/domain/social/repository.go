@@ -2,7 +2,10 @@
 // Repository 仓储接口
 package social
 
-import "context"
+import (
+	"context"
+	"time"
+)
 
 // FriendRepository 好友仓储接口
 type FriendRepository interface {
@@ -45,12 +48,19 @@ type TradeRepository interface {
 	// FindByID 根据ID查找交易
 	FindByID(ctx context.Context, id int) (*Trade, error)
 
+	// FindByIDForUpdate 根据ID查找并锁定交易（须在事务中调用）
+	FindByIDForUpdate(ctx context.Context, id int) (*Trade, error)
+
 	// FindByUser 获取用户相关的交易
 	FindByUser(ctx context.Context, userID int) ([]*Trade, error)
 
 	// FindPending 获取待处理的交易
 	FindPending(ctx context.Context, userID int) ([]*Trade, error)
 
+	// LockExpiredPending 锁定并获取创建时间早于 before 的待处理交易（最多 limit 条）
+	// 需在事务中调用；已被其他事务锁定的交易会被跳过，便于多实例并发处理
+	LockExpiredPending(ctx context.Context, before time.Time, limit int) ([]*Trade, error)
+
 	// Save 保存交易
 	Save(ctx context.Context, trade *Trade) error
 }
@@ -67,3 +77,12 @@ type VisitRepository interface {
 	HasVisitedToday(ctx context.Context, visitorID, hostID int) (bool, error)
 }
 
+
+// InteractionRepository 好友互动记录仓储接口
+type InteractionRepository interface {
+	// Save 保存互动记录
+	Save(ctx context.Context, interaction *Interaction) error
+
+	// CountToday 统计今天帮某位好友照顾宠物的次数
+	CountToday(ctx context.Context, actorID, ownerID int) (int, error)
+}
@@ -2,6 +2,11 @@
 // 领域服务
 package social
 
+import (
+	"context"
+	"errors"
+)
+
 // TODO: 社交领域服务
 // - 好友推荐
 // - 交易撮合
@@ -27,3 +32,18 @@ func NewDomainService(
 	}
 }
 
+// FindFriendship 查找双方已通过的好友关系
+// 没有关系或申请尚未通过时返回 ErrNotFriends，交易、代喂、繁殖契约等共用这一校验
+func FindFriendship(ctx context.Context, repo FriendRepository, userID, friendID int) (*Friendship, error) {
+	friendship, err := repo.FindByUsers(ctx, userID, friendID)
+	if err != nil {
+		if errors.Is(err, ErrFriendshipNotFound) {
+			return nil, ErrNotFriends
+		}
+		return nil, err
+	}
+	if !friendship.IsFriend() {
+		return nil, ErrNotFriends
+	}
+	return friendship, nil
+}
@@ -7,6 +7,9 @@ import (
 	"time"
 )
 
+// DefaultTradeTTL 默认交易有效期，超时未处理自动过期并退还托管道具
+const DefaultTradeTTL = 24 * time.Hour
+
 // TradeStatus 交易状态
 type TradeStatus int
 
@@ -17,7 +20,18 @@ const (
 	TradeStatusExpired   TradeStatus = 3 // 已过期
 )
 
+// Name 获取状态名称
+func (s TradeStatus) Name() string {
+	names := []string{"待确认", "已完成", "已取消", "已过期"}
+	if int(s) >= 0 && int(s) < len(names) {
+		return names[s]
+	}
+	return "未知"
+}
+
 // Trade 交易实体
+// 发起方提供的道具在创建时即从背包扣除并托管，
+// 交易完成时交给接收方，取消或过期时退还发起方
 type Trade struct {
 	ID              int
 	FromUserID      int         // 发起者
@@ -29,10 +43,19 @@ type Trade struct {
 	Status          TradeStatus // 状态
 	CreatedAt       time.Time
 	CompletedAt     time.Time
+
+	events []any
 }
 
 // NewTrade 创建交易
-func NewTrade(fromUserID, toUserID int, offerItemID, offerQty, requestItemID, requestQty int) *Trade {
+func NewTrade(fromUserID, toUserID int, offerItemID, offerQty, requestItemID, requestQty int) (*Trade, error) {
+	if fromUserID == toUserID {
+		return nil, ErrTradeSelf
+	}
+	if offerQty <= 0 || requestQty <= 0 {
+		return nil, ErrInvalidTradeQuantity
+	}
+
 	return &Trade{
 		FromUserID:      fromUserID,
 		ToUserID:        toUserID,
@@ -42,49 +65,108 @@ func NewTrade(fromUserID, toUserID int, offerItemID, offerQty, requestItemID, re
 		RequestQuantity: requestQty,
 		Status:          TradeStatusPending,
 		CreatedAt:       time.Now(),
-	}
+	}, nil
+}
+
+// Created 记录交易创建事件（保存获得ID后调用）
+func (t *Trade) Created() {
+	t.addEvent(TradeCreatedEvent{
+		TradeID:         t.ID,
+		FromUserID:      t.FromUserID,
+		ToUserID:        t.ToUserID,
+		OfferItemID:     t.OfferItemID,
+		OfferQuantity:   t.OfferQuantity,
+		RequestItemID:   t.RequestItemID,
+		RequestQuantity: t.RequestQuantity,
+		Timestamp:       t.CreatedAt,
+	})
+}
+
+// IsParty 用户是否为交易一方
+func (t *Trade) IsParty(userID int) bool {
+	return userID == t.FromUserID || userID == t.ToUserID
 }
 
-// Accept 接受交易
+// Accept 接受交易（是否过期由调用方按配置的有效期判断）
 func (t *Trade) Accept() error {
 	if t.Status != TradeStatusPending {
 		return ErrInvalidTradeStatus
 	}
 	t.Status = TradeStatusCompleted
 	t.CompletedAt = time.Now()
+
+	t.addEvent(TradeCompletedEvent{
+		TradeID:    t.ID,
+		FromUserID: t.FromUserID,
+		ToUserID:   t.ToUserID,
+		Timestamp:  t.CompletedAt,
+	})
 	return nil
 }
 
-// Cancel 取消交易
-func (t *Trade) Cancel() error {
+// Cancel 取消交易（发起方撤回或接收方拒绝）
+func (t *Trade) Cancel(byUserID int) error {
 	if t.Status != TradeStatusPending {
 		return ErrInvalidTradeStatus
 	}
 	t.Status = TradeStatusCancelled
 	t.CompletedAt = time.Now()
+
+	t.addEvent(TradeCancelledEvent{
+		TradeID:     t.ID,
+		FromUserID:  t.FromUserID,
+		ToUserID:    t.ToUserID,
+		CancelledBy: byUserID,
+		Timestamp:   t.CompletedAt,
+	})
 	return nil
 }
 
-// IsExpired 检查是否过期（24小时）
-func (t *Trade) IsExpired() bool {
+// IsExpired 检查待处理交易是否已超过有效期，ttl 不大于0时使用默认有效期
+func (t *Trade) IsExpired(ttl time.Duration) bool {
 	if t.Status != TradeStatusPending {
 		return false
 	}
-	return time.Since(t.CreatedAt) > 24*time.Hour
+	if ttl <= 0 {
+		ttl = DefaultTradeTTL
+	}
+	return time.Since(t.CreatedAt) > ttl
 }
 
 // MarkExpired 标记为过期
 func (t *Trade) MarkExpired() {
-	if t.Status == TradeStatusPending {
-		t.Status = TradeStatusExpired
-		t.CompletedAt = time.Now()
+	if t.Status != TradeStatusPending {
+		return
 	}
+	t.Status = TradeStatusExpired
+	t.CompletedAt = time.Now()
+
+	t.addEvent(TradeExpiredEvent{
+		TradeID:    t.ID,
+		FromUserID: t.FromUserID,
+		ToUserID:   t.ToUserID,
+		Timestamp:  t.CompletedAt,
+	})
+}
+
+// --- 领域事件 ---
+
+func (t *Trade) addEvent(event any) {
+	t.events = append(t.events, event)
+}
+
+// Events 获取并清空事件
+func (t *Trade) Events() []any {
+	events := t.events
+	t.events = nil
+	return events
 }
 
 // 领域错误
 var (
-	ErrInvalidTradeStatus = errors.New("无效的交易状态")
-	ErrTradeNotFound      = errors.New("交易不存在")
-	ErrTradeExpired       = errors.New("交易已过期")
+	ErrInvalidTradeStatus   = errors.New("无效的交易状态")
+	ErrTradeNotFound        = errors.New("交易不存在")
+	ErrTradeExpired         = errors.New("交易已过期")
+	ErrTradeSelf            = errors.New("不能和自己交易")
+	ErrInvalidTradeQuantity = errors.New("无效的交易数量")
 )
-
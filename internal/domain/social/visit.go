@@ -0,0 +1,53 @@
+// Package social 社交领域
+// Visit 拜访好友 - 每日奖励规则
+package social
+
+import (
+	"errors"
+	"math"
+)
+
+const (
+	// MaxDailyVisitsPerHost 每位主人每天最多接待的访客数
+	MaxDailyVisitsPerHost = 20
+
+	// VisitorBaseCoins 访客每天首次拜访某位好友获得的基础金币
+	VisitorBaseCoins = 10
+
+	// HostBaseCoins 主人每接待一位访客获得的基础金币
+	HostBaseCoins = 5
+
+	// VisitIntimacy 每天首次拜访增加的亲密度
+	VisitIntimacy = 2
+)
+
+// VisitReward 拜访奖励（值对象）
+type VisitReward struct {
+	VisitorCoins int // 访客金币
+	HostCoins    int // 主人金币
+	Intimacy     int // 亲密度
+}
+
+// IsZero 是否没有奖励
+func (r VisitReward) IsZero() bool {
+	return r.VisitorCoins == 0 && r.HostCoins == 0 && r.Intimacy == 0
+}
+
+// CalculateVisitReward 计算拜访奖励
+// bonus 为主人宠物社交性带来的加成倍数，金币按倍数向下取整
+func CalculateVisitReward(bonus float64) VisitReward {
+	if bonus < 1 {
+		bonus = 1
+	}
+	return VisitReward{
+		VisitorCoins: int(math.Floor(VisitorBaseCoins * bonus)),
+		HostCoins:    int(math.Floor(HostBaseCoins * bonus)),
+		Intimacy:     VisitIntimacy,
+	}
+}
+
+// 拜访相关错误
+var (
+	ErrVisitSelf         = errors.New("不能拜访自己")
+	ErrVisitLimitReached = errors.New("对方今天接待的访客已满")
+)
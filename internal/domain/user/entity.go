@@ -21,6 +21,7 @@ type User struct {
 	Coins       int       // 金币（普通货币）
 	Diamonds    int       // 钻石（高级货币）
 	ActivePetID *int      // 当前主宠物ID
+	PetSlots    int       // 用钻石扩充的宠物栏位数
 	CreatedAt   time.Time // 创建时间
 	LastLoginAt time.Time // 最后登录时间
 }
@@ -77,6 +78,15 @@ func (u *User) SpendDiamonds(amount int) error {
 	return nil
 }
 
+// BuyPetSlot 花费钻石扩充一个宠物栏位（栏位上限由调用方校验）
+func (u *User) BuyPetSlot(price int) error {
+	if err := u.SpendDiamonds(price); err != nil {
+		return err
+	}
+	u.PetSlots++
+	return nil
+}
+
 // UpdateLogin 更新登录时间
 func (u *User) UpdateLogin() {
 	u.LastLoginAt = time.Now()
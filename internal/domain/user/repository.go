@@ -10,6 +10,10 @@ type Repository interface {
 	// FindByID 根据ID查找用户
 	FindByID(ctx context.Context, id int) (*User, error)
 
+	// FindByIDForUpdate 根据ID查找并锁定用户（须在事务中调用）
+	// 金币、钻石等按整行保存的字段在并发修改前需要先加锁
+	FindByIDForUpdate(ctx context.Context, id int) (*User, error)
+
 	// FindByUsername 根据用户名查找用户
 	FindByUsername(ctx context.Context, username string) (*User, error)
 
@@ -2,6 +2,8 @@
 // 领域服务 - 处理不属于单个实体的业务逻辑
 package user
 
+import "context"
+
 // TODO: 领域服务
 // 当业务逻辑不属于单个实体时，放在领域服务中
 // 例如：
@@ -18,3 +20,23 @@ func NewDomainService(repo Repository) *DomainService {
 	return &DomainService{repo: repo}
 }
 
+// LockPair 锁定两名用户（须在事务中调用），按参数顺序返回
+// 按用户ID从小到大加锁，避免两笔方向相反的操作互相等待
+func LockPair(ctx context.Context, repo Repository, firstID, secondID int) (*User, *User, error) {
+	lowID, highID := firstID, secondID
+	if lowID > highID {
+		lowID, highID = highID, lowID
+	}
+	low, err := repo.FindByIDForUpdate(ctx, lowID)
+	if err != nil {
+		return nil, nil, err
+	}
+	high, err := repo.FindByIDForUpdate(ctx, highID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if low.ID == firstID {
+		return low, high, nil
+	}
+	return high, low, nil
+}
@@ -8,14 +8,77 @@ import (
 
 	"pets-server/internal/domain/pet"
 	"pets-server/internal/domain/shared"
+	"pets-server/internal/domain/social"
 )
 
+// TradeSweeper 过期交易处理器（由社交应用服务实现）
+type TradeSweeper interface {
+	// ExpireStaleTrades 处理一批过期交易，返回本批处理的交易
+	ExpireStaleTrades(ctx context.Context, batchSize int) ([]*social.Trade, error)
+}
+
+// LifespanSweeper 寿终宠物处理器（由宠物应用服务实现）
+type LifespanSweeper interface {
+	// ExpiredPetIDs 按ID游标获取一批寿命已尽的宠物ID
+	ExpiredPetIDs(ctx context.Context, afterID, limit int) ([]int, error)
+
+	// PassOnPet 处理一只寿命已尽的宠物（单独一个事务），已被其他实例处理时返回 nil
+	PassOnPet(ctx context.Context, petID int) (*pet.Memorial, error)
+
+	// KeepsakeItemID 纪念品道具ID
+	KeepsakeItemID() int
+}
+
+// Notifier 在线用户通知（由 WebSocket Hub 实现）
+type Notifier interface {
+	SendToUser(userID int, msgType string, payload interface{})
+}
+
+// StatusBuffer 宠物状态缓冲区（由 Redis 实现）
+// 计算出的状态先写入缓冲区，再按时间/数量阈值批量落库
+type StatusBuffer interface {
+	// Push 写入一批快照，返回写入后缓冲区中的快照数
+	Push(ctx context.Context, snapshots []pet.StatusSnapshot) (int, error)
+
+	// Pop 取出并删除至多 limit 条快照
+	Pop(ctx context.Context, limit int) ([]pet.StatusSnapshot, error)
+}
+
+// 以下扫描配置的默认值统一由 config.CronConfig 提供，传入的间隔和批量均须为正数
+
+// TradeSweepConfig 过期交易扫描配置
+type TradeSweepConfig struct {
+	Interval  time.Duration // 扫描间隔
+	BatchSize int           // 每批处理数量
+}
+
+// LifespanSweepConfig 寿终宠物扫描配置
+type LifespanSweepConfig struct {
+	Interval  time.Duration // 扫描间隔
+	BatchSize int           // 每批处理数量
+}
+
+// StatusFlushConfig 宠物状态落库配置
+type StatusFlushConfig struct {
+	ScanInterval  time.Duration // 状态计算间隔
+	FlushInterval time.Duration // 最长落库间隔
+	BatchSize     int           // 缓冲区达到该条数立即落库，也是每批写入条数
+}
+
 // Scheduler 定时任务调度器
 type Scheduler struct {
-	petRepo   pet.Repository
-	uow       shared.UnitOfWork
-	publisher shared.EventPublisher
-	stopCh    chan struct{}
+	petRepo         pet.Repository
+	uow             shared.UnitOfWork
+	publisher       shared.EventPublisher
+	statusBuffer    StatusBuffer
+	tradeSweeper    TradeSweeper
+	lifespanSweeper LifespanSweeper
+	notifier        Notifier
+	tradeSweep      TradeSweepConfig
+	statusFlush     StatusFlushConfig
+	lifespanSweep   LifespanSweepConfig
+	flushCh         chan struct{} // 缓冲区达到数量阈值时触发落库
+	stopCh          chan struct{}
 }
 
 // NewScheduler 创建调度器
@@ -23,18 +86,44 @@ func NewScheduler(
 	petRepo pet.Repository,
 	uow shared.UnitOfWork,
 	publisher shared.EventPublisher,
+	statusBuffer StatusBuffer,
+	tradeSweeper TradeSweeper,
+	lifespanSweeper LifespanSweeper,
+	notifier Notifier,
+	tradeSweep TradeSweepConfig,
+	statusFlush StatusFlushConfig,
+	lifespanSweep LifespanSweepConfig,
 ) *Scheduler {
 	return &Scheduler{
-		petRepo:   petRepo,
-		uow:       uow,
-		publisher: publisher,
-		stopCh:    make(chan struct{}),
+		petRepo:         petRepo,
+		uow:             uow,
+		publisher:       publisher,
+		statusBuffer:    statusBuffer,
+		tradeSweeper:    tradeSweeper,
+		lifespanSweeper: lifespanSweeper,
+		notifier:        notifier,
+		tradeSweep:      tradeSweep,
+		statusFlush:     statusFlush,
+		lifespanSweep:   lifespanSweep,
+		flushCh:         make(chan struct{}, 1),
+		stopCh:          make(chan struct{}),
 	}
 }
 
 // Start 启动定时任务
 func (s *Scheduler) Start() {
-	log.Println("Scheduler initialized (pet status decay disabled)")
+	if s.statusBuffer != nil {
+		go s.runStatusCollector()
+		go s.runStatusFlusher()
+	}
+	if s.tradeSweeper != nil {
+		go s.runTradeExpirySweep()
+	}
+	if s.lifespanSweeper != nil {
+		go s.runLifespanSweep()
+	}
+	log.Printf("Scheduler started (status buffer: %t, trade expiry sweep: %t, lifespan sweep: %t)",
+		s.statusBuffer != nil, s.tradeSweeper != nil, s.lifespanSweeper != nil)
 }
 
 // Stop 停止定时任务
@@ -43,10 +132,22 @@ func (s *Scheduler) Stop() {
 	log.Println("Scheduler stopped")
 }
 
-// runPetStatusDecay 宠物状态衰减任务
-// 每小时执行一次
-func (s *Scheduler) runPetStatusDecay() {
-	ticker := time.NewTicker(1 * time.Hour)
+// ============================================================
+// 宠物状态缓冲落库：
+//   runStatusCollector 按间隔以ID游标遍历宠物
+//     → Pet.SnapshotAt() 用 StatusAt 计算当前状态并做健康检查，无变化则跳过
+//     → StatusBuffer.Push() 写入 Redis 缓冲区
+//     → 缓冲区达到 BatchSize 时立即触发落库
+//   runStatusFlusher 每 FlushInterval 或被触发时
+//     → StatusBuffer.Pop() 每次取出 BatchSize 条
+//     → PetRepo.UpdateStatusBatch() 一个事务一批，只写轻量状态列
+//     → 只为实际写入且新跨过阈值的宠物发布 PetStatusWarningEvent
+//     → 只为实际写入且本次生病的宠物发布 PetFellIllEvent
+// ============================================================
+
+// runStatusCollector 宠物状态计算任务
+func (s *Scheduler) runStatusCollector() {
+	ticker := time.NewTicker(s.statusFlush.ScanInterval)
 	defer ticker.Stop()
 
 	for {
@@ -54,86 +155,305 @@ func (s *Scheduler) runPetStatusDecay() {
 		case <-s.stopCh:
 			return
 		case <-ticker.C:
-			s.decayAllPetStatus()
+			s.collectPetStatus()
 		}
 	}
 }
 
-// decayAllPetStatus 衰减所有宠物状态
-func (s *Scheduler) decayAllPetStatus() {
+// collectPetStatus 计算所有宠物的当前状态并写入缓冲区
+func (s *Scheduler) collectPetStatus() {
 	ctx := context.Background()
+	batchSize := s.statusFlush.BatchSize
+	now := time.Now()
 
-	// 获取总数
-	total, err := s.petRepo.CountAll(ctx)
-	if err != nil {
-		log.Printf("Failed to count pets: %v", err)
-		return
+	total := 0
+	afterID := 0
+	for {
+		select {
+		case <-s.stopCh:
+			return
+		default:
+		}
+
+		pets, err := s.petRepo.FindAfterID(ctx, afterID, batchSize)
+		if err != nil {
+			log.Printf("Failed to find pets after %d: %v", afterID, err)
+			return
+		}
+		if len(pets) == 0 {
+			break
+		}
+		afterID = pets[len(pets)-1].ID
+
+		snapshots := make([]pet.StatusSnapshot, 0, len(pets))
+		for _, p := range pets {
+			if snapshot, changed := p.SnapshotAt(now); changed {
+				snapshots = append(snapshots, snapshot)
+			}
+		}
+		if len(snapshots) > 0 {
+			size, err := s.statusBuffer.Push(ctx, snapshots)
+			if err != nil {
+				log.Printf("Failed to buffer pet status: %v", err)
+				return
+			}
+			total += len(snapshots)
+
+			if size >= batchSize {
+				s.triggerFlush()
+			}
+		}
+
+		if len(pets) < batchSize {
+			break
+		}
 	}
 
-	log.Printf("Starting pet status decay for %d pets", total)
+	if total > 0 {
+		log.Printf("Pet status collected: %d pets buffered", total)
+	}
+}
+
+// triggerFlush 通知落库任务立即执行（已有待处理的触发时忽略）
+func (s *Scheduler) triggerFlush() {
+	select {
+	case s.flushCh <- struct{}{}:
+	default:
+	}
+}
 
-	// 分批处理
-	batchSize := 100
-	for offset := 0; offset < int(total); offset += batchSize {
-		pets, err := s.petRepo.FindAll(ctx, offset, batchSize)
+// runStatusFlusher 状态缓冲区落库任务
+func (s *Scheduler) runStatusFlusher() {
+	ticker := time.NewTicker(s.statusFlush.FlushInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-s.stopCh:
+			// 退出前尽量写完缓冲区
+			s.flushPetStatus()
+			return
+		case <-ticker.C:
+			s.flushPetStatus()
+		case <-s.flushCh:
+			s.flushPetStatus()
+			ticker.Reset(s.statusFlush.FlushInterval)
+		}
+	}
+}
+
+// flushPetStatus 分批取出缓冲区中的快照并落库
+func (s *Scheduler) flushPetStatus() {
+	ctx := context.Background()
+	batchSize := s.statusFlush.BatchSize
+
+	written, skipped := 0, 0
+	for {
+		snapshots, err := s.statusBuffer.Pop(ctx, batchSize)
+		if err != nil {
+			log.Printf("Failed to read pet status buffer: %v", err)
+			return
+		}
+		if len(snapshots) == 0 {
+			break
+		}
+
+		var updatedIDs []int
+		err = s.uow.Do(ctx, func(txCtx context.Context) error {
+			var err error
+			updatedIDs, err = s.petRepo.UpdateStatusBatch(txCtx, snapshots)
+			return err
+		})
 		if err != nil {
-			log.Printf("Failed to find pets: %v", err)
+			// 丢弃本批，下一轮计算会重新生成
+			log.Printf("Failed to flush %d pet status: %v", len(snapshots), err)
+			return
+		}
+
+		written += len(updatedIDs)
+		skipped += len(snapshots) - len(updatedIDs)
+		s.publishStatusWarnings(ctx, snapshots, updatedIDs)
+
+		if len(snapshots) < batchSize {
+			break
+		}
+	}
+
+	if written > 0 || skipped > 0 {
+		log.Printf("Pet status flushed: %d written, %d skipped (revision changed)", written, skipped)
+	}
+}
+
+// publishStatusWarnings 为实际写入的宠物发布新跨过阈值的警告、生病和情绪变化事件
+func (s *Scheduler) publishStatusWarnings(ctx context.Context, snapshots []pet.StatusSnapshot, updatedIDs []int) {
+	if s.publisher == nil || len(updatedIDs) == 0 {
+		return
+	}
+
+	updated := make(map[int]bool, len(updatedIDs))
+	for _, id := range updatedIDs {
+		updated[id] = true
+	}
+
+	for _, snapshot := range snapshots {
+		if !updated[snapshot.PetID] {
 			continue
 		}
+		for _, e := range snapshot.WarningEvents() {
+			_ = s.publisher.Publish(ctx, e)
+		}
+		if e, ok := snapshot.FellIllEvent(); ok {
+			_ = s.publisher.Publish(ctx, e)
+		}
+		if e, ok := snapshot.MoodChangedEvent(); ok {
+			_ = s.publisher.Publish(ctx, e)
+		}
+	}
+}
 
-		for _, p := range pets {
-			s.decayPetStatus(ctx, p)
+// runTradeExpirySweep 过期交易扫描任务
+// 按配置的间隔执行，可在多个实例上同时运行
+func (s *Scheduler) runTradeExpirySweep() {
+	ticker := time.NewTicker(s.tradeSweep.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-s.stopCh:
+			return
+		case <-ticker.C:
+			s.expireStaleTrades()
+		}
+	}
+}
+
+// expireStaleTrades 分批处理过期交易并通知发起方
+func (s *Scheduler) expireStaleTrades() {
+	ctx := context.Background()
+
+	batchSize := s.tradeSweep.BatchSize
+
+	total := 0
+	for {
+		select {
+		case <-s.stopCh:
+			return
+		default:
+		}
+
+		trades, err := s.tradeSweeper.ExpireStaleTrades(ctx, batchSize)
+		if err != nil {
+			log.Printf("Failed to expire trades: %v", err)
+			return
+		}
+
+		for _, t := range trades {
+			s.notifyTradeExpired(t)
+		}
+		total += len(trades)
+
+		if len(trades) < batchSize {
+			break
 		}
 	}
 
-	log.Println("Pet status decay completed")
+	if total > 0 {
+		log.Printf("Trade expiry sweep completed: %d trades expired", total)
+	}
+}
+
+// notifyTradeExpired 通知发起方交易已过期、道具已退还
+// 只能通知连接在本实例上的用户，其他实例通过事件总线获知
+func (s *Scheduler) notifyTradeExpired(t *social.Trade) {
+	if s.notifier == nil {
+		return
+	}
+	s.notifier.SendToUser(t.FromUserID, "trade_expired", map[string]interface{}{
+		"tradeId":       t.ID,
+		"toUserId":      t.ToUserID,
+		"offerItemId":   t.OfferItemID,
+		"offerQuantity": t.OfferQuantity,
+		"refunded":      true,
+	})
 }
 
-// decayPetStatus 衰减单个宠物状态
-func (s *Scheduler) decayPetStatus(ctx context.Context, p *pet.Pet) {
-	err := s.uow.Do(ctx, func(txCtx context.Context) error {
-		now := time.Now()
-		// 衰减1小时的状态
-		p.DecayStatus(1.0)
-		p.StatusUpdatedAt = now
-		p.Revision++
+// runLifespanSweep 寿终宠物扫描任务
+// 按配置的间隔执行，可在多个实例上同时运行
+func (s *Scheduler) runLifespanSweep() {
+	ticker := time.NewTicker(s.lifespanSweep.Interval)
+	defer ticker.Stop()
 
-		// 检查是否需要发送警告
-		var events []shared.Event
-		if p.IsHungry() {
-			events = append(events, pet.PetStatusWarningEvent{
-				PetID:       p.ID,
-				UserID:      p.UserID,
-				WarningType: "hungry",
-				Timestamp:   now,
-			})
+	for {
+		select {
+		case <-s.stopCh:
+			return
+		case <-ticker.C:
+			s.passOnExpiredPets()
 		}
-		if p.IsUnhappy() {
-			events = append(events, pet.PetStatusWarningEvent{
-				PetID:       p.ID,
-				UserID:      p.UserID,
-				WarningType: "unhappy",
-				Timestamp:   now,
-			})
+	}
+}
+
+// passOnExpiredPets 分批处理寿命已尽的宠物并通知主人
+func (s *Scheduler) passOnExpiredPets() {
+	ctx := context.Background()
+
+	batchSize := s.lifespanSweep.BatchSize
+
+	total, failed := 0, 0
+	afterID := 0
+	for {
+		select {
+		case <-s.stopCh:
+			return
+		default:
 		}
 
-		// 保存
-		if err := s.petRepo.Save(txCtx, p); err != nil {
-			return err
+		ids, err := s.lifespanSweeper.ExpiredPetIDs(ctx, afterID, batchSize)
+		if err != nil {
+			log.Printf("Failed to find expired pets after %d: %v", afterID, err)
+			return
 		}
+		if len(ids) == 0 {
+			break
+		}
+		afterID = ids[len(ids)-1]
 
-		// 发布事件
-		if s.publisher != nil && len(events) > 0 {
-			for _, e := range events {
-				_ = s.publisher.Publish(txCtx, e)
+		// 逐只处理，失败的宠物记录日志后跳过，下一轮扫描会再次尝试
+		for _, id := range ids {
+			m, err := s.lifespanSweeper.PassOnPet(ctx, id)
+			if err != nil {
+				log.Printf("Failed to pass on pet %d: %v", id, err)
+				failed++
+				continue
+			}
+			if m == nil {
+				continue
 			}
+			s.notifyPetPassedOn(m)
+			total++
 		}
 
-		return nil
-	})
+		if len(ids) < batchSize {
+			break
+		}
+	}
 
-	if err != nil {
-		log.Printf("Failed to decay pet %d status: %v", p.ID, err)
+	if total > 0 || failed > 0 {
+		log.Printf("Lifespan sweep completed: %d pets passed on, %d failed", total, failed)
 	}
 }
 
+// notifyPetPassedOn 通知主人宠物已寿终、纪念品已发放
+// 只能通知连接在本实例上的用户，其他实例通过事件总线获知
+func (s *Scheduler) notifyPetPassedOn(m *pet.Memorial) {
+	if s.notifier == nil {
+		return
+	}
+	s.notifier.SendToUser(m.UserID, "pet_passed_on", map[string]interface{}{
+		"petId":          m.PetID,
+		"memorialId":     m.ID,
+		"name":           m.Name,
+		"lifeDays":       m.LifeDays(),
+		"keepsakeItemId": s.lifespanSweeper.KeepsakeItemID(),
+	})
+}
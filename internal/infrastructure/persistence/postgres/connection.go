@@ -56,6 +56,11 @@ func AutoMigrate(db *gorm.DB) error {
 	return db.AutoMigrate(
 		&model.User{},
 		&model.Pet{},
+		&model.BreedingContract{},
+		&model.AdoptionListing{},
+		&model.PetOwnership{},
+		&model.PetMemorial{},
+		&model.PetRandomEvent{},
 		&model.SkillDefinition{},
 		&model.ItemDefinition{},
 		&model.UserItem{},
@@ -66,5 +71,6 @@ func AutoMigrate(db *gorm.DB) error {
 		&model.AchievementDefinition{},
 		&model.UserAchievement{},
 		&model.VisitRecord{},
+		&model.InteractionRecord{},
 	)
 }
@@ -7,10 +7,12 @@ type ItemDefinition struct {
 	Name        string `gorm:"type:varchar(32);not null;comment:道具名称"`
 	Description string `gorm:"type:text;comment:道具描述"`
 	ItemType    int16  `gorm:"column:item_type;comment:道具类型(1食物2清洁3玩具4装饰5特殊)"` // 1食物 2清洁 3玩具 4装饰 5特殊
-	EffectType  string `gorm:"column:effect_type;type:varchar(32);comment:效果类型"`
+	EffectType  string `gorm:"column:effect_type;type:varchar(128);comment:效果类型(多个效果用逗号分隔)"`
 	EffectValue int    `gorm:"column:effect_value;comment:效果数值"`
 	Price       int    `gorm:"default:0;comment:价格"`
+	Currency    int16  `gorm:"default:0;comment:购买货币(0金币1钻石)"`      // 0金币 1钻石
 	Rarity      int16  `gorm:"default:1;comment:稀有度(1普通2稀有3史诗4传说)"` // 1普通 2稀有 3史诗 4传说
+	Slot        string `gorm:"type:varchar(16);comment:装饰品槽位(head/body/accessory)"`
 }
 
 // TableName 表名
@@ -43,9 +45,9 @@ func (UserItem) BeforeCreate(tx interface{}) error {
 // PetDecoration 宠物装饰 (穿戴中的装饰)
 type PetDecoration struct {
 	BaseModel
-	PetID  int    `gorm:"index;not null;comment:宠物ID"`
+	PetID  int    `gorm:"index;uniqueIndex:idx_pet_decoration_slot;not null;comment:宠物ID"`
 	ItemID int    `gorm:"column:item_id;not null;comment:道具ID"`
-	Slot   string `gorm:"type:varchar(16);comment:装备槽位(head/body/accessory)"` // head, body, accessory
+	Slot   string `gorm:"type:varchar(16);uniqueIndex:idx_pet_decoration_slot;comment:装备槽位(head/body/accessory)"` // head, body, accessory，同一宠物每个槽位一件
 }
 
 // TableName 表名
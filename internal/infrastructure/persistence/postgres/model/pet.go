@@ -41,6 +41,7 @@ type Pet struct {
 	// 技能
 	SkillID          int   `gorm:"column:skill_id;comment:主技能ID"`
 	SkillLevel       int16 `gorm:"column:skill_level;default:1;comment:技能等级"`
+	SkillExp         int   `gorm:"column:skill_exp;default:0;comment:技能经验"`
 	SkillStrength    int16 `gorm:"column:skill_strength;comment:技能强度"`
 	SkillSecondaryID int   `gorm:"column:skill_secondary_id;comment:副技能ID"`
 
@@ -49,12 +50,39 @@ type Pet struct {
 	Exp   int   `gorm:"default:0;comment:经验值"`
 	Level int   `gorm:"default:1;comment:等级"`
 
+	// 进化形态与养育记录
+	EvolutionForm string `gorm:"column:evolution_form;type:varchar(32);comment:进化形态ID"`
+	CareHistory   string `gorm:"column:care_history;type:jsonb;comment:养育记录(JSON)"`
+
+	// 孵化
+	HatchAt      *time.Time `gorm:"column:hatch_at;comment:孵化时间(蛋为预计时间)"`
+	LastWarmedAt time.Time  `gorm:"column:last_warmed_at;comment:最后保温时间"`
+
+	// 寿命
+	LifespanHours int        `gorm:"column:lifespan_hours;default:0;comment:老年期寿命(小时)"`
+	PassesAt      *time.Time `gorm:"column:passes_at;index;comment:寿终时间"`
+
 	// 实时状态 (0-100)
 	Hunger      int16 `gorm:"default:50;comment:饥饿度(0-100)"`
 	Happiness   int16 `gorm:"default:50;comment:快乐度(0-100)"`
 	Cleanliness int16 `gorm:"default:50;comment:清洁度(0-100)"`
 	Energy      int16 `gorm:"default:100;comment:能量值(0-100)"`
 
+	// 健康
+	Illness         int16      `gorm:"column:illness;default:0;comment:疾病(0健康1感冒2营养不良)"`
+	IllAt           *time.Time `gorm:"column:ill_at;comment:生病时间"`
+	HealthCheckedAt time.Time  `gorm:"column:health_checked_at;comment:上次健康检查时间"`
+
+	// 情绪
+	Mood     string `gorm:"column:mood;type:varchar(16);default:'content';comment:情绪"`
+	MoodCalm int16  `gorm:"column:mood_calm;default:0;comment:闹情绪时还需安抚的次数"`
+
+	// 装饰品衰减减免 (JSON存储)
+	DecayBonus string `gorm:"column:decay_bonus;type:jsonb;comment:装饰品衰减减免(JSON)"`
+
+	// 宠物栏排序
+	RosterOrder int `gorm:"column:roster_order;default:0;comment:宠物栏排序(0表示未排序)"`
+
 	// 繁衍相关
 	Parent1ID   *int       `gorm:"column:parent1_id;index;comment:父方ID"` // 父方ID (可为空)
 	Parent2ID   *int       `gorm:"column:parent2_id;index;comment:母方ID"` // 母方ID (可为空)
@@ -122,3 +150,108 @@ type SkillDefinition struct {
 func (SkillDefinition) TableName() string {
 	return "skill_definitions"
 }
+
+// BreedingContract 繁殖契约表
+type BreedingContract struct {
+	BaseModel
+	ProposerID        int        `gorm:"column:proposer_id;index;not null;comment:发起方用户ID"`
+	ProposerPetID     int        `gorm:"column:proposer_pet_id;index;not null;comment:发起方宠物ID"`
+	PartnerID         int        `gorm:"column:partner_id;index;not null;comment:接受方用户ID"`
+	PartnerPetID      int        `gorm:"column:partner_pet_id;index;not null;comment:接受方宠物ID"`
+	Assignment        int16      `gorm:"column:assignment;default:0;comment:后代归属(0发起方1接受方2双方各一只)"` // 0发起方 1接受方 2双方各一只
+	ProposerChildName string     `gorm:"column:proposer_child_name;type:varchar(32);comment:发起方取的后代名称"`
+	PartnerChildName  string     `gorm:"column:partner_child_name;type:varchar(32);comment:接受方取的后代名称"`
+	Status            int16      `gorm:"default:0;index;comment:状态(0待确认1已接受2已拒绝3已过期)"` // 0待确认 1已接受 2已拒绝 3已过期
+	OffspringIDs      string     `gorm:"column:offspring_ids;type:jsonb;comment:后代ID列表(JSON数组)"`
+	ExpiresAt         time.Time  `gorm:"column:expires_at;index;comment:过期时间"`
+	RespondedAt       *time.Time `gorm:"column:responded_at;comment:处理时间"`
+}
+
+// TableName 表名
+func (BreedingContract) TableName() string {
+	return "breeding_contracts"
+}
+
+// AdoptionListing 领养挂单表
+type AdoptionListing struct {
+	BaseModel
+	PetID       int        `gorm:"column:pet_id;index;not null;comment:宠物ID"`
+	SellerID    int        `gorm:"column:seller_id;index;not null;comment:原主人用户ID"`
+	Currency    int16      `gorm:"column:currency;default:0;comment:标价货币(0金币1钻石2赠送)"` // 0金币 1钻石 2赠送
+	Price       int        `gorm:"column:price;default:0;comment:标价"`
+	RecipientID *int       `gorm:"column:recipient_id;index;comment:指定领养人ID(赠送)"`
+	BuyerID     *int       `gorm:"column:buyer_id;comment:领养人ID"`
+	Status      int16      `gorm:"default:0;index;comment:状态(0挂单中1已领养2已撤回)"` // 0挂单中 1已领养 2已撤回
+	CompletedAt *time.Time `gorm:"column:completed_at;comment:完成时间"`
+}
+
+// TableName 表名
+func (AdoptionListing) TableName() string {
+	return "adoption_listings"
+}
+
+// PetOwnership 宠物易主记录表
+type PetOwnership struct {
+	BaseModel
+	PetID         int       `gorm:"column:pet_id;index;not null;comment:宠物ID"`
+	FromUserID    int       `gorm:"column:from_user_id;index;not null;comment:原主人用户ID"`
+	ToUserID      int       `gorm:"column:to_user_id;index;not null;comment:新主人用户ID"`
+	Method        int16     `gorm:"column:method;default:0;comment:易主方式(0出售1赠送)"` // 0出售 1赠送
+	Currency      int16     `gorm:"column:currency;default:0;comment:成交货币(0金币1钻石2赠送)"`
+	Price         int       `gorm:"column:price;default:0;comment:成交价格"`
+	ListingID     int       `gorm:"column:listing_id;comment:领养挂单ID"`
+	TransferredAt time.Time `gorm:"column:transferred_at;comment:易主时间"`
+}
+
+// TableName 表名
+func (PetOwnership) TableName() string {
+	return "pet_ownerships"
+}
+
+// PetMemorial 宠物纪念记录表
+type PetMemorial struct {
+	BaseModel
+	PetID        int        `gorm:"column:pet_id;uniqueIndex;not null;comment:原宠物ID"`
+	UserID       int        `gorm:"column:user_id;index;not null;comment:离开时的主人用户ID"`
+	Name         string     `gorm:"type:varchar(32);not null;comment:宠物名称"`
+	Cause        string     `gorm:"column:cause;type:varchar(16);default:'passed_on';comment:离开原因(passed_on寿终released放生)"`
+	SpeciesID    int        `gorm:"column:species_id;comment:物种ID"`
+	Gender       int16      `gorm:"column:gender;comment:性别"`
+	GeneCode     string     `gorm:"column:gene_code;type:varchar(40);comment:基因编码"`
+	Stage        int16      `gorm:"comment:成长阶段"`
+	Level        int        `gorm:"comment:等级"`
+	SkillID      int        `gorm:"column:skill_id;comment:主技能ID"`
+	SkillLevel   int16      `gorm:"column:skill_level;comment:技能等级"`
+	Parent1ID    *int       `gorm:"column:parent1_id;index;comment:父方ID"`
+	Parent2ID    *int       `gorm:"column:parent2_id;index;comment:母方ID"`
+	Generation   int        `gorm:"column:generation;comment:代数"`
+	Achievements string     `gorm:"column:achievements;type:jsonb;comment:生平成就(JSON数组)"`
+	BornAt       time.Time  `gorm:"column:born_at;comment:出生时间"`
+	HatchAt      *time.Time `gorm:"column:hatch_at;comment:孵化时间"`
+	PassedAt     time.Time  `gorm:"column:passed_at;index;comment:离世时间"`
+}
+
+// TableName 表名
+func (PetMemorial) TableName() string {
+	return "pet_memorials"
+}
+
+// PetRandomEvent 宠物随机事件记录表
+type PetRandomEvent struct {
+	BaseModel
+	PetID      int       `gorm:"column:pet_id;index:idx_random_event_pet;not null;comment:宠物ID"`
+	UserID     int       `gorm:"column:user_id;index;not null;comment:触发时的主人用户ID"`
+	EventID    string    `gorm:"column:event_id;type:varchar(32);index:idx_random_event_pet;not null;comment:随机事件配置ID"`
+	Name       string    `gorm:"type:varchar(32);comment:事件名称"`
+	Message    string    `gorm:"type:varchar(128);comment:事件描述"`
+	Effect     string    `gorm:"type:varchar(16);comment:效果类型(coins/item/status)"`
+	ItemID     int       `gorm:"column:item_id;comment:道具ID"`
+	Status     string    `gorm:"type:varchar(16);comment:状态效果的目标状态"`
+	Amount     int       `gorm:"comment:金币数/道具数/状态变化量"`
+	OccurredAt time.Time `gorm:"column:occurred_at;index;comment:触发时间"`
+}
+
+// TableName 表名
+func (PetRandomEvent) TableName() string {
+	return "pet_random_events"
+}
@@ -63,3 +63,18 @@ type VisitRecord struct {
 func (VisitRecord) TableName() string {
 	return "visit_records"
 }
+
+// InteractionRecord 好友互动记录表
+type InteractionRecord struct {
+	BaseModel
+	ActorID  int    `gorm:"column:actor_id;index;not null;comment:照顾者用户ID"`
+	OwnerID  int    `gorm:"column:owner_id;index;not null;comment:宠物主人用户ID"`
+	PetID    int    `gorm:"column:pet_id;index;not null;comment:宠物ID"`
+	Action   string `gorm:"column:action;type:varchar(16);not null;comment:动作(feed/play/clean)"`
+	Intimacy int    `gorm:"column:intimacy;default:0;comment:增加的亲密度"`
+}
+
+// TableName 表名
+func (InteractionRecord) TableName() string {
+	return "interaction_records"
+}
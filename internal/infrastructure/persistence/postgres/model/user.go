@@ -15,6 +15,7 @@ type User struct {
 	Coins       int       `gorm:"default:0;comment:金币数量"`
 	Diamonds    int       `gorm:"default:0;comment:钻石数量"`
 	ActivePetID *int      `gorm:"column:active_pet_id;index;comment:当前主宠物ID"`
+	PetSlots    int       `gorm:"column:pet_slots;default:0;comment:已扩充的宠物栏位数"`
 	LastLoginAt time.Time `gorm:"column:last_login_at;comment:最后登录时间"`
 }
 
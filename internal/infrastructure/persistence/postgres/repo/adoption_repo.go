@@ -0,0 +1,207 @@
+// Package repo 仓储实现
+package repo
+
+import (
+	"context"
+	"errors"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"pets-server/internal/domain/pet"
+	"pets-server/internal/infrastructure/persistence/postgres"
+	"pets-server/internal/infrastructure/persistence/postgres/model"
+)
+
+// AdoptionRepository 领养挂单仓储实现
+type AdoptionRepository struct {
+	db *gorm.DB
+}
+
+// NewAdoptionRepository 创建领养挂单仓储
+func NewAdoptionRepository(db *gorm.DB) *AdoptionRepository {
+	return &AdoptionRepository{db: db}
+}
+
+// FindByID 根据ID查找挂单
+func (r *AdoptionRepository) FindByID(ctx context.Context, id int) (*pet.AdoptionListing, error) {
+	db := postgres.GetTx(ctx, r.db)
+
+	var m model.AdoptionListing
+	if err := db.First(&m, id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, pet.ErrAdoptionNotFound
+		}
+		return nil, err
+	}
+
+	return r.toDomain(&m), nil
+}
+
+// FindByIDForUpdate 根据ID查找并锁定挂单（SELECT ... FOR UPDATE）
+func (r *AdoptionRepository) FindByIDForUpdate(ctx context.Context, id int) (*pet.AdoptionListing, error) {
+	db := postgres.GetTx(ctx, r.db)
+
+	var m model.AdoptionListing
+	if err := db.Clauses(clause.Locking{Strength: "UPDATE"}).First(&m, id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, pet.ErrAdoptionNotFound
+		}
+		return nil, err
+	}
+
+	return r.toDomain(&m), nil
+}
+
+// FindOpenByPet 获取宠物当前的挂单
+func (r *AdoptionRepository) FindOpenByPet(ctx context.Context, petID int) (*pet.AdoptionListing, error) {
+	db := postgres.GetTx(ctx, r.db)
+
+	var m model.AdoptionListing
+	if err := db.Where("pet_id = ? AND status = ?", petID, pet.AdoptionStatusOpen).
+		First(&m).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, pet.ErrAdoptionNotFound
+		}
+		return nil, err
+	}
+
+	return r.toDomain(&m), nil
+}
+
+// FindOpenForUser 获取用户可领养的挂单（公开出售及赠送给该用户的）
+func (r *AdoptionRepository) FindOpenForUser(ctx context.Context, userID, offset, limit int) ([]*pet.AdoptionListing, error) {
+	db := postgres.GetTx(ctx, r.db)
+
+	var models []model.AdoptionListing
+	if err := db.Where("status = ? AND seller_id <> ? AND (recipient_id IS NULL OR recipient_id = ?)",
+		pet.AdoptionStatusOpen, userID, userID).
+		Order("created_at DESC").Offset(offset).Limit(limit).Find(&models).Error; err != nil {
+		return nil, err
+	}
+
+	return r.toDomainList(models), nil
+}
+
+// FindBySeller 获取用户发布的挂单
+func (r *AdoptionRepository) FindBySeller(ctx context.Context, sellerID int) ([]*pet.AdoptionListing, error) {
+	db := postgres.GetTx(ctx, r.db)
+
+	var models []model.AdoptionListing
+	if err := db.Where("seller_id = ?", sellerID).
+		Order("created_at DESC").Find(&models).Error; err != nil {
+		return nil, err
+	}
+
+	return r.toDomainList(models), nil
+}
+
+// Save 保存挂单
+func (r *AdoptionRepository) Save(ctx context.Context, l *pet.AdoptionListing) error {
+	db := postgres.GetTx(ctx, r.db)
+
+	m := &model.AdoptionListing{
+		PetID:       l.PetID,
+		SellerID:    l.SellerID,
+		Currency:    int16(l.Currency),
+		Price:       l.Price,
+		RecipientID: l.RecipientID,
+		BuyerID:     l.BuyerID,
+		Status:      int16(l.Status),
+		CompletedAt: l.CompletedAt,
+	}
+	m.ID = l.ID
+	m.CreatedAt = l.CreatedAt
+
+	if err := db.Save(m).Error; err != nil {
+		return err
+	}
+
+	l.ID = m.ID
+	return nil
+}
+
+func (r *AdoptionRepository) toDomainList(models []model.AdoptionListing) []*pet.AdoptionListing {
+	listings := make([]*pet.AdoptionListing, len(models))
+	for i, m := range models {
+		listings[i] = r.toDomain(&m)
+	}
+	return listings
+}
+
+func (r *AdoptionRepository) toDomain(m *model.AdoptionListing) *pet.AdoptionListing {
+	return &pet.AdoptionListing{
+		ID:          m.ID,
+		PetID:       m.PetID,
+		SellerID:    m.SellerID,
+		Currency:    pet.AdoptionCurrency(m.Currency),
+		Price:       m.Price,
+		RecipientID: m.RecipientID,
+		BuyerID:     m.BuyerID,
+		Status:      pet.AdoptionStatus(m.Status),
+		CreatedAt:   m.CreatedAt,
+		CompletedAt: m.CompletedAt,
+	}
+}
+
+// OwnershipRepository 宠物易主记录仓储实现
+type OwnershipRepository struct {
+	db *gorm.DB
+}
+
+// NewOwnershipRepository 创建宠物易主记录仓储
+func NewOwnershipRepository(db *gorm.DB) *OwnershipRepository {
+	return &OwnershipRepository{db: db}
+}
+
+// FindByPetID 获取宠物的易主记录（按时间先后）
+func (r *OwnershipRepository) FindByPetID(ctx context.Context, petID int) ([]pet.OwnershipRecord, error) {
+	db := postgres.GetTx(ctx, r.db)
+
+	var models []model.PetOwnership
+	if err := db.Where("pet_id = ?", petID).
+		Order("transferred_at ASC, id ASC").Find(&models).Error; err != nil {
+		return nil, err
+	}
+
+	records := make([]pet.OwnershipRecord, len(models))
+	for i, m := range models {
+		records[i] = pet.OwnershipRecord{
+			ID:            m.ID,
+			PetID:         m.PetID,
+			FromUserID:    m.FromUserID,
+			ToUserID:      m.ToUserID,
+			Method:        pet.TransferMethod(m.Method),
+			Currency:      pet.AdoptionCurrency(m.Currency),
+			Price:         m.Price,
+			ListingID:     m.ListingID,
+			TransferredAt: m.TransferredAt,
+		}
+	}
+
+	return records, nil
+}
+
+// Save 保存易主记录
+func (r *OwnershipRepository) Save(ctx context.Context, record *pet.OwnershipRecord) error {
+	db := postgres.GetTx(ctx, r.db)
+
+	m := &model.PetOwnership{
+		PetID:         record.PetID,
+		FromUserID:    record.FromUserID,
+		ToUserID:      record.ToUserID,
+		Method:        int16(record.Method),
+		Currency:      int16(record.Currency),
+		Price:         record.Price,
+		ListingID:     record.ListingID,
+		TransferredAt: record.TransferredAt,
+	}
+	m.ID = record.ID
+
+	if err := db.Save(m).Error; err != nil {
+		return err
+	}
+
+	record.ID = m.ID
+	return nil
+}
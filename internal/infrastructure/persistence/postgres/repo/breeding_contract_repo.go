@@ -0,0 +1,143 @@
+// Package repo 仓储实现
+package repo
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"pets-server/internal/domain/pet"
+	"pets-server/internal/infrastructure/persistence/postgres"
+	"pets-server/internal/infrastructure/persistence/postgres/model"
+)
+
+// BreedingContractRepository 繁殖契约仓储实现
+type BreedingContractRepository struct {
+	db *gorm.DB
+}
+
+// NewBreedingContractRepository 创建繁殖契约仓储
+func NewBreedingContractRepository(db *gorm.DB) *BreedingContractRepository {
+	return &BreedingContractRepository{db: db}
+}
+
+// FindByID 根据ID查找契约
+func (r *BreedingContractRepository) FindByID(ctx context.Context, id int) (*pet.BreedingContract, error) {
+	db := postgres.GetTx(ctx, r.db)
+
+	var m model.BreedingContract
+	if err := db.First(&m, id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, pet.ErrContractNotFound
+		}
+		return nil, err
+	}
+
+	return r.toDomain(&m), nil
+}
+
+// FindByIDForUpdate 根据ID查找并锁定契约（SELECT ... FOR UPDATE）
+func (r *BreedingContractRepository) FindByIDForUpdate(ctx context.Context, id int) (*pet.BreedingContract, error) {
+	db := postgres.GetTx(ctx, r.db)
+
+	var m model.BreedingContract
+	if err := db.Clauses(clause.Locking{Strength: "UPDATE"}).First(&m, id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, pet.ErrContractNotFound
+		}
+		return nil, err
+	}
+
+	return r.toDomain(&m), nil
+}
+
+// FindByUser 获取用户相关的契约（发起或接收）
+func (r *BreedingContractRepository) FindByUser(ctx context.Context, userID int) ([]*pet.BreedingContract, error) {
+	db := postgres.GetTx(ctx, r.db)
+
+	var models []model.BreedingContract
+	if err := db.Where("proposer_id = ? OR partner_id = ?", userID, userID).
+		Order("created_at DESC").Find(&models).Error; err != nil {
+		return nil, err
+	}
+
+	contracts := make([]*pet.BreedingContract, len(models))
+	for i, m := range models {
+		contracts[i] = r.toDomain(&m)
+	}
+
+	return contracts, nil
+}
+
+// FindPendingByPet 获取宠物参与的待处理契约
+func (r *BreedingContractRepository) FindPendingByPet(ctx context.Context, petID int) ([]*pet.BreedingContract, error) {
+	db := postgres.GetTx(ctx, r.db)
+
+	var models []model.BreedingContract
+	if err := db.Where("(proposer_pet_id = ? OR partner_pet_id = ?) AND status = ?",
+		petID, petID, pet.ContractStatusPending).Find(&models).Error; err != nil {
+		return nil, err
+	}
+
+	contracts := make([]*pet.BreedingContract, len(models))
+	for i, m := range models {
+		contracts[i] = r.toDomain(&m)
+	}
+
+	return contracts, nil
+}
+
+// Save 保存契约
+func (r *BreedingContractRepository) Save(ctx context.Context, c *pet.BreedingContract) error {
+	db := postgres.GetTx(ctx, r.db)
+
+	offspringJSON, _ := json.Marshal(c.OffspringIDs)
+	m := &model.BreedingContract{
+		ProposerID:        c.ProposerID,
+		ProposerPetID:     c.ProposerPetID,
+		PartnerID:         c.PartnerID,
+		PartnerPetID:      c.PartnerPetID,
+		Assignment:        int16(c.Assignment),
+		ProposerChildName: c.ProposerChildName,
+		PartnerChildName:  c.PartnerChildName,
+		Status:            int16(c.Status),
+		OffspringIDs:      string(offspringJSON),
+		ExpiresAt:         c.ExpiresAt,
+		RespondedAt:       c.RespondedAt,
+	}
+	m.ID = c.ID
+	m.CreatedAt = c.CreatedAt
+
+	if err := db.Save(m).Error; err != nil {
+		return err
+	}
+
+	c.ID = m.ID
+	return nil
+}
+
+func (r *BreedingContractRepository) toDomain(m *model.BreedingContract) *pet.BreedingContract {
+	var offspringIDs []int
+	if m.OffspringIDs != "" {
+		json.Unmarshal([]byte(m.OffspringIDs), &offspringIDs)
+	}
+
+	return &pet.BreedingContract{
+		ID:                m.ID,
+		ProposerID:        m.ProposerID,
+		ProposerPetID:     m.ProposerPetID,
+		PartnerID:         m.PartnerID,
+		PartnerPetID:      m.PartnerPetID,
+		Assignment:        pet.OffspringAssignment(m.Assignment),
+		ProposerChildName: m.ProposerChildName,
+		PartnerChildName:  m.PartnerChildName,
+		Status:            pet.ContractStatus(m.Status),
+		OffspringIDs:      offspringIDs,
+		CreatedAt:         m.CreatedAt,
+		ExpiresAt:         m.ExpiresAt,
+		RespondedAt:       m.RespondedAt,
+	}
+}
@@ -0,0 +1,97 @@
+// Package repo 仓储实现
+package repo
+
+import (
+	"context"
+	"errors"
+
+	"gorm.io/gorm"
+
+	"pets-server/internal/domain/item"
+	"pets-server/internal/infrastructure/persistence/postgres"
+	"pets-server/internal/infrastructure/persistence/postgres/model"
+)
+
+// DecorationRepository 装饰仓储实现
+type DecorationRepository struct {
+	db *gorm.DB
+}
+
+// NewDecorationRepository 创建装饰仓储
+func NewDecorationRepository(db *gorm.DB) *DecorationRepository {
+	return &DecorationRepository{db: db}
+}
+
+// FindByPetID 获取宠物的所有装饰
+func (r *DecorationRepository) FindByPetID(ctx context.Context, petID int) ([]*item.PetDecoration, error) {
+	db := postgres.GetTx(ctx, r.db)
+
+	var models []model.PetDecoration
+	if err := db.Where("pet_id = ?", petID).Order("id ASC").Find(&models).Error; err != nil {
+		return nil, err
+	}
+
+	decorations := make([]*item.PetDecoration, len(models))
+	for i, m := range models {
+		decorations[i] = r.toDomain(&m)
+	}
+
+	return decorations, nil
+}
+
+// FindByPetAndSlot 获取宠物某槽位的装饰
+func (r *DecorationRepository) FindByPetAndSlot(ctx context.Context, petID int, slot string) (*item.PetDecoration, error) {
+	db := postgres.GetTx(ctx, r.db)
+
+	var m model.PetDecoration
+	if err := db.Where("pet_id = ? AND slot = ?", petID, slot).First(&m).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, item.ErrDecorationNotFound
+		}
+		return nil, err
+	}
+
+	return r.toDomain(&m), nil
+}
+
+// Save 保存装饰
+func (r *DecorationRepository) Save(ctx context.Context, d *item.PetDecoration) error {
+	db := postgres.GetTx(ctx, r.db)
+
+	m := r.toModel(d)
+	if err := db.Save(m).Error; err != nil {
+		return err
+	}
+
+	d.ID = m.ID
+	return nil
+}
+
+// Delete 删除装饰
+func (r *DecorationRepository) Delete(ctx context.Context, id int) error {
+	db := postgres.GetTx(ctx, r.db)
+	return db.Delete(&model.PetDecoration{}, id).Error
+}
+
+// --- 模型转换 ---
+
+func (r *DecorationRepository) toDomain(m *model.PetDecoration) *item.PetDecoration {
+	return &item.PetDecoration{
+		ID:         m.ID,
+		PetID:      m.PetID,
+		ItemID:     m.ItemID,
+		Slot:       m.Slot,
+		EquippedAt: m.CreatedAt,
+	}
+}
+
+func (r *DecorationRepository) toModel(d *item.PetDecoration) *model.PetDecoration {
+	m := &model.PetDecoration{
+		PetID:  d.PetID,
+		ItemID: d.ItemID,
+		Slot:   d.Slot,
+	}
+	m.ID = d.ID
+	m.CreatedAt = d.EquippedAt
+	return m
+}
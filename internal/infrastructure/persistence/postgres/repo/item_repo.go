@@ -6,6 +6,7 @@ import (
 	"errors"
 
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 
 	"pets-server/internal/domain/item"
 	"pets-server/internal/infrastructure/persistence/postgres"
@@ -52,6 +53,22 @@ func (r *ItemRepository) FindByUserAndItem(ctx context.Context, userID int, item
 	return r.toDomain(&m), nil
 }
 
+// FindByUserAndItemForUpdate 根据用户ID和道具ID查找并锁定（SELECT ... FOR UPDATE）
+func (r *ItemRepository) FindByUserAndItemForUpdate(ctx context.Context, userID int, itemID int) (*item.UserItem, error) {
+	db := postgres.GetTx(ctx, r.db)
+
+	var m model.UserItem
+	if err := db.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("user_id = ? AND item_id = ?", userID, itemID).First(&m).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, item.ErrItemNotFound
+		}
+		return nil, err
+	}
+
+	return r.toDomain(&m), nil
+}
+
 // FindByUserID 获取用户所有道具
 func (r *ItemRepository) FindByUserID(ctx context.Context, userID int) ([]*item.UserItem, error) {
 	db := postgres.GetTx(ctx, r.db)
@@ -119,16 +136,7 @@ func (r *ItemRepository) GetDefinition(ctx context.Context, itemID int) (*item.I
 		return nil, err
 	}
 
-	return &item.ItemDefinition{
-		ID:          int(m.ID),
-		Name:        m.Name,
-		Description: m.Description,
-		Type:        item.ItemType(m.ItemType),
-		EffectType:  m.EffectType,
-		EffectValue: m.EffectValue,
-		Price:       m.Price,
-		Rarity:      int(m.Rarity),
-	}, nil
+	return r.definitionToDomain(&m), nil
 }
 
 // GetAllDefinitions 获取所有道具定义
@@ -142,16 +150,7 @@ func (r *ItemRepository) GetAllDefinitions(ctx context.Context) ([]*item.ItemDef
 
 	defs := make([]*item.ItemDefinition, len(models))
 	for i, m := range models {
-		defs[i] = &item.ItemDefinition{
-			ID:          int(m.ID),
-			Name:        m.Name,
-			Description: m.Description,
-			Type:        item.ItemType(m.ItemType),
-			EffectType:  m.EffectType,
-			EffectValue: m.EffectValue,
-			Price:       m.Price,
-			Rarity:      int(m.Rarity),
-		}
+		defs[i] = r.definitionToDomain(&m)
 	}
 
 	return defs, nil
@@ -169,6 +168,21 @@ func (r *ItemRepository) toDomain(m *model.UserItem) *item.UserItem {
 	}
 }
 
+func (r *ItemRepository) definitionToDomain(m *model.ItemDefinition) *item.ItemDefinition {
+	return &item.ItemDefinition{
+		ID:          int(m.ID),
+		Name:        m.Name,
+		Description: m.Description,
+		Type:        item.ItemType(m.ItemType),
+		EffectType:  m.EffectType,
+		EffectValue: m.EffectValue,
+		Price:       m.Price,
+		Currency:    item.Currency(m.Currency),
+		Rarity:      int(m.Rarity),
+		Slot:        m.Slot,
+	}
+}
+
 func (r *ItemRepository) toModel(i *item.UserItem) *model.UserItem {
 	m := &model.UserItem{
 		UserID:   i.UserID,
@@ -0,0 +1,138 @@
+// Package repo 仓储实现
+package repo
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	"gorm.io/gorm"
+
+	"pets-server/internal/domain/pet"
+	"pets-server/internal/infrastructure/persistence/postgres"
+	"pets-server/internal/infrastructure/persistence/postgres/model"
+)
+
+// MemorialRepository 纪念记录仓储实现
+type MemorialRepository struct {
+	db *gorm.DB
+}
+
+// NewMemorialRepository 创建纪念记录仓储
+func NewMemorialRepository(db *gorm.DB) *MemorialRepository {
+	return &MemorialRepository{db: db}
+}
+
+// FindByUserID 获取用户的纪念记录（按离世时间倒序）
+func (r *MemorialRepository) FindByUserID(ctx context.Context, userID int) ([]*pet.Memorial, error) {
+	db := postgres.GetTx(ctx, r.db)
+
+	var models []model.PetMemorial
+	if err := db.Where("user_id = ?", userID).Order("passed_at DESC").Find(&models).Error; err != nil {
+		return nil, err
+	}
+
+	memorials := make([]*pet.Memorial, len(models))
+	for i, m := range models {
+		memorials[i] = r.toDomain(&m)
+	}
+
+	return memorials, nil
+}
+
+// FindByPetID 根据原宠物ID查找纪念记录
+func (r *MemorialRepository) FindByPetID(ctx context.Context, petID int) (*pet.Memorial, error) {
+	db := postgres.GetTx(ctx, r.db)
+
+	var m model.PetMemorial
+	if err := db.Where("pet_id = ?", petID).First(&m).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, pet.ErrMemorialNotFound
+		}
+		return nil, err
+	}
+
+	return r.toDomain(&m), nil
+}
+
+// FindByParent 根据父母ID查找已离开的子代
+func (r *MemorialRepository) FindByParent(ctx context.Context, parentID int) ([]*pet.Memorial, error) {
+	db := postgres.GetTx(ctx, r.db)
+
+	var models []model.PetMemorial
+	if err := db.Where("parent1_id = ? OR parent2_id = ?", parentID, parentID).Find(&models).Error; err != nil {
+		return nil, err
+	}
+
+	memorials := make([]*pet.Memorial, len(models))
+	for i, m := range models {
+		memorials[i] = r.toDomain(&m)
+	}
+
+	return memorials, nil
+}
+
+// Save 保存纪念记录
+func (r *MemorialRepository) Save(ctx context.Context, memorial *pet.Memorial) error {
+	db := postgres.GetTx(ctx, r.db)
+
+	achievementsJSON, _ := json.Marshal(memorial.Achievements)
+
+	m := &model.PetMemorial{
+		PetID:        memorial.PetID,
+		UserID:       memorial.UserID,
+		Name:         memorial.Name,
+		Cause:        string(memorial.Cause),
+		SpeciesID:    int(memorial.SpeciesID),
+		Gender:       int16(memorial.Gender),
+		GeneCode:     memorial.Gene.String(),
+		Stage:        int16(memorial.Stage),
+		Level:        memorial.Level,
+		SkillID:      int(memorial.SkillType),
+		SkillLevel:   int16(memorial.SkillLevel),
+		Parent1ID:    memorial.Parent1ID,
+		Parent2ID:    memorial.Parent2ID,
+		Generation:   memorial.Generation,
+		Achievements: string(achievementsJSON),
+		BornAt:       memorial.BornAt,
+		HatchAt:      memorial.HatchAt,
+		PassedAt:     memorial.PassedAt,
+	}
+	m.ID = memorial.ID
+
+	if err := db.Save(m).Error; err != nil {
+		return err
+	}
+
+	memorial.ID = m.ID
+	return nil
+}
+
+func (r *MemorialRepository) toDomain(m *model.PetMemorial) *pet.Memorial {
+	var achievements []string
+	if m.Achievements != "" {
+		_ = json.Unmarshal([]byte(m.Achievements), &achievements)
+	}
+
+	return &pet.Memorial{
+		ID:           m.ID,
+		PetID:        m.PetID,
+		UserID:       m.UserID,
+		Name:         m.Name,
+		Cause:        pet.MemorialCause(m.Cause),
+		SpeciesID:    pet.SpeciesID(m.SpeciesID),
+		Gender:       pet.Gender(m.Gender),
+		Gene:         pet.NewGene(m.GeneCode),
+		Stage:        pet.Stage(m.Stage),
+		Level:        m.Level,
+		SkillType:    pet.SkillType(m.SkillID),
+		SkillLevel:   int(m.SkillLevel),
+		Parent1ID:    m.Parent1ID,
+		Parent2ID:    m.Parent2ID,
+		Generation:   m.Generation,
+		Achievements: achievements,
+		BornAt:       m.BornAt,
+		HatchAt:      m.HatchAt,
+		PassedAt:     m.PassedAt,
+	}
+}
@@ -5,8 +5,12 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"strconv"
+	"strings"
+	"time"
 
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 
 	"pets-server/internal/domain/pet"
 	"pets-server/internal/infrastructure/persistence/postgres"
@@ -15,12 +19,13 @@ import (
 
 // PetRepository 宠物仓储实现
 type PetRepository struct {
-	db *gorm.DB
+	db              *gorm.DB
+	speciesRegistry *pet.SpeciesRegistry // 加载时注入物种成长曲线和进化形态
 }
 
 // NewPetRepository 创建宠物仓储
-func NewPetRepository(db *gorm.DB) *PetRepository {
-	return &PetRepository{db: db}
+func NewPetRepository(db *gorm.DB, speciesRegistry *pet.SpeciesRegistry) *PetRepository {
+	return &PetRepository{db: db, speciesRegistry: speciesRegistry}
 }
 
 // FindByID 根据ID查找宠物
@@ -54,11 +59,12 @@ func (r *PetRepository) FindByUserID(ctx context.Context, userID int) (*pet.Pet,
 }
 
 // FindByUserIDAll 根据用户ID查找所有宠物
+// 按宠物栏排序，未排序的宠物按创建先后排在最后
 func (r *PetRepository) FindByUserIDAll(ctx context.Context, userID int) ([]*pet.Pet, error) {
 	db := postgres.GetTx(ctx, r.db)
 
 	var models []model.Pet
-	if err := db.Where("user_id = ?", userID).Find(&models).Error; err != nil {
+	if err := db.Where("user_id = ?", userID).Order("roster_order = 0, roster_order, id").Find(&models).Error; err != nil {
 		return nil, err
 	}
 
@@ -70,17 +76,44 @@ func (r *PetRepository) FindByUserIDAll(ctx context.Context, userID int) ([]*pet
 	return pets, nil
 }
 
+// CountByUserID 统计用户拥有的宠物数量
+func (r *PetRepository) CountByUserID(ctx context.Context, userID int) (int, error) {
+	db := postgres.GetTx(ctx, r.db)
+
+	var count int64
+	if err := db.Model(&model.Pet{}).Where("user_id = ?", userID).Count(&count).Error; err != nil {
+		return 0, err
+	}
+
+	return int(count), nil
+}
+
 // Save 保存宠物
 func (r *PetRepository) Save(ctx context.Context, p *pet.Pet) error {
 	db := postgres.GetTx(ctx, r.db)
 
 	m := r.toModel(p)
-	if err := db.Save(m).Error; err != nil {
-		return err
+	if p.ID == 0 {
+		if err := db.Create(m).Error; err != nil {
+			return err
+		}
+		// 回写ID
+		p.ID = m.ID
+		return nil
 	}
 
-	// 回写ID
-	p.ID = m.ID
+	// 乐观锁：只有版本号与读取时一致才写入，并推进版本号
+	// 读取之后被其他写操作（包括状态批量落库）更新过的宠物返回 ErrPetModified
+	m.Revision = p.Revision + 1
+	result := db.Model(m).Where("revision = ?", p.Revision).Select("*").Updates(m)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return pet.ErrPetModified
+	}
+
+	p.Revision = m.Revision
 	return nil
 }
 
@@ -153,6 +186,132 @@ func (r *PetRepository) CountAll(ctx context.Context) (int, error) {
 	return int(count), nil
 }
 
+// GenePool 统计全部宠物在每个基因位上的取值分布
+// 用 generate_series 把基因码展开成 40 个基因位，在数据库中分组计数；长度不对的脏数据不参与统计
+func (r *PetRepository) GenePool(ctx context.Context) (*pet.GenePool, error) {
+	db := postgres.GetTx(ctx, r.db)
+
+	var total int64
+	if err := db.Model(&model.Pet{}).Where("length(gene_code) = ?", pet.GeneLength).Count(&total).Error; err != nil {
+		return nil, err
+	}
+
+	var rows []struct {
+		Pos   int
+		Value string
+		Count int
+	}
+	sql := `SELECT g.pos, lower(substr(p.gene_code, g.pos + 1, 1)) AS value, COUNT(*) AS count
+	FROM pets AS p CROSS JOIN generate_series(0, ?) AS g(pos)
+	WHERE length(p.gene_code) = ?
+	GROUP BY g.pos, value`
+	if err := db.Raw(sql, pet.GeneLength-1, pet.GeneLength).Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+
+	pool := pet.NewGenePool()
+	pool.Total = int(total)
+	for _, row := range rows {
+		value, err := strconv.ParseInt(row.Value, 16, 64)
+		if err != nil {
+			continue
+		}
+		pool.Add(row.Pos, int(value), row.Count)
+	}
+
+	return pool, nil
+}
+
+// FindAfterID 按ID游标分批查找宠物（ID升序）
+func (r *PetRepository) FindAfterID(ctx context.Context, afterID, limit int) ([]*pet.Pet, error) {
+	db := postgres.GetTx(ctx, r.db)
+
+	var models []model.Pet
+	if err := db.Where("id > ?", afterID).Order("id ASC").Limit(limit).Find(&models).Error; err != nil {
+		return nil, err
+	}
+
+	pets := make([]*pet.Pet, len(models))
+	for i, m := range models {
+		pets[i] = r.toDomain(&m)
+	}
+
+	return pets, nil
+}
+
+// UpdateStatusBatch 批量写入轻量状态
+// 使用单条 UPDATE ... FROM (VALUES ...) 语句，只更新实时状态、健康状态、情绪、锚点和版本号，
+// 版本号已被其他写操作推进的记录会被跳过
+func (r *PetRepository) UpdateStatusBatch(ctx context.Context, snapshots []pet.StatusSnapshot) ([]int, error) {
+	if len(snapshots) == 0 {
+		return nil, nil
+	}
+	db := postgres.GetTx(ctx, r.db)
+
+	rows := make([]string, 0, len(snapshots))
+	args := make([]any, 0, len(snapshots)*13)
+	for _, s := range snapshots {
+		rows = append(rows, "(?::bigint, ?::smallint, ?::smallint, ?::smallint, ?::smallint, ?::timestamptz, ?::smallint, ?::timestamptz, ?::timestamptz, ?::varchar, ?::smallint, ?::bigint, ?::bigint)")
+		args = append(args, s.PetID, s.Hunger, s.Happiness, s.Cleanliness, s.Energy,
+			s.StatusUpdatedAt, int(s.Illness), s.IllAt, s.HealthCheckedAt, string(s.Mood), s.MoodCalm, s.Revision, s.BaseRevision())
+	}
+
+	sql := `UPDATE pets AS p SET
+		hunger = v.hunger,
+		happiness = v.happiness,
+		cleanliness = v.cleanliness,
+		energy = v.energy,
+		status_updated_at = v.status_updated_at,
+		illness = v.illness,
+		ill_at = v.ill_at,
+		health_checked_at = v.health_checked_at,
+		mood = v.mood,
+		mood_calm = v.mood_calm,
+		revision = v.revision
+	FROM (VALUES ` + strings.Join(rows, ", ") + `) AS v(id, hunger, happiness, cleanliness, energy, status_updated_at, illness, ill_at, health_checked_at, mood, mood_calm, revision, base_revision)
+	WHERE p.id = v.id AND p.revision = v.base_revision
+	RETURNING p.id`
+
+	var ids []int
+	if err := db.Raw(sql, args...).Scan(&ids).Error; err != nil {
+		return nil, err
+	}
+
+	return ids, nil
+}
+
+// FindPassedAwayIDs 按ID游标获取一批寿命已尽的老年期宠物ID
+func (r *PetRepository) FindPassedAwayIDs(ctx context.Context, now time.Time, afterID, limit int) ([]int, error) {
+	db := postgres.GetTx(ctx, r.db)
+
+	var ids []int
+	if err := db.Model(&model.Pet{}).
+		Where("id > ? AND stage = ? AND passes_at <= ?", afterID, pet.StageElderly, now).
+		Order("id ASC").Limit(limit).Pluck("id", &ids).Error; err != nil {
+		return nil, err
+	}
+
+	return ids, nil
+}
+
+// LockPassedAway 加锁获取寿命已尽的老年期宠物
+// 使用 FOR UPDATE SKIP LOCKED，多个实例同时处理时互不阻塞且不会重复处理
+func (r *PetRepository) LockPassedAway(ctx context.Context, petID int, now time.Time) (*pet.Pet, error) {
+	db := postgres.GetTx(ctx, r.db)
+
+	var models []model.Pet
+	if err := db.Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+		Where("id = ? AND stage = ? AND passes_at <= ?", petID, pet.StageElderly, now).
+		Limit(1).Find(&models).Error; err != nil {
+		return nil, err
+	}
+	if len(models) == 0 {
+		return nil, pet.ErrPetNotFound
+	}
+
+	return r.toDomain(&models[0]), nil
+}
+
 // --- 模型转换 ---
 
 func (r *PetRepository) toDomain(m *model.Pet) *pet.Pet {
@@ -166,6 +325,18 @@ func (r *PetRepository) toDomain(m *model.Pet) *pet.Pet {
 		specialAppearance = pet.NewSpecialAppearance()
 	}
 
+	// 解析装饰品衰减减免
+	var decayBonus pet.DecayBonus
+	if m.DecayBonus != "" {
+		json.Unmarshal([]byte(m.DecayBonus), &decayBonus)
+	}
+
+	// 解析养育记录
+	var care pet.CareHistory
+	if m.CareHistory != "" {
+		json.Unmarshal([]byte(m.CareHistory), &care)
+	}
+
 	p := &pet.Pet{
 		ID:        m.ID,
 		UUID:      m.UUID,
@@ -197,34 +368,71 @@ func (r *PetRepository) toDomain(m *model.Pet) *pet.Pet {
 		Skill: pet.Skill{
 			Type:     pet.SkillType(m.SkillID),
 			Level:    int(m.SkillLevel),
+			Exp:      m.SkillExp,
 			Strength: int(m.SkillStrength),
 		},
 		Stage:           pet.Stage(m.Stage),
 		Exp:             m.Exp,
 		Level:           m.Level,
+		HatchAt:         m.HatchAt,
+		Form:            m.EvolutionForm,
+		Care:            care,
+		Lifespan:        time.Duration(m.LifespanHours) * time.Hour,
+		PassesAt:        m.PassesAt,
 		Hunger:          int(m.Hunger),
 		Happiness:       int(m.Happiness),
 		Cleanliness:     int(m.Cleanliness),
 		Energy:          int(m.Energy),
+		Illness:         pet.Illness(m.Illness),
+		IllAt:           m.IllAt,
+		HealthCheckedAt: m.HealthCheckedAt,
+		Mood:            pet.Mood(m.Mood),
+		MoodCalm:        int(m.MoodCalm),
+		DecayBonus:      decayBonus,
 		Parent1ID:       m.Parent1ID,
 		Parent2ID:       m.Parent2ID,
 		Generation:      m.Generation,
+		RosterOrder:     m.RosterOrder,
 		LastBreedAt:     m.LastBreedAt,
 		LastFedAt:       m.LastFedAt,
 		LastPlayedAt:    m.LastPlayedAt,
 		LastCleanedAt:   m.LastCleanedAt,
+		LastWarmedAt:    m.LastWarmedAt,
 		BornAt:          m.BornAt,
 		CreatedAt:       m.CreatedAt,
 		StatusUpdatedAt: m.StatusUpdatedAt,
 		Revision:        m.Revision,
 	}
 
+	// 启用孟德尔遗传前创建的宠物基因未经整理，加载时按物种整理（显性等位基因放到显现位），
+	// 外观随基因更新，下次保存时落库
+	if r.speciesRegistry != nil {
+		r.speciesRegistry.NormalizeGene(p)
+		if p.Gene != gene {
+			if interpreter, ok := r.speciesRegistry.GetInterpreter(p.SpeciesID); ok {
+				p.SetSpecialAppearance(interpreter.InterpretSpecialFeatures(p.Gene))
+			}
+		}
+	}
+
+	// 副技能由基因决定，成长期及以后生效
+	if p.Stage >= pet.StageTeen {
+		p.SecondarySkill = pet.NewSecondarySkillFromGene(p.Gene)
+	}
+
+	// 成长曲线和进化形态由物种决定，不落库
+	if r.speciesRegistry != nil {
+		r.speciesRegistry.AttachGrowth(p)
+	}
+
 	return p
 }
 
 func (r *PetRepository) toModel(p *pet.Pet) *model.Pet {
 	// 序列化物种特有外观
 	specialAppearanceJSON, _ := json.Marshal(p.SpecialAppearance)
+	decayBonusJSON, _ := json.Marshal(p.DecayBonus)
+	careJSON, _ := json.Marshal(p.Care)
 
 	m := &model.Pet{
 		UserID:            p.UserID,
@@ -250,18 +458,32 @@ func (r *PetRepository) toModel(p *pet.Pet) *model.Pet {
 		TraitPlayfulness:  int16(p.Personality.Playfulness),
 		SkillID:           int(p.Skill.Type),
 		SkillLevel:        int16(p.Skill.Level),
+		SkillExp:          p.Skill.Exp,
 		SkillStrength:     int16(p.Skill.Strength),
-		SkillSecondaryID:  0, // TODO: 支持副技能
+		SkillSecondaryID:  int(p.SecondarySkill.Type),
 		Stage:             int16(p.Stage),
 		Exp:               p.Exp,
 		Level:             p.Level,
+		HatchAt:           p.HatchAt,
+		EvolutionForm:     p.Form,
+		CareHistory:       string(careJSON),
+		LastWarmedAt:      p.LastWarmedAt,
+		LifespanHours:     int(p.Lifespan.Hours()),
+		PassesAt:          p.PassesAt,
 		Hunger:            int16(p.Hunger),
 		Happiness:         int16(p.Happiness),
 		Cleanliness:       int16(p.Cleanliness),
 		Energy:            int16(p.Energy),
+		Illness:           int16(p.Illness),
+		IllAt:             p.IllAt,
+		HealthCheckedAt:   p.HealthCheckedAt,
+		Mood:              string(p.Mood),
+		MoodCalm:          int16(p.MoodCalm),
+		DecayBonus:        string(decayBonusJSON),
 		Parent1ID:         p.Parent1ID,
 		Parent2ID:         p.Parent2ID,
 		Generation:        p.Generation,
+		RosterOrder:       p.RosterOrder,
 		LastBreedAt:       p.LastBreedAt,
 		LastFedAt:         p.LastFedAt,
 		LastPlayedAt:      p.LastPlayedAt,
@@ -0,0 +1,106 @@
+// Package repo 仓储实现
+package repo
+
+import (
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+
+	"pets-server/internal/domain/pet"
+	"pets-server/internal/infrastructure/persistence/postgres"
+	"pets-server/internal/infrastructure/persistence/postgres/model"
+)
+
+// RandomEventRepository 随机事件记录仓储实现
+type RandomEventRepository struct {
+	db *gorm.DB
+}
+
+// NewRandomEventRepository 创建随机事件记录仓储
+func NewRandomEventRepository(db *gorm.DB) *RandomEventRepository {
+	return &RandomEventRepository{db: db}
+}
+
+// FindByPetID 获取宠物最近的随机事件（按时间倒序）
+func (r *RandomEventRepository) FindByPetID(ctx context.Context, petID int, limit int) ([]*pet.RandomEvent, error) {
+	db := postgres.GetTx(ctx, r.db)
+
+	var models []model.PetRandomEvent
+	if err := db.Where("pet_id = ?", petID).Order("occurred_at DESC").Limit(limit).Find(&models).Error; err != nil {
+		return nil, err
+	}
+
+	events := make([]*pet.RandomEvent, len(models))
+	for i, m := range models {
+		events[i] = r.toDomain(&m)
+	}
+
+	return events, nil
+}
+
+// LastTriggeredAt 获取宠物各随机事件上次触发的时间（事件ID → 时间）
+func (r *RandomEventRepository) LastTriggeredAt(ctx context.Context, petID int) (map[string]time.Time, error) {
+	db := postgres.GetTx(ctx, r.db)
+
+	var rows []struct {
+		EventID    string
+		OccurredAt time.Time
+	}
+	err := db.Model(&model.PetRandomEvent{}).
+		Select("event_id, MAX(occurred_at) AS occurred_at").
+		Where("pet_id = ?", petID).
+		Group("event_id").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[string]time.Time, len(rows))
+	for _, row := range rows {
+		result[row.EventID] = row.OccurredAt
+	}
+	return result, nil
+}
+
+// Save 保存随机事件记录
+func (r *RandomEventRepository) Save(ctx context.Context, event *pet.RandomEvent) error {
+	db := postgres.GetTx(ctx, r.db)
+
+	m := &model.PetRandomEvent{
+		PetID:      event.PetID,
+		UserID:     event.UserID,
+		EventID:    event.EventID,
+		Name:       event.Name,
+		Message:    event.Message,
+		Effect:     string(event.Effect),
+		ItemID:     event.ItemID,
+		Status:     string(event.Status),
+		Amount:     event.Amount,
+		OccurredAt: event.OccurredAt,
+	}
+	m.ID = event.ID
+
+	if err := db.Save(m).Error; err != nil {
+		return err
+	}
+
+	event.ID = m.ID
+	return nil
+}
+
+func (r *RandomEventRepository) toDomain(m *model.PetRandomEvent) *pet.RandomEvent {
+	return &pet.RandomEvent{
+		ID:         m.ID,
+		PetID:      m.PetID,
+		UserID:     m.UserID,
+		EventID:    m.EventID,
+		Name:       m.Name,
+		Message:    m.Message,
+		Effect:     pet.RandomEventEffect(m.Effect),
+		ItemID:     m.ItemID,
+		Status:     pet.StatusType(m.Status),
+		Amount:     m.Amount,
+		OccurredAt: m.OccurredAt,
+	}
+}
@@ -7,7 +7,9 @@ import (
 	"time"
 
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 
+	"pets-server/internal/domain/shared"
 	"pets-server/internal/domain/social"
 	"pets-server/internal/infrastructure/persistence/postgres"
 	"pets-server/internal/infrastructure/persistence/postgres/model"
@@ -130,6 +132,7 @@ func (r *FriendRepository) toModel(f *social.Friendship) *model.Friendship {
 		ConfirmedAt: f.ConfirmedAt,
 	}
 	m.ID = f.ID
+	m.CreatedAt = f.CreatedAt
 	return m
 }
 
@@ -258,6 +261,21 @@ func (r *TradeRepository) FindByID(ctx context.Context, id int) (*social.Trade,
 	return r.toDomain(&m), nil
 }
 
+// FindByIDForUpdate 根据ID查找并锁定交易（SELECT ... FOR UPDATE）
+func (r *TradeRepository) FindByIDForUpdate(ctx context.Context, id int) (*social.Trade, error) {
+	db := postgres.GetTx(ctx, r.db)
+
+	var m model.Trade
+	if err := db.Clauses(clause.Locking{Strength: "UPDATE"}).First(&m, id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, social.ErrTradeNotFound
+		}
+		return nil, err
+	}
+
+	return r.toDomain(&m), nil
+}
+
 // FindByUser 获取用户相关的交易
 func (r *TradeRepository) FindByUser(ctx context.Context, userID int) ([]*social.Trade, error) {
 	db := postgres.GetTx(ctx, r.db)
@@ -294,6 +312,26 @@ func (r *TradeRepository) FindPending(ctx context.Context, userID int) ([]*socia
 	return trades, nil
 }
 
+// LockExpiredPending 锁定并获取过期的待处理交易
+// 使用 FOR UPDATE SKIP LOCKED，多个实例同时扫描时互不阻塞且不会重复处理
+func (r *TradeRepository) LockExpiredPending(ctx context.Context, before time.Time, limit int) ([]*social.Trade, error) {
+	db := postgres.GetTx(ctx, r.db)
+
+	var models []model.Trade
+	if err := db.Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+		Where("status = ? AND created_at < ?", social.TradeStatusPending, before).
+		Order("id ASC").Limit(limit).Find(&models).Error; err != nil {
+		return nil, err
+	}
+
+	trades := make([]*social.Trade, len(models))
+	for i, m := range models {
+		trades[i] = r.toDomain(&m)
+	}
+
+	return trades, nil
+}
+
 // Save 保存交易
 func (r *TradeRepository) Save(ctx context.Context, t *social.Trade) error {
 	db := postgres.GetTx(ctx, r.db)
@@ -309,6 +347,7 @@ func (r *TradeRepository) Save(ctx context.Context, t *social.Trade) error {
 		CompletedAt:     t.CompletedAt,
 	}
 	m.ID = t.ID
+	m.CreatedAt = t.CreatedAt
 
 	if err := db.Save(m).Error; err != nil {
 		return err
@@ -361,7 +400,7 @@ func (r *VisitRepository) RecordVisit(ctx context.Context, visitorID, hostID int
 func (r *VisitRepository) CountTodayVisits(ctx context.Context, hostID int) (int, error) {
 	db := postgres.GetTx(ctx, r.db)
 
-	today := time.Now().Truncate(24 * time.Hour)
+	today := shared.StartOfGameDay(time.Now())
 	var count int64
 	if err := db.Model(&model.VisitRecord{}).
 		Where("host_id = ? AND created_at >= ?", hostID, today).
@@ -376,7 +415,7 @@ func (r *VisitRepository) CountTodayVisits(ctx context.Context, hostID int) (int
 func (r *VisitRepository) HasVisitedToday(ctx context.Context, visitorID, hostID int) (bool, error) {
 	db := postgres.GetTx(ctx, r.db)
 
-	today := time.Now().Truncate(24 * time.Hour)
+	today := shared.StartOfGameDay(time.Now())
 	var count int64
 	if err := db.Model(&model.VisitRecord{}).
 		Where("visitor_id = ? AND host_id = ? AND created_at >= ?", visitorID, hostID, today).
@@ -386,3 +425,52 @@ func (r *VisitRepository) HasVisitedToday(ctx context.Context, visitorID, hostID
 
 	return count > 0, nil
 }
+
+// --- InteractionRepository ---
+
+// InteractionRepository 好友互动记录仓储实现
+type InteractionRepository struct {
+	db *gorm.DB
+}
+
+// NewInteractionRepository 创建好友互动仓储
+func NewInteractionRepository(db *gorm.DB) *InteractionRepository {
+	return &InteractionRepository{db: db}
+}
+
+// Save 保存互动记录
+func (r *InteractionRepository) Save(ctx context.Context, i *social.Interaction) error {
+	db := postgres.GetTx(ctx, r.db)
+
+	m := &model.InteractionRecord{
+		ActorID:  i.ActorID,
+		OwnerID:  i.OwnerID,
+		PetID:    i.PetID,
+		Action:   string(i.Action),
+		Intimacy: i.Intimacy,
+	}
+	m.ID = i.ID
+	m.CreatedAt = i.CreatedAt
+
+	if err := db.Save(m).Error; err != nil {
+		return err
+	}
+
+	i.ID = m.ID
+	return nil
+}
+
+// CountToday 统计今天帮某位好友照顾宠物的次数
+func (r *InteractionRepository) CountToday(ctx context.Context, actorID, ownerID int) (int, error) {
+	db := postgres.GetTx(ctx, r.db)
+
+	today := shared.StartOfGameDay(time.Now())
+	var count int64
+	if err := db.Model(&model.InteractionRecord{}).
+		Where("actor_id = ? AND owner_id = ? AND created_at >= ?", actorID, ownerID, today).
+		Count(&count).Error; err != nil {
+		return 0, err
+	}
+
+	return int(count), nil
+}
@@ -6,6 +6,7 @@ import (
 	"errors"
 
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 
 	"pets-server/internal/domain/user"
 	"pets-server/internal/infrastructure/persistence/postgres"
@@ -37,6 +38,21 @@ func (r *UserRepository) FindByID(ctx context.Context, id int) (*user.User, erro
 	return r.toDomain(&m), nil
 }
 
+// FindByIDForUpdate 根据ID查找并锁定用户（SELECT ... FOR UPDATE）
+func (r *UserRepository) FindByIDForUpdate(ctx context.Context, id int) (*user.User, error) {
+	db := postgres.GetTx(ctx, r.db)
+
+	var m model.User
+	if err := db.Clauses(clause.Locking{Strength: "UPDATE"}).First(&m, id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, user.ErrUserNotFound
+		}
+		return nil, err
+	}
+
+	return r.toDomain(&m), nil
+}
+
 // FindByUsername 根据用户名查找用户
 func (r *UserRepository) FindByUsername(ctx context.Context, username string) (*user.User, error) {
 	db := postgres.GetTx(ctx, r.db)
@@ -101,6 +117,7 @@ func (r *UserRepository) toDomain(m *model.User) *user.User {
 		Coins:       m.Coins,
 		Diamonds:    m.Diamonds,
 		ActivePetID: m.ActivePetID,
+		PetSlots:    m.PetSlots,
 		CreatedAt:   m.CreatedAt,
 		LastLoginAt: m.LastLoginAt,
 	}
@@ -117,6 +134,7 @@ func (r *UserRepository) toModel(u *user.User) *model.User {
 		Coins:       u.Coins,
 		Diamonds:    u.Diamonds,
 		ActivePetID: u.ActivePetID,
+		PetSlots:    u.PetSlots,
 		LastLoginAt: u.LastLoginAt,
 	}
 	m.ID = u.ID
@@ -10,6 +10,7 @@ import (
 	"github.com/redis/go-redis/v9"
 
 	petApp "pets-server/internal/application/pet"
+	"pets-server/internal/domain/pet"
 )
 
 // CacheService 缓存服务实现
@@ -66,6 +67,38 @@ func (c *CacheService) DeletePetDetail(ctx context.Context, userID int) error {
 	return c.client.Del(ctx, key).Err()
 }
 
+// --- 基因分布缓存 ---
+
+const genePoolKey = "pet:gene_pool"
+
+// GetGenePool 获取基因分布缓存
+func (c *CacheService) GetGenePool(ctx context.Context) (*pet.GenePool, error) {
+	data, err := c.client.Get(ctx, genePoolKey).Bytes()
+	if err != nil {
+		if err == redis.Nil {
+			return nil, nil // 缓存未命中
+		}
+		return nil, err
+	}
+
+	var pool pet.GenePool
+	if err := json.Unmarshal(data, &pool); err != nil {
+		return nil, err
+	}
+
+	return &pool, nil
+}
+
+// SetGenePool 设置基因分布缓存
+func (c *CacheService) SetGenePool(ctx context.Context, pool *pet.GenePool, ttl time.Duration) error {
+	data, err := json.Marshal(pool)
+	if err != nil {
+		return err
+	}
+
+	return c.client.Set(ctx, genePoolKey, data, ttl).Err()
+}
+
 // --- 通用缓存方法 ---
 
 // Get 获取缓存
@@ -0,0 +1,116 @@
+// Package redis 宠物状态缓冲区实现
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+
+	"github.com/redis/go-redis/v9"
+
+	"pets-server/internal/domain/pet"
+)
+
+// statusBufferKey 待落库的宠物状态（Hash: 宠物ID → 快照JSON）
+// 同一只宠物的多次变更只保留最新一份
+const statusBufferKey = "pet:status:buffer"
+
+// popStatusScript 原子地取出并删除至多 ARGV[1] 条快照，多个实例同时刷新时不会重复落库
+// 用 HSCAN 按批游标读取，凑够一批即停止，不随缓冲区大小遍历全部字段；HSCAN 可能重复返回字段，需去重
+var popStatusScript = redis.NewScript(`
+local limit = tonumber(ARGV[1])
+local cursor = '0'
+local seen = {}
+local fields = {}
+local values = {}
+repeat
+	local page = redis.call('HSCAN', KEYS[1], cursor, 'COUNT', limit)
+	cursor = page[1]
+	local items = page[2]
+	for i = 1, #items, 2 do
+		if #fields >= limit then
+			break
+		end
+		if not seen[items[i]] then
+			seen[items[i]] = true
+			fields[#fields + 1] = items[i]
+			values[#values + 1] = items[i + 1]
+		end
+	end
+until cursor == '0' or #fields >= limit
+if #fields == 0 then
+	return {}
+end
+redis.call('HDEL', KEYS[1], unpack(fields))
+return values
+`)
+
+// StatusBuffer 宠物状态缓冲区
+type StatusBuffer struct {
+	client *redis.Client
+}
+
+// NewStatusBuffer 创建宠物状态缓冲区
+func NewStatusBuffer(client *redis.Client) *StatusBuffer {
+	return &StatusBuffer{client: client}
+}
+
+// Push 写入一批快照，返回写入后缓冲区中的快照数
+func (b *StatusBuffer) Push(ctx context.Context, snapshots []pet.StatusSnapshot) (int, error) {
+	if len(snapshots) == 0 {
+		return b.Len(ctx)
+	}
+
+	values := make(map[string]interface{}, len(snapshots))
+	for _, s := range snapshots {
+		data, err := json.Marshal(s)
+		if err != nil {
+			return 0, err
+		}
+		values[strconv.Itoa(s.PetID)] = data
+	}
+
+	pipe := b.client.TxPipeline()
+	pipe.HSet(ctx, statusBufferKey, values)
+	size := pipe.HLen(ctx, statusBufferKey)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return 0, err
+	}
+
+	return int(size.Val()), nil
+}
+
+// Pop 取出并删除至多 limit 条快照
+func (b *StatusBuffer) Pop(ctx context.Context, limit int) ([]pet.StatusSnapshot, error) {
+	values, err := popStatusScript.Run(ctx, b.client, []string{statusBufferKey}, limit).Slice()
+	if err != nil {
+		if err == redis.Nil {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	snapshots := make([]pet.StatusSnapshot, 0, len(values))
+	for _, v := range values {
+		data, ok := v.(string)
+		if !ok {
+			continue
+		}
+		var s pet.StatusSnapshot
+		if err := json.Unmarshal([]byte(data), &s); err != nil {
+			continue
+		}
+		snapshots = append(snapshots, s)
+	}
+
+	return snapshots, nil
+}
+
+// Len 缓冲区中的快照数
+func (b *StatusBuffer) Len(ctx context.Context) (int, error) {
+	n, err := b.client.HLen(ctx, statusBufferKey).Result()
+	if err != nil {
+		return 0, err
+	}
+	return int(n), nil
+}
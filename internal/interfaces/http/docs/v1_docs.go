@@ -389,7 +389,7 @@ const docTemplatev1 = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "type": "object"
+                                            "$ref": "#/definitions/item.BagResponse"
                                         }
                                     }
                                 }
@@ -412,7 +412,7 @@ const docTemplatev1 = `{
                         "Bearer": []
                     }
                 ],
-                "description": "在商店购买道具",
+                "description": "在商店购买道具，扣除金币或钻石并放入背包",
                 "consumes": [
                     "application/json"
                 ],
@@ -430,7 +430,7 @@ const docTemplatev1 = `{
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/handler.BuyRequest"
+                            "$ref": "#/definitions/item.BuyItemRequest"
                         }
                     }
                 ],
@@ -438,7 +438,19 @@ const docTemplatev1 = `{
                     "200": {
                         "description": "购买成功",
                         "schema": {
-                            "$ref": "#/definitions/response.Response"
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/item.BuyItemResponse"
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     },
                     "400": {
@@ -447,8 +459,14 @@ const docTemplatev1 = `{
                             "$ref": "#/definitions/response.Response"
                         }
                     },
+                    "404": {
+                        "description": "道具不存在",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
                     "500": {
-                        "description": "服务器错误",
+                        "description": "货币不足、道具不出售或服务器错误",
                         "schema": {
                             "$ref": "#/definitions/response.Response"
                         }
@@ -456,14 +474,14 @@ const docTemplatev1 = `{
                 }
             }
         },
-        "/items/shop": {
+        "/items/decorations": {
             "get": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "获取商店中可购买的道具列表",
+                "description": "获取宠物各槽位穿戴中的装饰及其带来的状态衰减减免，不传 petId 时为当前主宠物",
                 "consumes": [
                     "application/json"
                 ],
@@ -473,7 +491,15 @@ const docTemplatev1 = `{
                 "tags": [
                     "item"
                 ],
-                "summary": "获取商店道具",
+                "summary": "获取宠物装饰",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "宠物ID",
+                        "name": "petId",
+                        "in": "query"
+                    }
+                ],
                 "responses": {
                     "200": {
                         "description": "获取成功",
@@ -486,13 +512,19 @@ const docTemplatev1 = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "type": "object"
+                                            "$ref": "#/definitions/item.PetDecorationsResponse"
                                         }
                                     }
                                 }
                             ]
                         }
                     },
+                    "400": {
+                        "description": "请求参数错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
                     "500": {
                         "description": "服务器错误",
                         "schema": {
@@ -502,14 +534,14 @@ const docTemplatev1 = `{
                 }
             }
         },
-        "/items/use": {
+        "/items/decorations/equip": {
             "post": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "使用背包中的道具",
+                "description": "从背包取出一件装饰品穿戴到宠物对应槽位（head/body/accessory），每个槽位只能穿戴一件",
                 "consumes": [
                     "application/json"
                 ],
@@ -519,16 +551,45 @@ const docTemplatev1 = `{
                 "tags": [
                     "item"
                 ],
-                "summary": "使用道具",
+                "summary": "穿戴装饰",
+                "parameters": [
+                    {
+                        "description": "穿戴请求",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/item.EquipDecorationRequest"
+                        }
+                    }
+                ],
                 "responses": {
                     "200": {
-                        "description": "使用成功",
+                        "description": "穿戴成功",
                         "schema": {
-                            "$ref": "#/definitions/response.Response"
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/item.PetDecorationsResponse"
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     },
                     "400": {
-                        "description": "请求参数错误",
+                        "description": "请求参数错误、不是装饰品或槽位已占用",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "道具或宠物不存在",
                         "schema": {
                             "$ref": "#/definitions/response.Response"
                         }
@@ -542,14 +603,14 @@ const docTemplatev1 = `{
                 }
             }
         },
-        "/pet": {
-            "get": {
+        "/items/decorations/unequip": {
+            "post": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "获取当前用户的宠物详细信息，包括外观、性格、技能和状态",
+                "description": "卸下宠物指定槽位的装饰并放回背包",
                 "consumes": [
                     "application/json"
                 ],
@@ -557,12 +618,23 @@ const docTemplatev1 = `{
                     "application/json"
                 ],
                 "tags": [
-                    "pet"
+                    "item"
+                ],
+                "summary": "卸下装饰",
+                "parameters": [
+                    {
+                        "description": "卸下请求",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/item.UnequipDecorationRequest"
+                        }
+                    }
                 ],
-                "summary": "获取我的宠物",
                 "responses": {
                     "200": {
-                        "description": "获取成功",
+                        "description": "卸下成功",
                         "schema": {
                             "allOf": [
                                 {
@@ -572,15 +644,21 @@ const docTemplatev1 = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/pet.PetDetailDTO"
+                                            "$ref": "#/definitions/item.PetDecorationsResponse"
                                         }
                                     }
                                 }
                             ]
                         }
                     },
+                    "400": {
+                        "description": "请求参数错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
                     "404": {
-                        "description": "还没有宠物",
+                        "description": "该槽位没有装饰",
                         "schema": {
                             "$ref": "#/definitions/response.Response"
                         }
@@ -592,14 +670,16 @@ const docTemplatev1 = `{
                         }
                     }
                 }
-            },
-            "post": {
+            }
+        },
+        "/items/shop": {
+            "get": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "创建一个新宠物，可以指定物种或随机生成",
+                "description": "获取商店中可购买的道具列表，可按道具类型筛选",
                 "consumes": [
                     "application/json"
                 ],
@@ -607,23 +687,20 @@ const docTemplatev1 = `{
                     "application/json"
                 ],
                 "tags": [
-                    "pet"
+                    "item"
                 ],
-                "summary": "创建宠物",
+                "summary": "获取商店道具",
                 "parameters": [
                     {
-                        "description": "创建宠物请求",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/pet.CreatePetRequest"
-                        }
+                        "type": "integer",
+                        "description": "道具类型：1食物 2清洁 3玩具 4装饰 5特殊",
+                        "name": "type",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "创建成功",
+                        "description": "获取成功",
                         "schema": {
                             "allOf": [
                                 {
@@ -633,7 +710,7 @@ const docTemplatev1 = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/pet.CreatePetResponse"
+                                            "$ref": "#/definitions/item.ShopResponse"
                                         }
                                     }
                                 }
@@ -646,12 +723,6 @@ const docTemplatev1 = `{
                             "$ref": "#/definitions/response.Response"
                         }
                     },
-                    "409": {
-                        "description": "已拥有宠物",
-                        "schema": {
-                            "$ref": "#/definitions/response.Response"
-                        }
-                    },
                     "500": {
                         "description": "服务器错误",
                         "schema": {
@@ -661,14 +732,14 @@ const docTemplatev1 = `{
                 }
             }
         },
-        "/pet/clean": {
+        "/items/use": {
             "post": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "清洁宠物，增加清洁度",
+                "description": "对当前主宠物使用背包中的道具，按道具效果（可组合）恢复状态、增加经验、清除繁殖冷却、改名或重置技能；改名卡需提供 newName",
                 "consumes": [
                     "application/json"
                 ],
@@ -676,12 +747,23 @@ const docTemplatev1 = `{
                     "application/json"
                 ],
                 "tags": [
-                    "pet"
+                    "item"
+                ],
+                "summary": "使用道具",
+                "parameters": [
+                    {
+                        "description": "使用道具请求",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/item.UseItemRequest"
+                        }
+                    }
                 ],
-                "summary": "清洁宠物",
                 "responses": {
                     "200": {
-                        "description": "清洁成功",
+                        "description": "使用成功",
                         "schema": {
                             "allOf": [
                                 {
@@ -691,7 +773,7 @@ const docTemplatev1 = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/pet.CleanPetResponse"
+                                            "$ref": "#/definitions/item.UseItemResponse"
                                         }
                                     }
                                 }
@@ -699,7 +781,13 @@ const docTemplatev1 = `{
                         }
                     },
                     "400": {
-                        "description": "请求参数错误",
+                        "description": "请求参数错误或宠物当前状态无法使用",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "道具或宠物不存在",
                         "schema": {
                             "$ref": "#/definitions/response.Response"
                         }
@@ -713,14 +801,14 @@ const docTemplatev1 = `{
                 }
             }
         },
-        "/pet/feed": {
-            "post": {
+        "/pet": {
+            "get": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "使用食物道具喂食宠物，增加饱食度和经验值，可能升级",
+                "description": "获取当前用户的宠物详细信息，包括外观、性格、技能和状态",
                 "consumes": [
                     "application/json"
                 ],
@@ -730,21 +818,10 @@ const docTemplatev1 = `{
                 "tags": [
                     "pet"
                 ],
-                "summary": "喂食宠物",
-                "parameters": [
-                    {
-                        "description": "喂食请求",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/pet.FeedPetRequest"
-                        }
-                    }
-                ],
+                "summary": "获取我的宠物",
                 "responses": {
                     "200": {
-                        "description": "喂食成功",
+                        "description": "获取成功",
                         "schema": {
                             "allOf": [
                                 {
@@ -754,15 +831,15 @@ const docTemplatev1 = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/pet.FeedPetResponse"
+                                            "$ref": "#/definitions/pet.PetDetailDTO"
                                         }
                                     }
                                 }
                             ]
                         }
                     },
-                    "400": {
-                        "description": "请求参数错误",
+                    "404": {
+                        "description": "还没有宠物",
                         "schema": {
                             "$ref": "#/definitions/response.Response"
                         }
@@ -774,16 +851,14 @@ const docTemplatev1 = `{
                         }
                     }
                 }
-            }
-        },
-        "/pet/play": {
+            },
             "post": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "与宠物互动玩耍，增加快乐度和经验值，消耗精力",
+                "description": "创建一个新宠物，可以指定物种或随机生成",
                 "consumes": [
                     "application/json"
                 ],
@@ -793,10 +868,21 @@ const docTemplatev1 = `{
                 "tags": [
                     "pet"
                 ],
-                "summary": "和宠物玩耍",
+                "summary": "创建宠物",
+                "parameters": [
+                    {
+                        "description": "创建宠物请求",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/pet.CreatePetRequest"
+                        }
+                    }
+                ],
                 "responses": {
                     "200": {
-                        "description": "玩耍成功",
+                        "description": "创建成功",
                         "schema": {
                             "allOf": [
                                 {
@@ -806,7 +892,7 @@ const docTemplatev1 = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/pet.PlayPetResponse"
+                                            "$ref": "#/definitions/pet.CreatePetResponse"
                                         }
                                     }
                                 }
@@ -819,6 +905,12 @@ const docTemplatev1 = `{
                             "$ref": "#/definitions/response.Response"
                         }
                     },
+                    "409": {
+                        "description": "宠物栏已满",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
                     "500": {
                         "description": "服务器错误",
                         "schema": {
@@ -828,14 +920,14 @@ const docTemplatev1 = `{
                 }
             }
         },
-        "/pet/status": {
-            "get": {
+        "/pet/active": {
+            "put": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "获取当前用户宠物的轻量状态信息（实时状态与版本信息）",
+                "description": "设置当前用户的主宠物（主页默认展示和互动对象）",
                 "consumes": [
                     "application/json"
                 ],
@@ -845,19 +937,21 @@ const docTemplatev1 = `{
                 "tags": [
                     "pet"
                 ],
-                "summary": "获取宠物轻量状态",
+                "summary": "设置主宠物",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "宠物ID",
-                        "name": "petId",
-                        "in": "query",
-                        "required": true
+                        "description": "设置主宠物请求",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/pet.SetActivePetRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "获取成功",
+                        "description": "设置成功",
                         "schema": {
                             "allOf": [
                                 {
@@ -867,7 +961,7 @@ const docTemplatev1 = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/pet.PetStatusDTO"
+                                            "$ref": "#/definitions/pet.SetActivePetResponse"
                                         }
                                     }
                                 }
@@ -887,7 +981,7 @@ const docTemplatev1 = `{
                         }
                     },
                     "404": {
-                        "description": "还没有宠物",
+                        "description": "宠物不存在",
                         "schema": {
                             "$ref": "#/definitions/response.Response"
                         }
@@ -901,14 +995,14 @@ const docTemplatev1 = `{
                 }
             }
         },
-        "/ranking": {
+        "/pet/adoptions": {
             "get": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "获取排行榜数据，支持宠物等级、成就数量、亲密度等类型",
+                "description": "获取可领养的宠物挂单，包括公开出售的和好友指定赠送给我的",
                 "consumes": [
                     "application/json"
                 ],
@@ -916,34 +1010,22 @@ const docTemplatev1 = `{
                     "application/json"
                 ],
                 "tags": [
-                    "ranking"
+                    "pet"
                 ],
-                "summary": "获取排行榜",
+                "summary": "领养市场",
                 "parameters": [
-                    {
-                        "enum": [
-                            "pet_level",
-                            "achievement",
-                            "intimacy"
-                        ],
-                        "type": "string",
-                        "description": "排行榜类型",
-                        "name": "type",
-                        "in": "query",
-                        "required": true
-                    },
                     {
                         "type": "integer",
-                        "default": 0,
-                        "description": "偏移量",
-                        "name": "offset",
+                        "default": 1,
+                        "description": "页码",
+                        "name": "page",
                         "in": "query"
                     },
                     {
                         "type": "integer",
                         "default": 20,
-                        "description": "数量限制",
-                        "name": "limit",
+                        "description": "每页数量",
+                        "name": "pageSize",
                         "in": "query"
                     }
                 ],
@@ -959,7 +1041,7 @@ const docTemplatev1 = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/ranking.RankingResponse"
+                                            "$ref": "#/definitions/pet.AdoptionListResponse"
                                         }
                                     }
                                 }
@@ -979,16 +1061,14 @@ const docTemplatev1 = `{
                         }
                     }
                 }
-            }
-        },
-        "/social/friends": {
-            "get": {
+            },
+            "post": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "获取当前用户的好友列表，包括亲密度等信息",
+                "description": "把自己的宠物以金币或钻石标价挂到领养市场；填写 recipientId 则免费赠送给该好友",
                 "consumes": [
                     "application/json"
                 ],
@@ -996,14 +1076,25 @@ const docTemplatev1 = `{
                     "application/json"
                 ],
                 "tags": [
-                    "social"
+                    "pet"
                 ],
-                "summary": "获取好友列表",
-                "responses": {
-                    "200": {
-                        "description": "获取成功",
-                        "schema": {
-                            "allOf": [
+                "summary": "挂单领养",
+                "parameters": [
+                    {
+                        "description": "挂单请求",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/pet.ListPetForAdoptionRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "挂单成功",
+                        "schema": {
+                            "allOf": [
                                 {
                                     "$ref": "#/definitions/response.Response"
                                 },
@@ -1011,13 +1102,31 @@ const docTemplatev1 = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/social.FriendListResponse"
+                                            "$ref": "#/definitions/pet.AdoptionListingDTO"
                                         }
                                     }
                                 }
                             ]
                         }
                     },
+                    "400": {
+                        "description": "请求参数错误或标价无效",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "403": {
+                        "description": "非宠物主人或非好友",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "409": {
+                        "description": "宠物已在挂单中",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
                     "500": {
                         "description": "服务器错误",
                         "schema": {
@@ -1027,14 +1136,14 @@ const docTemplatev1 = `{
                 }
             }
         },
-        "/social/friends/accept/{id}": {
-            "post": {
+        "/pet/adoptions/mine": {
+            "get": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "接受指定的好友申请",
+                "description": "获取我发布的全部领养挂单（含已领养和已撤回的）",
                 "consumes": [
                     "application/json"
                 ],
@@ -1042,29 +1151,26 @@ const docTemplatev1 = `{
                     "application/json"
                 ],
                 "tags": [
-                    "social"
-                ],
-                "summary": "接受好友申请",
-                "parameters": [
-                    {
-                        "type": "integer",
-                        "description": "好友申请ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    }
+                    "pet"
                 ],
+                "summary": "我发布的挂单",
                 "responses": {
                     "200": {
-                        "description": "已添加为好友",
-                        "schema": {
-                            "$ref": "#/definitions/response.Response"
-                        }
-                    },
-                    "400": {
-                        "description": "请求参数错误",
+                        "description": "获取成功",
                         "schema": {
-                            "$ref": "#/definitions/response.Response"
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/pet.AdoptionListResponse"
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     },
                     "500": {
@@ -1076,14 +1182,14 @@ const docTemplatev1 = `{
                 }
             }
         },
-        "/social/friends/request": {
+        "/pet/adoptions/{id}/adopt": {
             "post": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "向指定用户发送好友申请",
+                "description": "支付标价领养挂单中的宠物，宠物穿戴的装饰退回原主人，原主人的主宠物随之清空",
                 "consumes": [
                     "application/json"
                 ],
@@ -1091,35 +1197,63 @@ const docTemplatev1 = `{
                     "application/json"
                 ],
                 "tags": [
-                    "social"
+                    "pet"
                 ],
-                "summary": "发送好友申请",
+                "summary": "领养宠物",
                 "parameters": [
                     {
-                        "description": "好友申请",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/social.AddFriendRequest"
-                        }
+                        "type": "integer",
+                        "description": "挂单ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "申请已发送",
+                        "description": "领养成功",
                         "schema": {
-                            "$ref": "#/definitions/response.Response"
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/pet.AdoptPetResponse"
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     },
                     "400": {
-                        "description": "请求参数错误",
+                        "description": "请求参数错误或领养自己的宠物",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "403": {
+                        "description": "非指定的赠送对象",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "挂单不存在",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "409": {
+                        "description": "挂单状态无效或宠物已易主",
                         "schema": {
                             "$ref": "#/definitions/response.Response"
                         }
                     },
                     "500": {
-                        "description": "服务器错误",
+                        "description": "金币/钻石不足或服务器错误",
                         "schema": {
                             "$ref": "#/definitions/response.Response"
                         }
@@ -1127,14 +1261,14 @@ const docTemplatev1 = `{
                 }
             }
         },
-        "/social/friends/requests": {
-            "get": {
+        "/pet/adoptions/{id}/cancel": {
+            "post": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "获取待处理的好友申请列表",
+                "description": "原主人撤回尚未被领养的挂单",
                 "consumes": [
                     "application/json"
                 ],
@@ -1142,12 +1276,21 @@ const docTemplatev1 = `{
                     "application/json"
                 ],
                 "tags": [
-                    "social"
+                    "pet"
+                ],
+                "summary": "撤回领养挂单",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "挂单ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
-                "summary": "获取好友申请列表",
                 "responses": {
                     "200": {
-                        "description": "获取成功",
+                        "description": "撤回成功",
                         "schema": {
                             "allOf": [
                                 {
@@ -1157,13 +1300,37 @@ const docTemplatev1 = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "type": "object"
+                                            "$ref": "#/definitions/pet.AdoptionListingDTO"
                                         }
                                     }
                                 }
                             ]
                         }
                     },
+                    "400": {
+                        "description": "请求参数错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "403": {
+                        "description": "非挂单主人",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "挂单不存在",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "409": {
+                        "description": "挂单状态无效",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
                     "500": {
                         "description": "服务器错误",
                         "schema": {
@@ -1173,14 +1340,14 @@ const docTemplatev1 = `{
                 }
             }
         },
-        "/social/gifts": {
-            "get": {
+        "/pet/breed": {
+            "post": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "获取当前用户收到的礼物列表",
+                "description": "使用自己的两只宠物进行繁殖，后代继承双亲基因，跨物种时有概率触发隐藏物种融合；血缘越近后代越容易突变、抗性越低，超过阈值时禁止繁殖",
                 "consumes": [
                     "application/json"
                 ],
@@ -1188,12 +1355,23 @@ const docTemplatev1 = `{
                     "application/json"
                 ],
                 "tags": [
-                    "social"
+                    "pet"
+                ],
+                "summary": "双亲繁殖",
+                "parameters": [
+                    {
+                        "description": "繁殖请求",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/pet.BreedPetsRequest"
+                        }
+                    }
                 ],
-                "summary": "获取收到的礼物",
                 "responses": {
                     "200": {
-                        "description": "获取成功",
+                        "description": "繁殖成功",
                         "schema": {
                             "allOf": [
                                 {
@@ -1203,28 +1381,48 @@ const docTemplatev1 = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "type": "object"
+                                            "$ref": "#/definitions/pet.BreedPetsResponse"
                                         }
                                     }
                                 }
                             ]
                         }
                     },
+                    "400": {
+                        "description": "请求参数错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "403": {
+                        "description": "非宠物主人",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "宠物不存在",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
                     "500": {
-                        "description": "服务器错误",
+                        "description": "繁殖条件不满足（冷却中、性别不兼容、未成年等）或服务器错误",
                         "schema": {
                             "$ref": "#/definitions/response.Response"
                         }
                     }
                 }
-            },
-            "post": {
+            }
+        },
+        "/pet/breed/check": {
+            "get": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "向好友发送礼物",
+                "description": "检查两只宠物能否繁殖（不传 parent2Id 时检查分裂繁殖），返回不可繁殖原因和冷却剩余时间",
                 "consumes": [
                     "application/json"
                 ],
@@ -1232,25 +1430,41 @@ const docTemplatev1 = `{
                     "application/json"
                 ],
                 "tags": [
-                    "social"
+                    "pet"
                 ],
-                "summary": "发送礼物",
+                "summary": "检查是否可繁殖",
                 "parameters": [
                     {
-                        "description": "礼物请求",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/social.SendGiftRequest"
-                        }
+                        "type": "integer",
+                        "description": "父母1 ID",
+                        "name": "parent1Id",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "父母2 ID",
+                        "name": "parent2Id",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "发送成功",
+                        "description": "检查成功",
                         "schema": {
-                            "$ref": "#/definitions/response.Response"
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/pet.CanBreedResponse"
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     },
                     "400": {
@@ -1259,6 +1473,18 @@ const docTemplatev1 = `{
                             "$ref": "#/definitions/response.Response"
                         }
                     },
+                    "403": {
+                        "description": "非宠物主人",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "宠物不存在",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
                     "500": {
                         "description": "服务器错误",
                         "schema": {
@@ -1268,14 +1494,14 @@ const docTemplatev1 = `{
                 }
             }
         },
-        "/social/trades": {
+        "/pet/breed/contracts": {
             "get": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "获取当前用户的交易记录",
+                "description": "获取我发起和收到的跨主人繁殖契约，已超时的待确认契约会被标记为过期",
                 "consumes": [
                     "application/json"
                 ],
@@ -1283,9 +1509,9 @@ const docTemplatev1 = `{
                     "application/json"
                 ],
                 "tags": [
-                    "social"
+                    "pet"
                 ],
-                "summary": "获取交易列表",
+                "summary": "我的繁殖契约",
                 "responses": {
                     "200": {
                         "description": "获取成功",
@@ -1298,7 +1524,7 @@ const docTemplatev1 = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "type": "object"
+                                            "$ref": "#/definitions/pet.BreedingContractListResponse"
                                         }
                                     }
                                 }
@@ -1319,7 +1545,7 @@ const docTemplatev1 = `{
                         "Bearer": []
                     }
                 ],
-                "description": "创建一个新的道具交易请求",
+                "description": "用自己的宠物向好友的宠物发起繁殖邀约，需要双方为好友且亲密度达到\"朋友\"，双方宠物都满足繁殖条件",
                 "consumes": [
                     "application/json"
                 ],
@@ -1327,35 +1553,65 @@ const docTemplatev1 = `{
                     "application/json"
                 ],
                 "tags": [
-                    "social"
+                    "pet"
                 ],
-                "summary": "创建交易",
+                "summary": "发起繁殖契约",
                 "parameters": [
                     {
-                        "description": "交易请求",
+                        "description": "发起契约请求",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/social.CreateTradeRequest"
+                            "$ref": "#/definitions/pet.ProposeBreedingContractRequest"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "创建成功",
-                        "schema": {
-                            "$ref": "#/definitions/response.Response"
-                        }
-                    },
-                    "400": {
-                        "description": "请求参数错误",
+                        "description": "发起成功",
                         "schema": {
-                            "$ref": "#/definitions/response.Response"
-                        }
-                    },
-                    "500": {
-                        "description": "服务器错误",
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/pet.BreedingContractDTO"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "请求参数错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "403": {
+                        "description": "非宠物主人或非好友",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "宠物不存在",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "409": {
+                        "description": "宠物已有待处理契约",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "亲密度不足、繁殖条件不满足或服务器错误",
                         "schema": {
                             "$ref": "#/definitions/response.Response"
                         }
@@ -1363,14 +1619,14 @@ const docTemplatev1 = `{
                 }
             }
         },
-        "/social/trades/accept/{id}": {
+        "/pet/breed/contracts/{id}/accept": {
             "post": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "接受指定的交易请求",
+                "description": "契约接收方同意后立即繁殖，后代按契约约定归属（可一窝双胎双方各得一只）",
                 "consumes": [
                     "application/json"
                 ],
@@ -1378,23 +1634,43 @@ const docTemplatev1 = `{
                     "application/json"
                 ],
                 "tags": [
-                    "social"
+                    "pet"
                 ],
-                "summary": "接受交易",
+                "summary": "接受繁殖契约",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "交易ID",
+                        "description": "契约ID",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "接受契约请求",
+                        "name": "request",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/pet.AcceptBreedingContractRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "交易完成",
+                        "description": "繁殖成功",
                         "schema": {
-                            "$ref": "#/definitions/response.Response"
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/pet.AcceptBreedingContractResponse"
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     },
                     "400": {
@@ -1403,8 +1679,26 @@ const docTemplatev1 = `{
                             "$ref": "#/definitions/response.Response"
                         }
                     },
+                    "403": {
+                        "description": "非契约接收方",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "契约不存在",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "409": {
+                        "description": "契约状态无效或宠物已易主",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
                     "500": {
-                        "description": "服务器错误",
+                        "description": "契约已过期、繁殖条件不满足或服务器错误",
                         "schema": {
                             "$ref": "#/definitions/response.Response"
                         }
@@ -1412,14 +1706,14 @@ const docTemplatev1 = `{
                 }
             }
         },
-        "/social/trades/cancel/{id}": {
+        "/pet/breed/contracts/{id}/reject": {
             "post": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "取消指定的交易请求",
+                "description": "接收方拒绝或发起方撤回待确认的繁殖契约，双方都会收到通知",
                 "consumes": [
                     "application/json"
                 ],
@@ -1427,13 +1721,13 @@ const docTemplatev1 = `{
                     "application/json"
                 ],
                 "tags": [
-                    "social"
+                    "pet"
                 ],
-                "summary": "取消交易",
+                "summary": "拒绝或撤回繁殖契约",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "交易ID",
+                        "description": "契约ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -1441,9 +1735,21 @@ const docTemplatev1 = `{
                 ],
                 "responses": {
                     "200": {
-                        "description": "交易已取消",
+                        "description": "操作成功",
                         "schema": {
-                            "$ref": "#/definitions/response.Response"
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/pet.BreedingContractDTO"
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     },
                     "400": {
@@ -1452,6 +1758,24 @@ const docTemplatev1 = `{
                             "$ref": "#/definitions/response.Response"
                         }
                     },
+                    "403": {
+                        "description": "非契约当事人",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "契约不存在",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "409": {
+                        "description": "契约状态无效",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
                     "500": {
                         "description": "服务器错误",
                         "schema": {
@@ -1461,14 +1785,14 @@ const docTemplatev1 = `{
                 }
             }
         },
-        "/social/visit/{userId}": {
-            "get": {
+        "/pet/breed/predict": {
+            "post": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "拜访好友的宠物，可获得奖励",
+                "description": "预测两只宠物（或单只分裂繁殖）后代可能的物种及概率，包括隐藏物种，并给出双亲的近交系数供客户端提醒",
                 "consumes": [
                     "application/json"
                 ],
@@ -1476,23 +1800,37 @@ const docTemplatev1 = `{
                     "application/json"
                 ],
                 "tags": [
-                    "social"
+                    "pet"
                 ],
-                "summary": "拜访好友",
+                "summary": "预测后代物种",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "好友用户ID",
-                        "name": "userId",
-                        "in": "path",
-                        "required": true
+                        "description": "预测请求",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/pet.PredictOffspringRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "拜访成功",
+                        "description": "预测成功",
                         "schema": {
-                            "$ref": "#/definitions/response.Response"
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/pet.PredictOffspringResponse"
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     },
                     "400": {
@@ -1501,6 +1839,18 @@ const docTemplatev1 = `{
                             "$ref": "#/definitions/response.Response"
                         }
                     },
+                    "403": {
+                        "description": "非宠物主人",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "宠物不存在",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
                     "500": {
                         "description": "服务器错误",
                         "schema": {
@@ -1509,384 +1859,4174 @@ const docTemplatev1 = `{
                     }
                 }
             }
-        }
-    },
-    "definitions": {
-        "auth.KickRequest": {
-            "type": "object",
-            "required": [
-                "userId"
-            ],
-            "properties": {
-                "userId": {
-                    "type": "integer"
-                }
-            }
-        },
-        "auth.LoginRequest": {
-            "type": "object",
-            "required": [
-                "password",
-                "username"
-            ],
-            "properties": {
-                "password": {
-                    "description": "密码",
-                    "type": "string",
-                    "maxLength": 32,
-                    "minLength": 6
-                },
-                "username": {
-                    "description": "用户名",
-                    "type": "string",
-                    "maxLength": 20,
-                    "minLength": 6
-                }
-            }
         },
-        "auth.LoginResponse": {
-            "type": "object",
-            "properties": {
-                "token": {
-                    "description": "JWT Token",
-                    "type": "string"
-                },
-                "userInfo": {
-                    "description": "用户信息",
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/auth.UserInfo"
+        "/pet/clean": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "清洁宠物，增加清洁度",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "pet"
+                ],
+                "summary": "清洁宠物",
+                "responses": {
+                    "200": {
+                        "description": "清洁成功",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/pet.CleanPetResponse"
+                                        }
+                                    }
+                                }
+                            ]
                         }
-                    ]
+                    },
+                    "400": {
+                        "description": "请求参数错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "服务器错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
                 }
             }
         },
-        "auth.RegisterRequest": {
-            "type": "object",
-            "required": [
-                "nickname",
-                "password",
-                "username"
-            ],
-            "properties": {
+        "/pet/feed": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "使用食物道具喂食宠物，增加饱食度和经验值，可能升级",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "pet"
+                ],
+                "summary": "喂食宠物",
+                "parameters": [
+                    {
+                        "description": "喂食请求",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/pet.FeedPetRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "喂食成功",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/pet.FeedPetResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "请求参数错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "服务器错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/pet/friends/{userId}/clean": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "拜访时帮好友的主宠物清洁，增加双方亲密度，每天对同一好友的照顾次数有上限",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "pet"
+                ],
+                "summary": "清洁好友宠物",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "好友用户ID",
+                        "name": "userId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "清洁成功",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/pet.FriendCareResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "请求参数错误、非好友或宠物状态不允许",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "服务器错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/pet/friends/{userId}/feed": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "拜访时用自己背包里的食物喂好友的主宠物，增加双方亲密度（好友宠物越忠诚增加越多），每天对同一好友的照顾次数有上限",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "pet"
+                ],
+                "summary": "喂食好友宠物",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "好友用户ID",
+                        "name": "userId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "喂食请求",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/pet.FeedPetRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "喂食成功",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/pet.FriendCareResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "请求参数错误、非好友或宠物状态不允许",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "服务器错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/pet/friends/{userId}/play": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "拜访时陪好友的主宠物玩耍，增加双方亲密度，每天对同一好友的照顾次数有上限",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "pet"
+                ],
+                "summary": "陪好友宠物玩耍",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "好友用户ID",
+                        "name": "userId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "玩耍成功",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/pet.FriendCareResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "请求参数错误、非好友或宠物状态不允许",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "服务器错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/pet/genome/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "把宠物的 40 位基因码拆成通用外观、物种特征、性格、技能/能力、遗传隐藏五个片段，逐位给出原始值、解读（物种特征由物种解释器解读）和在全部宠物中的百分位；遗传隐藏片段需要背包中有基因扫描仪才能查看",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "pet"
+                ],
+                "summary": "基因组报告",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "宠物ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "获取成功",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/pet.GenomeResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "请求参数错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "403": {
+                        "description": "非宠物主人",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "服务器错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/pet/growth": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "获取宠物所属物种的升级经验曲线和进化等级，以及距离下一阶段还需的经验；获得的经验按基因成长速率修正",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "pet"
+                ],
+                "summary": "等级曲线预览",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "宠物ID",
+                        "name": "petId",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "获取成功",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/pet.GrowthPreviewDTO"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "请求参数错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "403": {
+                        "description": "非宠物主人",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "还没有宠物",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "服务器错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/pet/hatch": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "主宠物的蛋到达孵化时间后孵化，进入幼年期",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "pet"
+                ],
+                "summary": "孵化",
+                "responses": {
+                    "200": {
+                        "description": "孵化成功",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/pet.HatchPetResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "已孵化或未到孵化时间",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "服务器错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/pet/lineage/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "获取宠物向上若干代的祖先和全部后代（含已离开的宠物），每只宠物附带物种、性别和主人；分裂繁殖的后代只有一位亲本",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "pet"
+                ],
+                "summary": "血统图谱",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "宠物ID（在世的或纪念馆中的）",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 3,
+                        "description": "向上追溯的祖先代数，最多 10",
+                        "name": "generations",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "获取成功",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/pet.LineageResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "请求参数错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "403": {
+                        "description": "非宠物主人",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "服务器错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/pet/lineage/{id}/dot": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "以 Graphviz DOT 格式返回血统图谱，可直接用 dot -Tsvg 渲染；已离开的宠物为虚线框",
+                "produces": [
+                    "text/plain"
+                ],
+                "tags": [
+                    "pet"
+                ],
+                "summary": "血统图谱（Graphviz）",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "宠物ID（在世的或纪念馆中的）",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 3,
+                        "description": "向上追溯的祖先代数，最多 10",
+                        "name": "generations",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "DOT 文本",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "请求参数错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "403": {
+                        "description": "非宠物主人",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "服务器错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/pet/memorials": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "获取我已离世宠物的纪念记录，保留生平数据、血统和生平成就",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "pet"
+                ],
+                "summary": "纪念馆",
+                "responses": {
+                    "200": {
+                        "description": "获取成功",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/pet.MemorialListResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "服务器错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/pet/ownership/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "按时间先后列出宠物的每一次易主（出售或赠送），只有现任主人可查看",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "pet"
+                ],
+                "summary": "宠物历任主人",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "宠物ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "获取成功",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/pet.OwnershipHistoryResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "请求参数错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "403": {
+                        "description": "非宠物主人",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "服务器错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/pet/play": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "与宠物互动玩耍，增加快乐度和经验值，消耗精力；增加的快乐度受情绪影响，闹别扭的宠物拒绝玩耍",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "pet"
+                ],
+                "summary": "和宠物玩耍",
+                "responses": {
+                    "200": {
+                        "description": "玩耍成功",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/pet.PlayPetResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "请求参数错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "服务器错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/pet/random-events": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "获取宠物最近经历的随机事件。照顾宠物时有概率触发随机事件，好奇心越高、探险家技能越强越容易触发",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "pet"
+                ],
+                "summary": "随机事件记录",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "宠物ID",
+                        "name": "petId",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "获取成功",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/pet.RandomEventListResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "请求参数错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "403": {
+                        "description": "非宠物主人",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "还没有宠物",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "服务器错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/pet/roster": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "按排序获取我拥有的全部宠物及其实时状态，以及栏位上限和扩充价格",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "pet"
+                ],
+                "summary": "我的宠物栏",
+                "responses": {
+                    "200": {
+                        "description": "获取成功",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/pet.RosterResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "服务器错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/pet/roster/expand": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "花费钻石扩充一个宠物栏位，最多扩充到栏位上限",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "pet"
+                ],
+                "summary": "扩充宠物栏",
+                "responses": {
+                    "200": {
+                        "description": "扩充成功",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/pet.ExpandRosterResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "409": {
+                        "description": "已扩充到上限",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "钻石不足或服务器错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/pet/roster/order": {
+            "put": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "按给定的宠物ID顺序重排宠物栏，需包含全部宠物且不能重复",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "pet"
+                ],
+                "summary": "宠物栏排序",
+                "parameters": [
+                    {
+                        "description": "排序请求",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/pet.ReorderRosterRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "排序成功",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/pet.RosterResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "请求参数错误或排序不完整",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "服务器错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/pet/roster/{id}/name": {
+            "put": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "给自己的任意一只宠物改名，名称长度为 1-20 个字符",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "pet"
+                ],
+                "summary": "宠物改名",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "宠物ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "改名请求",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/pet.RenamePetRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "改名成功",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/pet.RenamePetResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "请求参数错误或与原名相同",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "403": {
+                        "description": "非宠物主人",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "服务器错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/pet/roster/{id}/release": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "输入宠物名字确认后放生，宠物转入纪念馆（保留生平与血统），装饰退回背包，挂单和待处理的繁殖契约一并撤回",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "pet"
+                ],
+                "summary": "放生宠物",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "宠物ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "放生请求",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/pet.ReleasePetRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "放生成功",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/pet.ReleasePetResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "请求参数错误或名字不匹配",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "403": {
+                        "description": "非宠物主人",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "服务器错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/pet/self-breed": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "支持无性繁殖的物种可由单只宠物分裂出后代，基因自我复制并有概率突变",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "pet"
+                ],
+                "summary": "分裂繁殖",
+                "parameters": [
+                    {
+                        "description": "分裂繁殖请求",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/pet.SelfBreedRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "繁殖成功",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/pet.BreedPetsResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "请求参数错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "403": {
+                        "description": "非宠物主人",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "宠物不存在",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "繁殖条件不满足（冷却中、物种不支持分裂等）或服务器错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/pet/status": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "获取当前用户宠物的轻量状态信息（实时状态与版本信息）",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "pet"
+                ],
+                "summary": "获取宠物轻量状态",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "宠物ID",
+                        "name": "petId",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "获取成功",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/pet.PetStatusDTO"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "请求参数错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "403": {
+                        "description": "非宠物主人",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "还没有宠物",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "服务器错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/pet/warm": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "给主宠物的蛋保温，缩短孵化时间，两次保温之间有冷却",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "pet"
+                ],
+                "summary": "给蛋保温",
+                "responses": {
+                    "200": {
+                        "description": "保温成功",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/pet.WarmEggResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "已孵化、冷却中或已可孵化",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "服务器错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/ranking": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "获取排行榜数据，支持宠物等级、成就数量、亲密度等类型",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "ranking"
+                ],
+                "summary": "获取排行榜",
+                "parameters": [
+                    {
+                        "enum": [
+                            "pet_level",
+                            "achievement",
+                            "intimacy"
+                        ],
+                        "type": "string",
+                        "description": "排行榜类型",
+                        "name": "type",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 0,
+                        "description": "偏移量",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 20,
+                        "description": "数量限制",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "获取成功",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/ranking.RankingResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "请求参数错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "服务器错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/social/friends": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "获取当前用户的好友列表，包括亲密度等信息",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "social"
+                ],
+                "summary": "获取好友列表",
+                "responses": {
+                    "200": {
+                        "description": "获取成功",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/social.FriendListResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "服务器错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/social/friends/accept/{id}": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "接受指定的好友申请",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "social"
+                ],
+                "summary": "接受好友申请",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "好友申请ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "已添加为好友",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "请求参数错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "服务器错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/social/friends/request": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "向指定用户发送好友申请",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "social"
+                ],
+                "summary": "发送好友申请",
+                "parameters": [
+                    {
+                        "description": "好友申请",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/social.AddFriendRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "申请已发送",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "请求参数错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "服务器错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/social/friends/requests": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "获取待处理的好友申请列表",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "social"
+                ],
+                "summary": "获取好友申请列表",
+                "responses": {
+                    "200": {
+                        "description": "获取成功",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "服务器错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/social/gifts": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "获取当前用户收到的礼物列表",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "social"
+                ],
+                "summary": "获取收到的礼物",
+                "responses": {
+                    "200": {
+                        "description": "获取成功",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "服务器错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "向好友发送礼物",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "social"
+                ],
+                "summary": "发送礼物",
+                "parameters": [
+                    {
+                        "description": "礼物请求",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/social.SendGiftRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "发送成功",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "请求参数错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "服务器错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/social/trades": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "获取当前用户发起和收到的交易记录，已过期的交易会自动退还托管道具",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "social"
+                ],
+                "summary": "获取交易列表",
+                "responses": {
+                    "200": {
+                        "description": "获取成功",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/social.TradeListResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "服务器错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "向好友发起以物易物的交易，提供的道具立即从背包扣除并托管，直到交易完成、取消或过期",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "social"
+                ],
+                "summary": "创建交易",
+                "parameters": [
+                    {
+                        "description": "交易请求",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/social.CreateTradeRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "创建成功",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/social.TradeDTO"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "请求参数错误、非好友或道具不足",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "服务器错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/social/trades/accept/{id}": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "接收方接受交易，双方道具在同一事务中交换",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "social"
+                ],
+                "summary": "接受交易",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "交易ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "交易完成",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/social.TradeDTO"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "请求参数错误、交易已过期或道具不足",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "403": {
+                        "description": "非交易接收方",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "服务器错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/social/trades/cancel/{id}": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "发起方撤回或接收方拒绝交易，托管道具退还发起方",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "social"
+                ],
+                "summary": "取消交易",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "交易ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "交易已取消",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/social.TradeDTO"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "请求参数错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "403": {
+                        "description": "非交易参与方",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "服务器错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/social/visit/{userId}": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "查看好友主宠物的实时状态；每天首次拜访某位好友时双方获得金币（按宠物社交性加成）并增加亲密度，每位主人每天接待的访客有上限",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "social"
+                ],
+                "summary": "拜访好友",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "好友用户ID",
+                        "name": "userId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "拜访成功",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/social.VisitResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "请求参数错误、非好友或拜访自己",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "409": {
+                        "description": "对方今天接待的访客已满",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "服务器错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "auth.KickRequest": {
+            "type": "object",
+            "required": [
+                "userId"
+            ],
+            "properties": {
+                "userId": {
+                    "type": "integer"
+                }
+            }
+        },
+        "auth.LoginRequest": {
+            "type": "object",
+            "required": [
+                "password",
+                "username"
+            ],
+            "properties": {
+                "password": {
+                    "description": "密码",
+                    "type": "string",
+                    "maxLength": 32,
+                    "minLength": 6
+                },
+                "username": {
+                    "description": "用户名",
+                    "type": "string",
+                    "maxLength": 20,
+                    "minLength": 6
+                }
+            }
+        },
+        "auth.LoginResponse": {
+            "type": "object",
+            "properties": {
+                "token": {
+                    "description": "JWT Token",
+                    "type": "string"
+                },
+                "userInfo": {
+                    "description": "用户信息",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/auth.UserInfo"
+                        }
+                    ]
+                }
+            }
+        },
+        "auth.RegisterRequest": {
+            "type": "object",
+            "required": [
+                "nickname",
+                "password",
+                "username"
+            ],
+            "properties": {
                 "nickname": {
                     "description": "昵称",
                     "type": "string",
                     "maxLength": 20,
-                    "minLength": 2
+                    "minLength": 2
+                },
+                "password": {
+                    "description": "密码",
+                    "type": "string",
+                    "maxLength": 32,
+                    "minLength": 6
+                },
+                "username": {
+                    "description": "用户名，只能包含字母、数字和下划线",
+                    "type": "string",
+                    "maxLength": 20,
+                    "minLength": 6
+                }
+            }
+        },
+        "auth.UserInfo": {
+            "type": "object",
+            "properties": {
+                "avatarUrl": {
+                    "type": "string"
+                },
+                "coins": {
+                    "type": "integer"
+                },
+                "diamonds": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "nickname": {
+                    "type": "string"
+                }
+            }
+        },
+        "auth.WxLoginRequest": {
+            "type": "object",
+            "required": [
+                "code"
+            ],
+            "properties": {
+                "code": {
+                    "description": "微信登录凭证",
+                    "type": "string"
+                }
+            }
+        },
+        "auth.WxLoginResponse": {
+            "type": "object",
+            "properties": {
+                "isNew": {
+                    "description": "是否新用户",
+                    "type": "boolean"
+                },
+                "token": {
+                    "description": "JWT Token",
+                    "type": "string"
+                },
+                "userInfo": {
+                    "description": "用户信息",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/auth.UserInfo"
+                        }
+                    ]
+                }
+            }
+        },
+        "item.BagItemDTO": {
+            "type": "object",
+            "properties": {
+                "description": {
+                    "type": "string"
+                },
+                "effectType": {
+                    "type": "string"
+                },
+                "effectValue": {
+                    "type": "integer"
+                },
+                "itemId": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "quantity": {
+                    "type": "integer"
+                },
+                "rarity": {
+                    "type": "integer"
+                },
+                "type": {
+                    "type": "integer"
+                },
+                "typeName": {
+                    "type": "string"
+                }
+            }
+        },
+        "item.BagResponse": {
+            "type": "object",
+            "properties": {
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/item.BagItemDTO"
+                    }
+                }
+            }
+        },
+        "item.BuyItemRequest": {
+            "type": "object",
+            "required": [
+                "itemId",
+                "quantity"
+            ],
+            "properties": {
+                "itemId": {
+                    "description": "道具ID",
+                    "type": "integer"
+                },
+                "quantity": {
+                    "description": "购买数量",
+                    "type": "integer",
+                    "maximum": 99,
+                    "minimum": 1
+                }
+            }
+        },
+        "item.BuyItemResponse": {
+            "type": "object",
+            "properties": {
+                "coins": {
+                    "description": "剩余金币",
+                    "type": "integer"
+                },
+                "cost": {
+                    "description": "花费",
+                    "type": "integer"
+                },
+                "diamonds": {
+                    "description": "剩余钻石",
+                    "type": "integer"
+                },
+                "itemId": {
+                    "type": "integer"
+                },
+                "quantity": {
+                    "description": "购买后持有数量",
+                    "type": "integer"
+                }
+            }
+        },
+        "item.DecayBonusDTO": {
+            "type": "object",
+            "properties": {
+                "cleanliness": {
+                    "type": "integer"
+                },
+                "happiness": {
+                    "type": "integer"
+                },
+                "hunger": {
+                    "type": "integer"
+                }
+            }
+        },
+        "item.DecorationDTO": {
+            "type": "object",
+            "properties": {
+                "equippedAt": {
+                    "type": "string"
+                },
+                "itemId": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "rarity": {
+                    "type": "integer"
+                },
+                "slot": {
+                    "type": "string"
+                },
+                "slotName": {
+                    "type": "string"
+                }
+            }
+        },
+        "item.EquipDecorationRequest": {
+            "type": "object",
+            "required": [
+                "itemId"
+            ],
+            "properties": {
+                "itemId": {
+                    "description": "装饰品道具ID（槽位由道具决定）",
+                    "type": "integer"
+                },
+                "petId": {
+                    "description": "宠物ID，不传则为当前主宠物",
+                    "type": "integer"
+                }
+            }
+        },
+        "item.ItemEffectDTO": {
+            "type": "object",
+            "properties": {
+                "type": {
+                    "type": "string"
+                },
+                "value": {
+                    "type": "integer"
+                }
+            }
+        },
+        "item.PetDecorationsResponse": {
+            "type": "object",
+            "properties": {
+                "decayBonus": {
+                    "$ref": "#/definitions/item.DecayBonusDTO"
+                },
+                "decorations": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/item.DecorationDTO"
+                    }
+                },
+                "petId": {
+                    "type": "integer"
+                }
+            }
+        },
+        "item.PetStatusDTO": {
+            "type": "object",
+            "properties": {
+                "cleanliness": {
+                    "type": "integer"
+                },
+                "energy": {
+                    "type": "integer"
+                },
+                "exp": {
+                    "type": "integer"
+                },
+                "happiness": {
+                    "type": "integer"
+                },
+                "hatchAt": {
+                    "description": "蛋的预计孵化时间（孵化器生效后）",
+                    "type": "string"
+                },
+                "hunger": {
+                    "type": "integer"
+                },
+                "illness": {
+                    "description": "疾病名称，健康时为\"健康\"",
+                    "type": "string"
+                },
+                "isSick": {
+                    "type": "boolean"
+                },
+                "level": {
+                    "type": "integer"
+                },
+                "mood": {
+                    "description": "情绪：content, happy, excited, grumpy, sulking, sleepy",
+                    "type": "string"
+                },
+                "moodName": {
+                    "description": "情绪名称",
+                    "type": "string"
+                }
+            }
+        },
+        "item.ShopItemDTO": {
+            "type": "object",
+            "properties": {
+                "currency": {
+                    "description": "0金币 1钻石",
+                    "type": "integer"
+                },
+                "currencyName": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "effectType": {
+                    "type": "string"
+                },
+                "effectValue": {
+                    "type": "integer"
+                },
+                "itemId": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "price": {
+                    "type": "integer"
+                },
+                "rarity": {
+                    "type": "integer"
+                },
+                "type": {
+                    "type": "integer"
+                },
+                "typeName": {
+                    "type": "string"
+                }
+            }
+        },
+        "item.ShopResponse": {
+            "type": "object",
+            "properties": {
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/item.ShopItemDTO"
+                    }
+                }
+            }
+        },
+        "item.UnequipDecorationRequest": {
+            "type": "object",
+            "required": [
+                "slot"
+            ],
+            "properties": {
+                "petId": {
+                    "description": "宠物ID，不传则为当前主宠物",
+                    "type": "integer"
+                },
+                "slot": {
+                    "description": "槽位",
+                    "type": "string",
+                    "enum": [
+                        "head",
+                        "body",
+                        "accessory"
+                    ]
+                }
+            }
+        },
+        "item.UseItemRequest": {
+            "type": "object",
+            "required": [
+                "itemId"
+            ],
+            "properties": {
+                "itemId": {
+                    "description": "道具ID",
+                    "type": "integer"
+                },
+                "newName": {
+                    "description": "新名字（改名卡使用）",
+                    "type": "string",
+                    "maxLength": 20,
+                    "minLength": 1
+                }
+            }
+        },
+        "item.UseItemResponse": {
+            "type": "object",
+            "properties": {
+                "effects": {
+                    "description": "生效的效果（可组合多个）",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/item.ItemEffectDTO"
+                    }
+                },
+                "itemId": {
+                    "type": "integer"
+                },
+                "levelUp": {
+                    "description": "是否升级",
+                    "type": "boolean"
+                },
+                "name": {
+                    "description": "宠物名字（改名后为新名字）",
+                    "type": "string"
+                },
+                "newLevel": {
+                    "description": "当前等级",
+                    "type": "integer"
+                },
+                "petId": {
+                    "description": "作用的宠物",
+                    "type": "integer"
+                },
+                "remaining": {
+                    "description": "剩余数量",
+                    "type": "integer"
+                },
+                "skillLevel": {
+                    "description": "当前技能等级",
+                    "type": "integer"
+                },
+                "skillLevelUp": {
+                    "description": "技能是否升级",
+                    "type": "boolean"
+                },
+                "status": {
+                    "description": "使用后的宠物状态",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/item.PetStatusDTO"
+                        }
+                    ]
+                }
+            }
+        },
+        "pet.AbilityDTO": {
+            "type": "object",
+            "properties": {
+                "description": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "pet.AcceptBreedingContractRequest": {
+            "type": "object",
+            "properties": {
+                "childName": {
+                    "description": "接受方为自己那只后代取的名字（可选）",
+                    "type": "string",
+                    "maxLength": 20
+                }
+            }
+        },
+        "pet.AcceptBreedingContractResponse": {
+            "type": "object",
+            "properties": {
+                "contract": {
+                    "$ref": "#/definitions/pet.BreedingContractDTO"
+                },
+                "inbreeding": {
+                    "description": "近亲程度及对后代的影响",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/pet.InbreedingDTO"
+                        }
+                    ]
+                },
+                "offspring": {
+                    "description": "本窝全部后代",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pet.PetDetailDTO"
+                    }
+                }
+            }
+        },
+        "pet.AdoptPetResponse": {
+            "type": "object",
+            "properties": {
+                "listing": {
+                    "$ref": "#/definitions/pet.AdoptionListingDTO"
+                },
+                "pet": {
+                    "description": "领养到的宠物",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/pet.PetDetailDTO"
+                        }
+                    ]
+                }
+            }
+        },
+        "pet.AdoptionListResponse": {
+            "type": "object",
+            "properties": {
+                "listings": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pet.AdoptionListingDTO"
+                    }
+                }
+            }
+        },
+        "pet.AdoptionListingDTO": {
+            "type": "object",
+            "properties": {
+                "buyerId": {
+                    "type": "integer"
+                },
+                "completedAt": {
+                    "type": "string"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "currency": {
+                    "type": "integer"
+                },
+                "currencyName": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "pet": {
+                    "$ref": "#/definitions/pet.AdoptionPetDTO"
+                },
+                "petId": {
+                    "type": "integer"
+                },
+                "price": {
+                    "type": "integer"
+                },
+                "recipientId": {
+                    "type": "integer"
+                },
+                "sellerId": {
+                    "type": "integer"
+                },
+                "status": {
+                    "type": "integer"
+                },
+                "statusName": {
+                    "type": "string"
+                }
+            }
+        },
+        "pet.AdoptionPetDTO": {
+            "type": "object",
+            "properties": {
+                "gender": {
+                    "type": "string"
+                },
+                "generation": {
+                    "type": "integer"
+                },
+                "level": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "speciesId": {
+                    "type": "integer"
+                },
+                "stage": {
+                    "type": "string"
+                }
+            }
+        },
+        "pet.AppearanceDTO": {
+            "type": "object",
+            "properties": {
+                "bodyType": {
+                    "type": "string"
+                },
+                "colorPrimary": {
+                    "type": "string"
+                },
+                "colorSecondary": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "patternType": {
+                    "type": "string"
+                }
+            }
+        },
+        "pet.BreedPetsRequest": {
+            "type": "object",
+            "required": [
+                "childName",
+                "parent1Id",
+                "parent2Id"
+            ],
+            "properties": {
+                "childName": {
+                    "description": "后代名称",
+                    "type": "string",
+                    "maxLength": 20,
+                    "minLength": 1
+                },
+                "parent1Id": {
+                    "description": "父母1 ID",
+                    "type": "integer"
+                },
+                "parent2Id": {
+                    "description": "父母2 ID",
+                    "type": "integer"
+                }
+            }
+        },
+        "pet.BreedPetsResponse": {
+            "type": "object",
+            "properties": {
+                "inbreeding": {
+                    "description": "近亲程度及对后代的影响",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/pet.InbreedingDTO"
+                        }
+                    ]
+                },
+                "inheritedGenes": {
+                    "description": "继承的基因特征",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "mutations": {
+                    "description": "发生的变异",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "offspring": {
+                    "description": "后代",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/pet.PetDetailDTO"
+                        }
+                    ]
+                },
+                "parent1Updated": {
+                    "description": "父母1更新后状态",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/pet.PetBreedingStatusDTO"
+                        }
+                    ]
+                },
+                "parent2Updated": {
+                    "description": "父母2更新后状态（分裂繁殖时为nil）",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/pet.PetBreedingStatusDTO"
+                        }
+                    ]
+                }
+            }
+        },
+        "pet.BreedingContractDTO": {
+            "type": "object",
+            "properties": {
+                "assignment": {
+                    "type": "integer"
+                },
+                "assignmentName": {
+                    "type": "string"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "expiresAt": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "offspringIds": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "partnerId": {
+                    "type": "integer"
+                },
+                "partnerPetId": {
+                    "type": "integer"
+                },
+                "proposerId": {
+                    "type": "integer"
+                },
+                "proposerPetId": {
+                    "type": "integer"
+                },
+                "respondedAt": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "integer"
+                },
+                "statusName": {
+                    "type": "string"
+                }
+            }
+        },
+        "pet.BreedingContractListResponse": {
+            "type": "object",
+            "properties": {
+                "contracts": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pet.BreedingContractDTO"
+                    }
+                }
+            }
+        },
+        "pet.CanBreedResponse": {
+            "type": "object",
+            "properties": {
+                "canBreed": {
+                    "type": "boolean"
+                },
+                "cooldownSeconds": {
+                    "description": "繁殖冷却剩余秒数（取双亲中较长者）",
+                    "type": "integer"
+                },
+                "nextBreedAt": {
+                    "description": "冷却结束时间",
+                    "type": "string"
+                },
+                "reason": {
+                    "description": "不能繁殖的原因",
+                    "type": "string"
+                }
+            }
+        },
+        "pet.CareHistoryDTO": {
+            "type": "object",
+            "properties": {
+                "averageCleanliness": {
+                    "description": "照顾时的平均清洁度",
+                    "type": "integer"
+                },
+                "averageHappiness": {
+                    "description": "照顾时的平均快乐度",
+                    "type": "integer"
+                },
+                "cleanCount": {
+                    "type": "integer"
+                },
+                "feedCount": {
+                    "type": "integer"
+                },
+                "playCount": {
+                    "type": "integer"
+                }
+            }
+        },
+        "pet.CleanPetResponse": {
+            "type": "object",
+            "properties": {
+                "cleanliness": {
+                    "description": "当前清洁度",
+                    "type": "integer"
+                },
+                "randomEvent": {
+                    "description": "本次触发的随机事件",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/pet.RandomEventDTO"
+                        }
+                    ]
+                },
+                "skillLevelUp": {
+                    "description": "技能是否升级",
+                    "type": "boolean"
+                }
+            }
+        },
+        "pet.CreatePetRequest": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "name": {
+                    "description": "宠物名称",
+                    "type": "string",
+                    "maxLength": 20,
+                    "minLength": 1
+                },
+                "speciesId": {
+                    "description": "物种ID（可选，不填则随机）",
+                    "type": "string"
+                }
+            }
+        },
+        "pet.CreatePetResponse": {
+            "type": "object",
+            "properties": {
+                "pet": {
+                    "$ref": "#/definitions/pet.PetDetailDTO"
+                }
+            }
+        },
+        "pet.DecayBonusDTO": {
+            "type": "object",
+            "properties": {
+                "cleanliness": {
+                    "type": "integer"
+                },
+                "happiness": {
+                    "type": "integer"
+                },
+                "hunger": {
+                    "type": "integer"
+                }
+            }
+        },
+        "pet.DecorationDTO": {
+            "type": "object",
+            "properties": {
+                "equippedAt": {
+                    "type": "string"
+                },
+                "itemId": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "slot": {
+                    "type": "string"
+                },
+                "slotName": {
+                    "type": "string"
+                }
+            }
+        },
+        "pet.ExpandRosterResponse": {
+            "type": "object",
+            "properties": {
+                "capacity": {
+                    "type": "integer"
+                },
+                "diamonds": {
+                    "description": "剩余钻石",
+                    "type": "integer"
+                },
+                "maxCapacity": {
+                    "type": "integer"
+                },
+                "slotPrice": {
+                    "type": "integer"
+                }
+            }
+        },
+        "pet.FeedPetRequest": {
+            "type": "object",
+            "required": [
+                "foodItemId"
+            ],
+            "properties": {
+                "foodItemId": {
+                    "description": "食物道具ID",
+                    "type": "integer"
+                }
+            }
+        },
+        "pet.FeedPetResponse": {
+            "type": "object",
+            "properties": {
+                "expGained": {
+                    "description": "获得经验",
+                    "type": "integer"
+                },
+                "hunger": {
+                    "description": "当前饱食度",
+                    "type": "integer"
+                },
+                "levelUp": {
+                    "description": "是否升级",
+                    "type": "boolean"
+                },
+                "newLevel": {
+                    "description": "新等级（如果升级）",
+                    "type": "integer"
+                },
+                "randomEvent": {
+                    "description": "本次触发的随机事件",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/pet.RandomEventDTO"
+                        }
+                    ]
+                },
+                "skillLevelUp": {
+                    "description": "技能是否升级",
+                    "type": "boolean"
+                }
+            }
+        },
+        "pet.FriendCareResponse": {
+            "type": "object",
+            "properties": {
+                "action": {
+                    "description": "feed, play, clean",
+                    "type": "string"
+                },
+                "actionName": {
+                    "description": "喂食、玩耍、清洁",
+                    "type": "string"
+                },
+                "cleanliness": {
+                    "type": "integer"
+                },
+                "energy": {
+                    "type": "integer"
+                },
+                "happiness": {
+                    "type": "integer"
+                },
+                "hunger": {
+                    "type": "integer"
+                },
+                "intimacy": {
+                    "description": "当前亲密度",
+                    "type": "integer"
+                },
+                "intimacyGained": {
+                    "description": "增加的亲密度",
+                    "type": "integer"
+                },
+                "levelUp": {
+                    "type": "boolean"
+                },
+                "petId": {
+                    "type": "integer"
+                },
+                "randomEvent": {
+                    "description": "本次触发的随机事件（奖励归好友）",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/pet.RandomEventDTO"
+                        }
+                    ]
+                },
+                "remainingToday": {
+                    "description": "今天还能帮这位好友照顾的次数",
+                    "type": "integer"
+                }
+            }
+        },
+        "pet.GenomeResponse": {
+            "type": "object",
+            "properties": {
+                "geneCode": {
+                    "description": "基因码，被遮蔽的位用 * 代替",
+                    "type": "string"
+                },
+                "hasScanner": {
+                    "description": "是否有基因扫描仪（可查看隐藏片段）",
+                    "type": "boolean"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "petId": {
+                    "type": "integer"
+                },
+                "poolSize": {
+                    "description": "计算百分位时参与统计的宠物数",
+                    "type": "integer"
+                },
+                "segments": {
+                    "description": "五个基因片段",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pet.GenomeSegmentDTO"
+                    }
+                },
+                "speciesId": {
+                    "type": "integer"
+                }
+            }
+        },
+        "pet.GenomeSegmentDTO": {
+            "type": "object",
+            "properties": {
+                "end": {
+                    "description": "结束基因位（不含）",
+                    "type": "integer"
+                },
+                "hidden": {
+                    "description": "是否为隐藏片段",
+                    "type": "boolean"
+                },
+                "key": {
+                    "description": "appearance, species, personality, skill, hidden",
+                    "type": "string"
+                },
+                "masked": {
+                    "description": "是否被遮蔽（隐藏片段且没有基因扫描仪）",
+                    "type": "boolean"
+                },
+                "name": {
+                    "description": "片段名称",
+                    "type": "string"
+                },
+                "start": {
+                    "description": "起始基因位",
+                    "type": "integer"
+                },
+                "traits": {
+                    "description": "逐位解读，被遮蔽时为空",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pet.GenomeTraitDTO"
+                    }
+                }
+            }
+        },
+        "pet.GenomeTraitDTO": {
+            "type": "object",
+            "properties": {
+                "meaning": {
+                    "description": "解读结果",
+                    "type": "string"
+                },
+                "name": {
+                    "description": "含义，物种特征位为解释器给出的部位",
+                    "type": "string"
+                },
+                "percentile": {
+                    "description": "在全部宠物中的百分位（0-100）",
+                    "type": "integer"
+                },
+                "position": {
+                    "description": "基因位（0-39）",
+                    "type": "integer"
+                },
+                "raw": {
+                    "description": "原始值（0-15）",
+                    "type": "integer"
+                }
+            }
+        },
+        "pet.GenotypeDTO": {
+            "type": "object",
+            "properties": {
+                "carrier": {
+                    "description": "是否携带隐性等位基因（表现为显性，可能隔代遗传给后代）",
+                    "type": "boolean"
+                },
+                "dominant": {
+                    "description": "表现型是否为显性",
+                    "type": "boolean"
+                },
+                "genotype": {
+                    "description": "基因型记号：显性大写、隐性小写，如 Aa",
+                    "type": "string"
+                },
+                "hidden": {
+                    "description": "未表现的等位基因",
+                    "type": "integer"
+                },
+                "masked": {
+                    "description": "没有基因扫描仪时被遮蔽，genotype、hidden、carrier 不返回真实值",
+                    "type": "boolean"
+                },
+                "phenotype": {
+                    "description": "表现出来的等位基因（十六进制值 0-15）",
+                    "type": "integer"
+                },
+                "trait": {
+                    "description": "性状组标识，如 pattern",
+                    "type": "string"
+                },
+                "traitName": {
+                    "description": "性状组名称",
+                    "type": "string"
+                }
+            }
+        },
+        "pet.GrowthLevelDTO": {
+            "type": "object",
+            "properties": {
+                "level": {
+                    "type": "integer"
+                },
+                "requiredExp": {
+                    "description": "升到下一级所需经验",
+                    "type": "integer"
+                }
+            }
+        },
+        "pet.GrowthPreviewDTO": {
+            "type": "object",
+            "properties": {
+                "exp": {
+                    "type": "integer"
+                },
+                "expToNext": {
+                    "description": "升级所需经验",
+                    "type": "integer"
+                },
+                "expToNextStage": {
+                    "description": "距离下一阶段还需的经验（未计成长速率）",
+                    "type": "integer"
+                },
+                "growthRate": {
+                    "description": "基因成长速率，获得的经验按此倍数修正",
+                    "type": "number"
+                },
+                "level": {
+                    "type": "integer"
+                },
+                "levels": {
+                    "description": "从当前等级起的升级经验",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pet.GrowthLevelDTO"
+                    }
+                },
+                "nextStage": {
+                    "description": "由等级决定的下一阶段，蛋和老年期为空",
+                    "type": "string"
+                },
+                "nextStageLevel": {
+                    "description": "进入下一阶段的等级",
+                    "type": "integer"
+                },
+                "petId": {
+                    "type": "integer"
+                },
+                "speciesId": {
+                    "type": "integer"
+                },
+                "stage": {
+                    "type": "string"
+                },
+                "stages": {
+                    "description": "各阶段的进化等级",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pet.GrowthStageDTO"
+                    }
+                }
+            }
+        },
+        "pet.GrowthStageDTO": {
+            "type": "object",
+            "properties": {
+                "level": {
+                    "type": "integer"
+                },
+                "reached": {
+                    "type": "boolean"
+                },
+                "stage": {
+                    "type": "string"
+                }
+            }
+        },
+        "pet.HatchPetResponse": {
+            "type": "object",
+            "properties": {
+                "pet": {
+                    "$ref": "#/definitions/pet.PetDetailDTO"
+                }
+            }
+        },
+        "pet.InbreedingDTO": {
+            "type": "object",
+            "properties": {
+                "blocked": {
+                    "description": "是否禁止繁殖",
+                    "type": "boolean"
+                },
+                "coefficient": {
+                    "description": "近交系数（0-1）：全同胞或亲子为 0.25，半同胞为 0.125",
+                    "type": "number"
+                },
+                "extraMutation": {
+                    "description": "后代额外的突变率（百分比）",
+                    "type": "integer"
+                },
+                "resistancePenalty": {
+                    "description": "后代抗性基因降低的值",
+                    "type": "integer"
+                },
+                "warning": {
+                    "description": "是否需要提醒玩家",
+                    "type": "boolean"
+                }
+            }
+        },
+        "pet.LineageEdgeDTO": {
+            "type": "object",
+            "properties": {
+                "childId": {
+                    "type": "integer"
+                },
+                "parentId": {
+                    "type": "integer"
+                }
+            }
+        },
+        "pet.LineageNodeDTO": {
+            "type": "object",
+            "properties": {
+                "cause": {
+                    "description": "离开的原因：passed_on 寿终，released 放生",
+                    "type": "string"
+                },
+                "departed": {
+                    "description": "是否已离开（寿终或放生）",
+                    "type": "boolean"
+                },
+                "depth": {
+                    "description": "相对查询宠物的代差：祖先为负，后代为正",
+                    "type": "integer"
+                },
+                "gender": {
+                    "type": "string"
+                },
+                "generation": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "ownerId": {
+                    "type": "integer"
+                },
+                "ownerName": {
+                    "type": "string"
+                },
+                "parentIds": {
+                    "description": "亲本ID，分裂繁殖时只有一位",
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "petId": {
+                    "type": "integer"
+                },
+                "speciesId": {
+                    "type": "integer"
+                }
+            }
+        },
+        "pet.LineageResponse": {
+            "type": "object",
+            "properties": {
+                "edges": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pet.LineageEdgeDTO"
+                    }
                 },
-                "password": {
-                    "description": "密码",
-                    "type": "string",
-                    "maxLength": 32,
-                    "minLength": 6
+                "generations": {
+                    "description": "向上追溯的祖先代数",
+                    "type": "integer"
                 },
-                "username": {
-                    "description": "用户名，只能包含字母、数字和下划线",
-                    "type": "string",
-                    "maxLength": 20,
-                    "minLength": 6
+                "nodes": {
+                    "description": "按代差排序",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pet.LineageNodeDTO"
+                    }
+                },
+                "rootId": {
+                    "type": "integer"
+                },
+                "truncated": {
+                    "description": "家族过大时省略了部分后代",
+                    "type": "boolean"
+                }
+            }
+        },
+        "pet.ListPetForAdoptionRequest": {
+            "type": "object",
+            "required": [
+                "petId"
+            ],
+            "properties": {
+                "currency": {
+                    "description": "标价货币：0金币 1钻石（赠送时忽略）",
+                    "type": "integer",
+                    "enum": [
+                        0,
+                        1
+                    ]
+                },
+                "petId": {
+                    "description": "宠物ID",
+                    "type": "integer"
+                },
+                "price": {
+                    "description": "标价（赠送时忽略）",
+                    "type": "integer",
+                    "minimum": 0
+                },
+                "recipientId": {
+                    "description": "赠送对象（好友ID），填写则为赠送",
+                    "type": "integer",
+                    "minimum": 0
+                }
+            }
+        },
+        "pet.MemorialDTO": {
+            "type": "object",
+            "properties": {
+                "achievements": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "bornAt": {
+                    "type": "string"
+                },
+                "cause": {
+                    "description": "离开的原因：passed_on 寿终，released 放生",
+                    "type": "string"
+                },
+                "causeName": {
+                    "description": "原因名称",
+                    "type": "string"
+                },
+                "gender": {
+                    "type": "string"
+                },
+                "geneCode": {
+                    "type": "string"
+                },
+                "generation": {
+                    "type": "integer"
+                },
+                "hatchAt": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "level": {
+                    "type": "integer"
+                },
+                "lifeDays": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "parent1Id": {
+                    "type": "integer"
+                },
+                "parent2Id": {
+                    "type": "integer"
+                },
+                "passedAt": {
+                    "type": "string"
+                },
+                "petId": {
+                    "type": "integer"
+                },
+                "skillLevel": {
+                    "type": "integer"
+                },
+                "skillName": {
+                    "type": "string"
+                },
+                "speciesId": {
+                    "type": "integer"
+                },
+                "stage": {
+                    "type": "string"
+                }
+            }
+        },
+        "pet.MemorialListResponse": {
+            "type": "object",
+            "properties": {
+                "memorials": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pet.MemorialDTO"
+                    }
+                }
+            }
+        },
+        "pet.OwnershipHistoryResponse": {
+            "type": "object",
+            "properties": {
+                "currentOwnerId": {
+                    "type": "integer"
+                },
+                "petId": {
+                    "type": "integer"
+                },
+                "records": {
+                    "description": "按时间先后排列",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pet.OwnershipRecordDTO"
+                    }
+                }
+            }
+        },
+        "pet.OwnershipRecordDTO": {
+            "type": "object",
+            "properties": {
+                "currency": {
+                    "type": "integer"
+                },
+                "currencyName": {
+                    "type": "string"
+                },
+                "fromUserId": {
+                    "type": "integer"
+                },
+                "method": {
+                    "type": "integer"
+                },
+                "methodName": {
+                    "type": "string"
+                },
+                "price": {
+                    "type": "integer"
+                },
+                "toUserId": {
+                    "type": "integer"
+                },
+                "transferredAt": {
+                    "type": "string"
+                }
+            }
+        },
+        "pet.PersonalityDTO": {
+            "type": "object",
+            "properties": {
+                "activity": {
+                    "type": "integer"
+                },
+                "appetite": {
+                    "type": "integer"
+                },
+                "curiosity": {
+                    "type": "integer"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "social": {
+                    "type": "integer"
+                }
+            }
+        },
+        "pet.PetBreedingStatusDTO": {
+            "type": "object",
+            "properties": {
+                "breedingCount": {
+                    "description": "繁殖次数",
+                    "type": "integer"
+                },
+                "cooldownSeconds": {
+                    "description": "繁殖冷却剩余秒数",
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "nextBreedAt": {
+                    "description": "冷却结束时间",
+                    "type": "string"
+                },
+                "remainingBreeds": {
+                    "description": "剩余可繁殖次数",
+                    "type": "integer"
+                }
+            }
+        },
+        "pet.PetDetailDTO": {
+            "type": "object",
+            "properties": {
+                "abilities": {
+                    "description": "特殊能力（最多两个）",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pet.AbilityDTO"
+                    }
+                },
+                "appearance": {
+                    "description": "外观",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/pet.AppearanceDTO"
+                        }
+                    ]
+                },
+                "care": {
+                    "$ref": "#/definitions/pet.CareHistoryDTO"
+                },
+                "decayBonus": {
+                    "description": "装饰带来的状态衰减减免（百分比）",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/pet.DecayBonusDTO"
+                        }
+                    ]
+                },
+                "decorations": {
+                    "description": "装饰",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pet.DecorationDTO"
+                    }
+                },
+                "exp": {
+                    "description": "当前经验",
+                    "type": "integer"
+                },
+                "expToNext": {
+                    "description": "升级所需经验",
+                    "type": "integer"
+                },
+                "form": {
+                    "description": "进化形态（进入成熟期时由基因进化倾向和养育记录决定）",
+                    "type": "string"
+                },
+                "formName": {
+                    "type": "string"
+                },
+                "geneCode": {
+                    "description": "基因码（可选，用于展示独特性），没有基因扫描仪时隐藏片段用 * 代替",
+                    "type": "string"
+                },
+                "genetics": {
+                    "description": "孟德尔性状（物种启用孟德尔遗传时才有）",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pet.GenotypeDTO"
+                    }
+                },
+                "hatchAt": {
+                    "description": "孵化（蛋为预计孵化时间，已孵化为实际孵化时间）",
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "level": {
+                    "description": "等级",
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "passesAt": {
+                    "description": "寿终时间（进入老年期后才有）",
+                    "type": "string"
+                },
+                "personality": {
+                    "description": "性格",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/pet.PersonalityDTO"
+                        }
+                    ]
+                },
+                "secondarySkill": {
+                    "description": "副技能（成长期解锁）",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/pet.SkillDTO"
+                        }
+                    ]
+                },
+                "skill": {
+                    "description": "技能与特殊能力",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/pet.SkillDTO"
+                        }
+                    ]
+                },
+                "stage": {
+                    "description": "成长",
+                    "type": "string"
+                },
+                "status": {
+                    "description": "状态",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/pet.StatusDTO"
+                        }
+                    ]
                 }
             }
         },
-        "auth.UserInfo": {
+        "pet.PetStatusDTO": {
             "type": "object",
             "properties": {
-                "avatarUrl": {
+                "canHatch": {
+                    "description": "蛋是否已可孵化",
+                    "type": "boolean"
+                },
+                "cleanliness": {
+                    "type": "integer"
+                },
+                "energy": {
+                    "type": "integer"
+                },
+                "happiness": {
+                    "type": "integer"
+                },
+                "hatchAt": {
+                    "description": "孵化时间（蛋为预计时间）",
                     "type": "string"
                 },
-                "coins": {
+                "hatchRemaining": {
+                    "description": "距离可孵化的秒数",
                     "type": "integer"
                 },
-                "diamonds": {
+                "hunger": {
                     "type": "integer"
                 },
-                "id": {
+                "illAt": {
+                    "description": "生病时间",
+                    "type": "string"
+                },
+                "illness": {
+                    "description": "疾病名称，健康时为\"健康\"",
+                    "type": "string"
+                },
+                "isDirty": {
+                    "type": "boolean"
+                },
+                "isHungry": {
+                    "type": "boolean"
+                },
+                "isSick": {
+                    "type": "boolean"
+                },
+                "isTired": {
+                    "type": "boolean"
+                },
+                "isUnhappy": {
+                    "type": "boolean"
+                },
+                "mood": {
+                    "description": "情绪：content, happy, excited, grumpy, sulking, sleepy",
+                    "type": "string"
+                },
+                "moodCalm": {
+                    "description": "闹情绪时还需安抚的次数",
                     "type": "integer"
                 },
-                "nickname": {
+                "moodName": {
+                    "description": "情绪名称",
+                    "type": "string"
+                },
+                "revision": {
+                    "type": "integer"
+                },
+                "serverTime": {
+                    "type": "string"
+                },
+                "statusUpdatedAt": {
                     "type": "string"
                 }
             }
         },
-        "auth.WxLoginRequest": {
+        "pet.PlayPetResponse": {
+            "type": "object",
+            "properties": {
+                "energy": {
+                    "description": "当前精力",
+                    "type": "integer"
+                },
+                "expGained": {
+                    "description": "获得经验",
+                    "type": "integer"
+                },
+                "happiness": {
+                    "description": "当前快乐度",
+                    "type": "integer"
+                },
+                "levelUp": {
+                    "description": "是否升级",
+                    "type": "boolean"
+                },
+                "randomEvent": {
+                    "description": "本次触发的随机事件",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/pet.RandomEventDTO"
+                        }
+                    ]
+                },
+                "skillLevelUp": {
+                    "description": "技能是否升级",
+                    "type": "boolean"
+                }
+            }
+        },
+        "pet.PredictOffspringRequest": {
             "type": "object",
             "required": [
-                "code"
+                "parent1Id"
             ],
             "properties": {
-                "code": {
-                    "description": "微信登录凭证",
-                    "type": "string"
+                "parent1Id": {
+                    "type": "integer"
+                },
+                "parent2Id": {
+                    "description": "可选，不填为分裂繁殖",
+                    "type": "integer"
                 }
             }
         },
-        "auth.WxLoginResponse": {
+        "pet.PredictOffspringResponse": {
             "type": "object",
             "properties": {
-                "isNew": {
-                    "description": "是否新用户",
-                    "type": "boolean"
-                },
-                "token": {
-                    "description": "JWT Token",
-                    "type": "string"
-                },
-                "userInfo": {
-                    "description": "用户信息",
+                "inbreeding": {
+                    "description": "近亲程度，warning 为 true 时客户端应提醒玩家",
                     "allOf": [
                         {
-                            "$ref": "#/definitions/auth.UserInfo"
+                            "$ref": "#/definitions/pet.InbreedingDTO"
                         }
                     ]
+                },
+                "possibleSpecies": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pet.SpeciesProbabilityDTO"
+                    }
                 }
             }
         },
-        "handler.BuyRequest": {
+        "pet.ProposeBreedingContractRequest": {
             "type": "object",
             "required": [
-                "itemId",
-                "quantity"
+                "childName",
+                "myPetId",
+                "partnerPetId"
             ],
             "properties": {
-                "itemId": {
-                    "description": "道具ID",
+                "assignment": {
+                    "description": "后代归属：0归发起方 1归接受方 2双方各一只",
+                    "type": "integer",
+                    "enum": [
+                        0,
+                        1,
+                        2
+                    ]
+                },
+                "childName": {
+                    "description": "后代名称",
+                    "type": "string",
+                    "maxLength": 20,
+                    "minLength": 1
+                },
+                "myPetId": {
+                    "description": "我方宠物ID",
                     "type": "integer"
                 },
-                "quantity": {
-                    "description": "购买数量",
-                    "type": "integer",
-                    "minimum": 1
+                "partnerPetId": {
+                    "description": "好友宠物ID",
+                    "type": "integer"
                 }
             }
         },
-        "pet.AppearanceDTO": {
+        "pet.RandomEventDTO": {
             "type": "object",
             "properties": {
-                "bodyType": {
+                "amount": {
+                    "description": "金币数/道具数/状态变化量",
+                    "type": "integer"
+                },
+                "effect": {
+                    "description": "coins, item, status",
                     "type": "string"
                 },
-                "colorPrimary": {
+                "eventId": {
                     "type": "string"
                 },
-                "colorSecondary": {
+                "id": {
+                    "type": "integer"
+                },
+                "itemId": {
+                    "description": "道具效果获得的道具",
+                    "type": "integer"
+                },
+                "message": {
                     "type": "string"
                 },
-                "description": {
+                "name": {
                     "type": "string"
                 },
-                "patternType": {
+                "occurredAt": {
                     "type": "string"
-                }
-            }
-        },
-        "pet.CleanPetResponse": {
-            "type": "object",
-            "properties": {
-                "cleanliness": {
-                    "description": "当前清洁度",
+                },
+                "petId": {
                     "type": "integer"
-                }
-            }
-        },
-        "pet.CreatePetRequest": {
-            "type": "object",
-            "required": [
-                "name"
-            ],
-            "properties": {
-                "name": {
-                    "description": "宠物名称",
-                    "type": "string",
-                    "maxLength": 20,
-                    "minLength": 1
                 },
-                "speciesId": {
-                    "description": "物种ID（可选，不填则随机）",
+                "status": {
+                    "description": "状态效果影响的状态",
                     "type": "string"
                 }
             }
         },
-        "pet.CreatePetResponse": {
+        "pet.RandomEventListResponse": {
             "type": "object",
             "properties": {
-                "pet": {
-                    "$ref": "#/definitions/pet.PetDetailDTO"
+                "events": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pet.RandomEventDTO"
+                    }
                 }
             }
         },
-        "pet.FeedPetRequest": {
+        "pet.ReleasePetRequest": {
             "type": "object",
             "required": [
-                "foodItemId"
+                "confirmName"
             ],
             "properties": {
-                "foodItemId": {
-                    "description": "食物道具ID",
-                    "type": "integer"
-                }
-            }
-        },
-        "pet.FeedPetResponse": {
-            "type": "object",
-            "properties": {
-                "expGained": {
-                    "description": "获得经验",
-                    "type": "integer"
-                },
-                "hunger": {
-                    "description": "当前饱食度",
-                    "type": "integer"
-                },
-                "levelUp": {
-                    "description": "是否升级",
-                    "type": "boolean"
-                },
-                "newLevel": {
-                    "description": "新等级（如果升级）",
-                    "type": "integer"
+                "confirmName": {
+                    "description": "需输入宠物名字确认",
+                    "type": "string"
                 }
             }
         },
-        "pet.PersonalityDTO": {
+        "pet.ReleasePetResponse": {
             "type": "object",
             "properties": {
-                "activity": {
-                    "type": "integer"
-                },
-                "appetite": {
-                    "type": "integer"
-                },
-                "curiosity": {
-                    "type": "integer"
+                "memorial": {
+                    "$ref": "#/definitions/pet.MemorialDTO"
                 },
-                "description": {
-                    "type": "string"
-                },
-                "social": {
+                "petId": {
                     "type": "integer"
                 }
             }
         },
-        "pet.PetDetailDTO": {
+        "pet.RenamePetRequest": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "name": {
+                    "type": "string",
+                    "maxLength": 20,
+                    "minLength": 1
+                }
+            }
+        },
+        "pet.RenamePetResponse": {
             "type": "object",
             "properties": {
-                "appearance": {
-                    "description": "外观",
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/pet.AppearanceDTO"
-                        }
-                    ]
-                },
-                "exp": {
-                    "description": "当前经验",
-                    "type": "integer"
-                },
-                "expToNext": {
-                    "description": "升级所需经验",
-                    "type": "integer"
-                },
-                "geneCode": {
-                    "description": "基因码（可选，用于展示独特性）",
-                    "type": "string"
-                },
-                "id": {
-                    "type": "integer"
-                },
-                "level": {
-                    "description": "等级",
-                    "type": "integer"
-                },
                 "name": {
                     "type": "string"
                 },
-                "personality": {
-                    "description": "性格",
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/pet.PersonalityDTO"
-                        }
-                    ]
-                },
-                "skill": {
-                    "description": "技能",
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/pet.SkillDTO"
-                        }
-                    ]
-                },
-                "stage": {
-                    "description": "成长",
-                    "type": "string"
-                },
-                "status": {
-                    "description": "状态",
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/pet.StatusDTO"
-                        }
-                    ]
+                "petId": {
+                    "type": "integer"
                 }
             }
         },
-        "pet.PetStatusDTO": {
+        "pet.ReorderRosterRequest": {
+            "type": "object",
+            "required": [
+                "petIds"
+            ],
+            "properties": {
+                "petIds": {
+                    "description": "全部宠物ID，按期望顺序排列",
+                    "type": "array",
+                    "minItems": 1,
+                    "items": {
+                        "type": "integer"
+                    }
+                }
+            }
+        },
+        "pet.RosterPetDTO": {
             "type": "object",
             "properties": {
+                "canHatch": {
+                    "description": "蛋是否已可孵化",
+                    "type": "boolean"
+                },
                 "cleanliness": {
                     "type": "integer"
                 },
                 "energy": {
                     "type": "integer"
                 },
+                "gender": {
+                    "type": "string"
+                },
+                "generation": {
+                    "type": "integer"
+                },
                 "happiness": {
                     "type": "integer"
                 },
+                "hatchAt": {
+                    "description": "孵化时间（蛋为预计时间）",
+                    "type": "string"
+                },
+                "hatchRemaining": {
+                    "description": "距离可孵化的秒数",
+                    "type": "integer"
+                },
                 "hunger": {
                     "type": "integer"
                 },
+                "id": {
+                    "type": "integer"
+                },
+                "illAt": {
+                    "description": "生病时间",
+                    "type": "string"
+                },
+                "illness": {
+                    "description": "疾病名称，健康时为\"健康\"",
+                    "type": "string"
+                },
+                "isActive": {
+                    "description": "是否为主宠物",
+                    "type": "boolean"
+                },
                 "isDirty": {
                     "type": "boolean"
                 },
                 "isHungry": {
                     "type": "boolean"
                 },
+                "isSick": {
+                    "type": "boolean"
+                },
                 "isTired": {
                     "type": "boolean"
                 },
                 "isUnhappy": {
                     "type": "boolean"
                 },
+                "level": {
+                    "type": "integer"
+                },
+                "mood": {
+                    "description": "情绪：content, happy, excited, grumpy, sulking, sleepy",
+                    "type": "string"
+                },
+                "moodCalm": {
+                    "description": "闹情绪时还需安抚的次数",
+                    "type": "integer"
+                },
+                "moodName": {
+                    "description": "情绪名称",
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
                 "revision": {
                     "type": "integer"
                 },
+                "rosterOrder": {
+                    "description": "排序，0 表示未排序",
+                    "type": "integer"
+                },
                 "serverTime": {
                     "type": "string"
                 },
+                "speciesId": {
+                    "type": "integer"
+                },
+                "stage": {
+                    "type": "string"
+                },
                 "statusUpdatedAt": {
                     "type": "string"
                 }
             }
         },
-        "pet.PlayPetResponse": {
+        "pet.RosterResponse": {
             "type": "object",
             "properties": {
-                "energy": {
-                    "description": "当前精力",
+                "capacity": {
+                    "description": "当前栏位上限",
                     "type": "integer"
                 },
-                "expGained": {
-                    "description": "获得经验",
+                "count": {
+                    "description": "拥有的宠物数",
                     "type": "integer"
                 },
-                "happiness": {
-                    "description": "当前快乐度",
+                "maxCapacity": {
+                    "description": "可扩充到的最大栏位数",
                     "type": "integer"
                 },
-                "levelUp": {
-                    "description": "是否升级",
-                    "type": "boolean"
+                "pets": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pet.RosterPetDTO"
+                    }
+                },
+                "slotPrice": {
+                    "description": "扩充一个栏位的钻石价格",
+                    "type": "integer"
+                }
+            }
+        },
+        "pet.SelfBreedRequest": {
+            "type": "object",
+            "required": [
+                "childName",
+                "parentId"
+            ],
+            "properties": {
+                "childName": {
+                    "description": "后代名称",
+                    "type": "string",
+                    "maxLength": 20,
+                    "minLength": 1
+                },
+                "parentId": {
+                    "description": "亲本 ID",
+                    "type": "integer"
+                }
+            }
+        },
+        "pet.SetActivePetRequest": {
+            "type": "object",
+            "required": [
+                "petId"
+            ],
+            "properties": {
+                "petId": {
+                    "description": "主宠物ID",
+                    "type": "integer"
+                }
+            }
+        },
+        "pet.SetActivePetResponse": {
+            "type": "object",
+            "properties": {
+                "activePetId": {
+                    "type": "integer"
                 }
             }
         },
@@ -1896,6 +6036,14 @@ const docTemplatev1 = `{
                 "description": {
                     "type": "string"
                 },
+                "exp": {
+                    "description": "当前等级已积累的技能经验",
+                    "type": "integer"
+                },
+                "expToNext": {
+                    "description": "升级所需技能经验，满级为0",
+                    "type": "integer"
+                },
                 "level": {
                     "type": "integer"
                 },
@@ -1907,6 +6055,25 @@ const docTemplatev1 = `{
                 }
             }
         },
+        "pet.SpeciesProbabilityDTO": {
+            "type": "object",
+            "properties": {
+                "isHidden": {
+                    "description": "是否为隐藏物种",
+                    "type": "boolean"
+                },
+                "probability": {
+                    "description": "0-1",
+                    "type": "number"
+                },
+                "speciesId": {
+                    "type": "string"
+                },
+                "speciesName": {
+                    "type": "string"
+                }
+            }
+        },
         "pet.StatusDTO": {
             "type": "object",
             "properties": {
@@ -1922,17 +6089,64 @@ const docTemplatev1 = `{
                 "hunger": {
                     "type": "integer"
                 },
+                "illAt": {
+                    "description": "生病时间",
+                    "type": "string"
+                },
+                "illness": {
+                    "description": "疾病名称，健康时为\"健康\"",
+                    "type": "string"
+                },
                 "isDirty": {
                     "type": "boolean"
                 },
                 "isHungry": {
                     "type": "boolean"
                 },
+                "isSick": {
+                    "type": "boolean"
+                },
                 "isTired": {
                     "type": "boolean"
                 },
                 "isUnhappy": {
                     "type": "boolean"
+                },
+                "mood": {
+                    "description": "情绪：content, happy, excited, grumpy, sulking, sleepy",
+                    "type": "string"
+                },
+                "moodCalm": {
+                    "description": "闹情绪时还需安抚的次数",
+                    "type": "integer"
+                },
+                "moodName": {
+                    "description": "情绪名称",
+                    "type": "string"
+                }
+            }
+        },
+        "pet.WarmEggResponse": {
+            "type": "object",
+            "properties": {
+                "canHatch": {
+                    "description": "是否已可孵化",
+                    "type": "boolean"
+                },
+                "hatchAt": {
+                    "description": "预计孵化时间",
+                    "type": "string"
+                },
+                "nextWarmAt": {
+                    "description": "下次可保温时间",
+                    "type": "string"
+                },
+                "petId": {
+                    "type": "integer"
+                },
+                "remainingSeconds": {
+                    "description": "距离可孵化的秒数",
+                    "type": "integer"
                 }
             }
         },
@@ -2010,7 +6224,36 @@ const docTemplatev1 = `{
                 40900,
                 50000,
                 50300,
-                300100
+                300100,
+                300101,
+                300102,
+                300103,
+                300104,
+                300105,
+                300106,
+                300107,
+                300108,
+                300109,
+                300110,
+                300111,
+                300112,
+                300113,
+                300114,
+                300200,
+                300201,
+                300202,
+                300203,
+                300204,
+                300205,
+                300206,
+                300207,
+                300208,
+                300300,
+                300301,
+                300302,
+                300303,
+                300304,
+                300305
             ],
             "x-enum-varnames": [
                 "CodeSuccess",
@@ -2021,7 +6264,36 @@ const docTemplatev1 = `{
                 "CodeConflict",
                 "CodeInternalError",
                 "CodeServiceUnavailable",
-                "CodePetNotFound"
+                "CodePetNotFound",
+                "CodeBreedCooldown",
+                "CodeIncompatibleGender",
+                "CodePetNotMature",
+                "CodePetLevelTooLow",
+                "CodePetUnhappy",
+                "CodeCannotSelfBreed",
+                "CodeIntimacyTooLow",
+                "CodeContractExpired",
+                "CodeEggNotReady",
+                "CodePetNotEgg",
+                "CodeWarmCooldown",
+                "CodePetIsSick",
+                "CodeRosterFull",
+                "CodeTooInbred",
+                "CodeItemNotFound",
+                "CodeInsufficientItem",
+                "CodeInsufficientCoins",
+                "CodeInsufficientDiamonds",
+                "CodeItemNotForSale",
+                "CodeItemNotUsable",
+                "CodeItemStageNotAllowed",
+                "CodeSlotOccupied",
+                "CodeDecorationNotFound",
+                "CodeNotFriends",
+                "CodeTradeNotFound",
+                "CodeTradeExpired",
+                "CodeInvalidTradeStatus",
+                "CodeVisitLimitReached",
+                "CodeInteractionLimit"
             ]
         },
         "response.Response": {
@@ -2116,6 +6388,41 @@ const docTemplatev1 = `{
                 }
             }
         },
+        "social.PetVisitDTO": {
+            "type": "object",
+            "properties": {
+                "cleanliness": {
+                    "type": "integer"
+                },
+                "energy": {
+                    "type": "integer"
+                },
+                "happiness": {
+                    "type": "integer"
+                },
+                "hunger": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "level": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "ownerName": {
+                    "type": "string"
+                },
+                "speciesId": {
+                    "type": "integer"
+                },
+                "stage": {
+                    "type": "string"
+                }
+            }
+        },
         "social.SendGiftRequest": {
             "type": "object",
             "required": [
@@ -2139,6 +6446,107 @@ const docTemplatev1 = `{
                     "type": "integer"
                 }
             }
+        },
+        "social.TradeDTO": {
+            "type": "object",
+            "properties": {
+                "completedAt": {
+                    "type": "string"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "fromNickname": {
+                    "type": "string"
+                },
+                "fromUserId": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "offerItemId": {
+                    "type": "integer"
+                },
+                "offerItemName": {
+                    "type": "string"
+                },
+                "offerQuantity": {
+                    "type": "integer"
+                },
+                "requestItemId": {
+                    "type": "integer"
+                },
+                "requestItemName": {
+                    "type": "string"
+                },
+                "requestQuantity": {
+                    "type": "integer"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "statusCode": {
+                    "type": "integer"
+                },
+                "toNickname": {
+                    "type": "string"
+                },
+                "toUserId": {
+                    "type": "integer"
+                }
+            }
+        },
+        "social.TradeListResponse": {
+            "type": "object",
+            "properties": {
+                "trades": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/social.TradeDTO"
+                    }
+                }
+            }
+        },
+        "social.VisitResponse": {
+            "type": "object",
+            "properties": {
+                "bonusCoins": {
+                    "description": "访客宠物幸运额外获得的金币",
+                    "type": "integer"
+                },
+                "canVisitMore": {
+                    "description": "主人今天是否还能接待访客",
+                    "type": "boolean"
+                },
+                "firstVisitToday": {
+                    "description": "今天首次拜访（有奖励）",
+                    "type": "boolean"
+                },
+                "hostBonusCoins": {
+                    "description": "主人宠物幸运额外获得的金币",
+                    "type": "integer"
+                },
+                "hostRewardCoins": {
+                    "description": "主人获得金币（含幸运额外获得）",
+                    "type": "integer"
+                },
+                "intimacy": {
+                    "description": "当前亲密度",
+                    "type": "integer"
+                },
+                "intimacyGained": {
+                    "description": "增加的亲密度",
+                    "type": "integer"
+                },
+                "pet": {
+                    "$ref": "#/definitions/social.PetVisitDTO"
+                },
+                "rewardCoins": {
+                    "description": "访客获得金币（含幸运额外获得）",
+                    "type": "integer"
+                }
+            }
         }
     },
     "securityDefinitions": {
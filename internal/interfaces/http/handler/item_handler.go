@@ -2,30 +2,36 @@
 package handler
 
 import (
-	"net/http"
+	"errors"
 
 	"github.com/gin-gonic/gin"
 
+	itemApp "pets-server/internal/application/item"
 	"pets-server/internal/interfaces/http/middleware"
 	"pets-server/internal/pkg/response"
 )
 
 // ItemHandler 道具处理器
 type ItemHandler struct {
-	// TODO: 注入 item 应用服务
+	itemService *itemApp.Service
 }
 
 // NewItemHandler 创建道具处理器
-func NewItemHandler() *ItemHandler {
-	return &ItemHandler{}
+func NewItemHandler(itemService *itemApp.Service) *ItemHandler {
+	return &ItemHandler{itemService: itemService}
 }
 
 // RegisterRoutes 注册路由
 func (h *ItemHandler) RegisterRoutes(r *gin.RouterGroup) {
-	r.GET("", h.GetItems)           // 获取背包
-	r.POST("/use", h.UseItem)       // 使用道具
-	r.GET("/shop", h.GetShopItems)  // 获取商店道具
-	r.POST("/buy", h.BuyItem)       // 购买道具
+	r.GET("", h.GetItems)          // 获取背包
+	r.POST("/use", h.UseItem)      // 使用道具
+	r.GET("/shop", h.GetShopItems) // 获取商店道具
+	r.POST("/buy", h.BuyItem)      // 购买道具
+
+	// 装饰
+	r.GET("/decorations", h.GetDecorations)             // 获取宠物装饰
+	r.POST("/decorations/equip", h.EquipDecoration)     // 穿戴装饰
+	r.POST("/decorations/unequip", h.UnequipDecoration) // 卸下装饰
 }
 
 // GetItems 获取背包道具
@@ -35,75 +41,233 @@ func (h *ItemHandler) RegisterRoutes(r *gin.RouterGroup) {
 // @Accept       json
 // @Produce      json
 // @Security     Bearer
-// @Success      200 {object} response.Response{data=object} "获取成功"
+// @Success      200 {object} response.Response{data=itemApp.BagResponse} "获取成功"
 // @Failure      500 {object} response.Response "服务器错误"
 // @Router       /items [get]
 func (h *ItemHandler) GetItems(c *gin.Context) {
 	userID := middleware.GetUserID(c)
-	_ = userID
 
-	// TODO: 实现获取背包逻辑
-	response.Success(c, gin.H{"items": []interface{}{}})
+	result, err := h.itemService.GetBag(c.Request.Context(), userID)
+	if err != nil {
+		response.Error(c, response.CodeInternalError, err.Error())
+		return
+	}
+
+	response.Success(c, result)
 }
 
 // UseItem 使用道具
 // @Summary      使用道具
-// @Description  使用背包中的道具
+// @Description  对当前主宠物使用背包中的道具，按道具效果（可组合）恢复状态、增加经验、清除繁殖冷却、改名或重置技能；改名卡需提供 newName
 // @Tags         item
 // @Accept       json
 // @Produce      json
 // @Security     Bearer
-// @Success      200 {object} response.Response "使用成功"
-// @Failure      400 {object} response.Response "请求参数错误"
+// @Param        request body itemApp.UseItemRequest true "使用道具请求"
+// @Success      200 {object} response.Response{data=itemApp.UseItemResponse} "使用成功"
+// @Failure      400 {object} response.Response "请求参数错误或宠物当前状态无法使用"
+// @Failure      404 {object} response.Response "道具或宠物不存在"
 // @Failure      500 {object} response.Response "服务器错误"
 // @Router       /items/use [post]
 func (h *ItemHandler) UseItem(c *gin.Context) {
-	// TODO: 实现使用道具逻辑
-	response.Success(c, gin.H{"message": "道具使用成功"})
+	userID := middleware.GetUserID(c)
+
+	var req itemApp.UseItemRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Error(c, response.CodeBadRequest, err.Error())
+		return
+	}
+
+	result, err := h.itemService.UseItem(c.Request.Context(), userID, req)
+	if err != nil {
+		h.handleItemError(c, err)
+		return
+	}
+
+	response.Success(c, result)
 }
 
 // GetShopItems 获取商店道具
 // @Summary      获取商店道具
-// @Description  获取商店中可购买的道具列表
+// @Description  获取商店中可购买的道具列表，可按道具类型筛选
 // @Tags         item
 // @Accept       json
 // @Produce      json
 // @Security     Bearer
-// @Success      200 {object} response.Response{data=object} "获取成功"
+// @Param        type query int false "道具类型：1食物 2清洁 3玩具 4装饰 5特殊"
+// @Success      200 {object} response.Response{data=itemApp.ShopResponse} "获取成功"
+// @Failure      400 {object} response.Response "请求参数错误"
 // @Failure      500 {object} response.Response "服务器错误"
 // @Router       /items/shop [get]
 func (h *ItemHandler) GetShopItems(c *gin.Context) {
-	// TODO: 实现获取商店道具逻辑
-	response.Success(c, gin.H{"items": []interface{}{}})
-}
+	var req itemApp.ShopItemsRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		response.Error(c, response.CodeBadRequest, err.Error())
+		return
+	}
 
-// BuyRequest 购买道具请求
-type BuyRequest struct {
-	ItemID   int `json:"itemId" binding:"required"`   // 道具ID
-	Quantity int `json:"quantity" binding:"required,min=1"` // 购买数量
+	result, err := h.itemService.GetShopItems(c.Request.Context(), req)
+	if err != nil {
+		response.Error(c, response.CodeInternalError, err.Error())
+		return
+	}
+
+	response.Success(c, result)
 }
 
 // BuyItem 购买道具
 // @Summary      购买道具
-// @Description  在商店购买道具
+// @Description  在商店购买道具，扣除金币或钻石并放入背包
 // @Tags         item
 // @Accept       json
 // @Produce      json
 // @Security     Bearer
-// @Param        request body BuyRequest true "购买请求"
-// @Success      200 {object} response.Response "购买成功"
+// @Param        request body itemApp.BuyItemRequest true "购买请求"
+// @Success      200 {object} response.Response{data=itemApp.BuyItemResponse} "购买成功"
 // @Failure      400 {object} response.Response "请求参数错误"
-// @Failure      500 {object} response.Response "服务器错误"
+// @Failure      404 {object} response.Response "道具不存在"
+// @Failure      500 {object} response.Response "货币不足、道具不出售或服务器错误"
 // @Router       /items/buy [post]
 func (h *ItemHandler) BuyItem(c *gin.Context) {
+	userID := middleware.GetUserID(c)
 
-	var req BuyRequest
+	var req itemApp.BuyItemRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		response.Error(c, http.StatusBadRequest, err.Error())
+		response.Error(c, response.CodeBadRequest, err.Error())
+		return
+	}
+
+	result, err := h.itemService.BuyItem(c.Request.Context(), userID, req)
+	if err != nil {
+		h.handleItemError(c, err)
 		return
 	}
 
-	// TODO: 实现购买道具逻辑
-	response.Success(c, gin.H{"message": "购买成功"})
+	response.Success(c, result)
 }
 
+// GetDecorations 获取宠物装饰
+// @Summary      获取宠物装饰
+// @Description  获取宠物各槽位穿戴中的装饰及其带来的状态衰减减免，不传 petId 时为当前主宠物
+// @Tags         item
+// @Accept       json
+// @Produce      json
+// @Security     Bearer
+// @Param        petId query int false "宠物ID"
+// @Success      200 {object} response.Response{data=itemApp.PetDecorationsResponse} "获取成功"
+// @Failure      400 {object} response.Response "请求参数错误"
+// @Failure      500 {object} response.Response "服务器错误"
+// @Router       /items/decorations [get]
+func (h *ItemHandler) GetDecorations(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+
+	var req itemApp.PetDecorationsRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		response.Error(c, response.CodeBadRequest, err.Error())
+		return
+	}
+
+	result, err := h.itemService.GetPetDecorations(c.Request.Context(), userID, req)
+	if err != nil {
+		h.handleItemError(c, err)
+		return
+	}
+
+	response.Success(c, result)
+}
+
+// EquipDecoration 穿戴装饰
+// @Summary      穿戴装饰
+// @Description  从背包取出一件装饰品穿戴到宠物对应槽位（head/body/accessory），每个槽位只能穿戴一件
+// @Tags         item
+// @Accept       json
+// @Produce      json
+// @Security     Bearer
+// @Param        request body itemApp.EquipDecorationRequest true "穿戴请求"
+// @Success      200 {object} response.Response{data=itemApp.PetDecorationsResponse} "穿戴成功"
+// @Failure      400 {object} response.Response "请求参数错误、不是装饰品或槽位已占用"
+// @Failure      404 {object} response.Response "道具或宠物不存在"
+// @Failure      500 {object} response.Response "服务器错误"
+// @Router       /items/decorations/equip [post]
+func (h *ItemHandler) EquipDecoration(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+
+	var req itemApp.EquipDecorationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Error(c, response.CodeBadRequest, err.Error())
+		return
+	}
+
+	result, err := h.itemService.EquipDecoration(c.Request.Context(), userID, req)
+	if err != nil {
+		h.handleItemError(c, err)
+		return
+	}
+
+	response.Success(c, result)
+}
+
+// UnequipDecoration 卸下装饰
+// @Summary      卸下装饰
+// @Description  卸下宠物指定槽位的装饰并放回背包
+// @Tags         item
+// @Accept       json
+// @Produce      json
+// @Security     Bearer
+// @Param        request body itemApp.UnequipDecorationRequest true "卸下请求"
+// @Success      200 {object} response.Response{data=itemApp.PetDecorationsResponse} "卸下成功"
+// @Failure      400 {object} response.Response "请求参数错误"
+// @Failure      404 {object} response.Response "该槽位没有装饰"
+// @Failure      500 {object} response.Response "服务器错误"
+// @Router       /items/decorations/unequip [post]
+func (h *ItemHandler) UnequipDecoration(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+
+	var req itemApp.UnequipDecorationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Error(c, response.CodeBadRequest, err.Error())
+		return
+	}
+
+	result, err := h.itemService.UnequipDecoration(c.Request.Context(), userID, req)
+	if err != nil {
+		h.handleItemError(c, err)
+		return
+	}
+
+	response.Success(c, result)
+}
+
+// handleItemError 处理道具相关错误
+func (h *ItemHandler) handleItemError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, itemApp.ErrPetNotFound):
+		response.SuccessWithMessageAndCode(c, response.CodePetNotFound, "还没有宠物，快去领养一只吧！", nil)
+	case errors.Is(err, itemApp.ErrItemNotFound):
+		response.Error(c, response.CodeItemNotFound, err.Error())
+	case errors.Is(err, itemApp.ErrInsufficientItem):
+		response.Error(c, response.CodeInsufficientItem, err.Error())
+	case errors.Is(err, itemApp.ErrInsufficientCoins):
+		response.Error(c, response.CodeInsufficientCoins, "金币不足")
+	case errors.Is(err, itemApp.ErrInsufficientDiamonds):
+		response.Error(c, response.CodeInsufficientDiamonds, "钻石不足")
+	case errors.Is(err, itemApp.ErrItemNotForSale):
+		response.Error(c, response.CodeItemNotForSale, err.Error())
+	case errors.Is(err, itemApp.ErrItemNotUsable),
+		errors.Is(err, itemApp.ErrUnknownEffect),
+		errors.Is(err, itemApp.ErrInvalidEffect):
+		response.Error(c, response.CodeItemNotUsable, err.Error())
+	case errors.Is(err, itemApp.ErrStageNotAllowed),
+		errors.Is(err, itemApp.ErrPetIsEgg):
+		response.Error(c, response.CodeItemStageNotAllowed, err.Error())
+	case errors.Is(err, itemApp.ErrSlotOccupied):
+		response.Error(c, response.CodeSlotOccupied, err.Error())
+	case errors.Is(err, itemApp.ErrDecorationNotFound):
+		response.Error(c, response.CodeDecorationNotFound, err.Error())
+	case errors.Is(err, itemApp.ErrNotPetOwner):
+		response.Error(c, response.CodeForbidden, err.Error())
+	default:
+		// 宠物状态类错误（已经吃饱、不在冷却中、名字无效等）直接返回原因
+		response.Error(c, response.CodeBadRequest, err.Error())
+	}
+}
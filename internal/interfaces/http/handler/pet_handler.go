@@ -3,6 +3,8 @@
 package handler
 
 import (
+	"errors"
+	"net/http"
 	"strconv"
 
 	"github.com/gin-gonic/gin"
@@ -24,13 +26,53 @@ func NewPetHandler(petService *petApp.Service) *PetHandler {
 
 // RegisterRoutes 注册路由
 func (h *PetHandler) RegisterRoutes(r *gin.RouterGroup) {
-	r.GET("", h.GetMyPet)         // 获取我的宠物（读操作示例）
-	r.GET("/status", h.GetStatus) // 获取轻量状态
-	r.PUT("/active", h.SetActive) // 设置主宠物
-	r.POST("", h.CreatePet)       // 创建宠物
-	r.POST("/feed", h.Feed)       // 喂食（写操作示例）
-	r.POST("/play", h.Play)       // 玩耍
-	r.POST("/clean", h.Clean)     // 清洁
+	r.GET("", h.GetMyPet)                      // 获取我的宠物（读操作示例）
+	r.GET("/status", h.GetStatus)              // 获取轻量状态
+	r.GET("/growth", h.GetGrowth)              // 等级曲线预览
+	r.GET("/random-events", h.GetRandomEvents) // 随机事件记录
+	r.PUT("/active", h.SetActive)              // 设置主宠物
+	r.POST("", h.CreatePet)                    // 创建宠物
+	r.POST("/feed", h.Feed)                    // 喂食（写操作示例）
+	r.POST("/play", h.Play)                    // 玩耍
+	r.POST("/clean", h.Clean)                  // 清洁
+	r.POST("/warm", h.WarmEgg)                 // 给蛋保温
+	r.POST("/hatch", h.Hatch)                  // 孵化
+
+	// 繁殖
+	r.POST("/breed", h.Breed)                    // 双亲繁殖
+	r.GET("/breed/check", h.CheckBreed)          // 检查是否可繁殖
+	r.POST("/breed/predict", h.PredictOffspring) // 预测后代物种
+	r.POST("/self-breed", h.SelfBreed)           // 分裂繁殖
+
+	// 领养市场
+	r.GET("/adoptions", h.GetAdoptionMarket)          // 可领养的挂单
+	r.GET("/adoptions/mine", h.GetMyAdoptions)        // 我发布的挂单
+	r.POST("/adoptions", h.ListForAdoption)           // 挂单出售或赠送
+	r.POST("/adoptions/:id/adopt", h.AdoptPet)        // 领养
+	r.POST("/adoptions/:id/cancel", h.CancelAdoption) // 撤回挂单
+	r.GET("/ownership/:id", h.GetOwnershipHistory)    // 宠物历任主人
+
+	// 纪念馆
+	r.GET("/memorials", h.GetMemorials) // 已离世的宠物
+
+	// 宠物栏
+	r.GET("/roster", h.GetRoster)               // 我的全部宠物
+	r.PUT("/roster/order", h.ReorderRoster)     // 排序
+	r.POST("/roster/expand", h.ExpandRoster)    // 花钻石扩充栏位
+	r.PUT("/roster/:id/name", h.RenamePet)      // 改名
+	r.POST("/roster/:id/release", h.ReleasePet) // 放生
+
+	// 血统图谱
+	r.GET("/lineage/:id", h.GetLineage)        // JSON
+	r.GET("/lineage/:id/dot", h.GetLineageDOT) // Graphviz DOT
+
+	// 基因组报告
+	r.GET("/genome/:id", h.GetGenome) // 逐位解读，隐藏片段需要基因扫描仪
+
+	// 照顾好友宠物
+	r.POST("/friends/:userId/feed", h.FeedFriendPet)     // 喂食好友宠物
+	r.POST("/friends/:userId/play", h.PlayWithFriendPet) // 陪好友宠物玩耍
+	r.POST("/friends/:userId/clean", h.CleanFriendPet)   // 清洁好友宠物
 }
 
 // GetMyPet 获取我的宠物
@@ -104,6 +146,84 @@ func (h *PetHandler) GetStatus(c *gin.Context) {
 	response.Success(c, status)
 }
 
+// GetGrowth 等级曲线预览
+// @Summary      等级曲线预览
+// @Description  获取宠物所属物种的升级经验曲线和进化等级，以及距离下一阶段还需的经验；获得的经验按基因成长速率修正
+// @Tags         pet
+// @Accept       json
+// @Produce      json
+// @Security     Bearer
+// @Param        petId query int true "宠物ID"
+// @Success      200 {object} response.Response{data=petApp.GrowthPreviewDTO} "获取成功"
+// @Failure      400 {object} response.Response "请求参数错误"
+// @Failure      404 {object} response.Response "还没有宠物"
+// @Failure      403 {object} response.Response "非宠物主人"
+// @Failure      500 {object} response.Response "服务器错误"
+// @Router       /pet/growth [get]
+func (h *PetHandler) GetGrowth(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+	petID, err := strconv.Atoi(c.Query("petId"))
+	if err != nil || petID <= 0 {
+		response.Error(c, response.CodeBadRequest, "petId 参数无效")
+		return
+	}
+
+	result, err := h.petService.GetGrowthPreview(c.Request.Context(), userID, petID)
+	if err != nil {
+		if err == petApp.ErrPetNotFound {
+			response.SuccessWithMessageAndCode(c, response.CodePetNotFound, "还没有宠物，快去领养一只吧！", nil)
+			return
+		}
+		if err == petApp.ErrNotPetOwner {
+			response.Error(c, response.CodeForbidden, err.Error())
+			return
+		}
+		response.Error(c, response.CodeInternalError, err.Error())
+		return
+	}
+
+	response.Success(c, result)
+}
+
+// GetRandomEvents 随机事件记录
+// @Summary      随机事件记录
+// @Description  获取宠物最近经历的随机事件。照顾宠物时有概率触发随机事件，好奇心越高、探险家技能越强越容易触发
+// @Tags         pet
+// @Accept       json
+// @Produce      json
+// @Security     Bearer
+// @Param        petId query int true "宠物ID"
+// @Success      200 {object} response.Response{data=petApp.RandomEventListResponse} "获取成功"
+// @Failure      400 {object} response.Response "请求参数错误"
+// @Failure      404 {object} response.Response "还没有宠物"
+// @Failure      403 {object} response.Response "非宠物主人"
+// @Failure      500 {object} response.Response "服务器错误"
+// @Router       /pet/random-events [get]
+func (h *PetHandler) GetRandomEvents(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+	petID, err := strconv.Atoi(c.Query("petId"))
+	if err != nil || petID <= 0 {
+		response.Error(c, response.CodeBadRequest, "petId 参数无效")
+		return
+	}
+
+	result, err := h.petService.GetRandomEvents(c.Request.Context(), userID, petID)
+	if err != nil {
+		if err == petApp.ErrPetNotFound {
+			response.SuccessWithMessageAndCode(c, response.CodePetNotFound, "还没有宠物，快去领养一只吧！", nil)
+			return
+		}
+		if err == petApp.ErrNotPetOwner {
+			response.Error(c, response.CodeForbidden, err.Error())
+			return
+		}
+		response.Error(c, response.CodeInternalError, err.Error())
+		return
+	}
+
+	response.Success(c, result)
+}
+
 // SetActive 设置主宠物
 // @Summary      设置主宠物
 // @Description  设置当前用户的主宠物（主页默认展示和互动对象）
@@ -214,7 +334,7 @@ func (h *PetHandler) Feed(c *gin.Context) {
 // @Param        request body petApp.CreatePetRequest true "创建宠物请求"
 // @Success      200 {object} response.Response{data=petApp.CreatePetResponse} "创建成功"
 // @Failure      400 {object} response.Response "请求参数错误"
-// @Failure      409 {object} response.Response "已拥有宠物"
+// @Failure      409 {object} response.Response "宠物栏已满"
 // @Failure      500 {object} response.Response "服务器错误"
 // @Router       /pet [post]
 func (h *PetHandler) CreatePet(c *gin.Context) {
@@ -228,8 +348,8 @@ func (h *PetHandler) CreatePet(c *gin.Context) {
 
 	result, err := h.petService.CreatePet(c.Request.Context(), userID, req)
 	if err != nil {
-		if err == petApp.ErrAlreadyHasPet {
-			response.Error(c, response.CodeConflict, err.Error())
+		if errors.Is(err, petApp.ErrRosterFull) {
+			response.Error(c, response.CodeRosterFull, err.Error())
 			return
 		}
 		response.Error(c, response.CodeInternalError, err.Error())
@@ -241,7 +361,7 @@ func (h *PetHandler) CreatePet(c *gin.Context) {
 
 // Play 和宠物玩耍
 // @Summary      和宠物玩耍
-// @Description  与宠物互动玩耍，增加快乐度和经验值，消耗精力
+// @Description  与宠物互动玩耍，增加快乐度和经验值，消耗精力；增加的快乐度受情绪影响，闹别扭的宠物拒绝玩耍
 // @Tags         pet
 // @Accept       json
 // @Produce      json
@@ -284,3 +404,1047 @@ func (h *PetHandler) Clean(c *gin.Context) {
 
 	response.Success(c, result)
 }
+
+// WarmEgg 给蛋保温
+// @Summary      给蛋保温
+// @Description  给主宠物的蛋保温，缩短孵化时间，两次保温之间有冷却
+// @Tags         pet
+// @Accept       json
+// @Produce      json
+// @Security     Bearer
+// @Success      200 {object} response.Response{data=petApp.WarmEggResponse} "保温成功"
+// @Failure      400 {object} response.Response "已孵化、冷却中或已可孵化"
+// @Failure      500 {object} response.Response "服务器错误"
+// @Router       /pet/warm [post]
+func (h *PetHandler) WarmEgg(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+
+	result, err := h.petService.WarmEgg(c.Request.Context(), userID)
+	if err != nil {
+		h.handleIncubationError(c, err)
+		return
+	}
+
+	response.Success(c, result)
+}
+
+// Hatch 孵化
+// @Summary      孵化
+// @Description  主宠物的蛋到达孵化时间后孵化，进入幼年期
+// @Tags         pet
+// @Accept       json
+// @Produce      json
+// @Security     Bearer
+// @Success      200 {object} response.Response{data=petApp.HatchPetResponse} "孵化成功"
+// @Failure      400 {object} response.Response "已孵化或未到孵化时间"
+// @Failure      500 {object} response.Response "服务器错误"
+// @Router       /pet/hatch [post]
+func (h *PetHandler) Hatch(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+
+	result, err := h.petService.HatchPet(c.Request.Context(), userID)
+	if err != nil {
+		h.handleIncubationError(c, err)
+		return
+	}
+
+	response.Success(c, result)
+}
+
+// handleIncubationError 处理孵化相关错误
+func (h *PetHandler) handleIncubationError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, petApp.ErrPetNotFound):
+		response.SuccessWithMessageAndCode(c, response.CodePetNotFound, "还没有宠物，快去领养一只吧！", nil)
+	case errors.Is(err, petApp.ErrEggNotReady):
+		response.Error(c, response.CodeEggNotReady, err.Error())
+	case errors.Is(err, petApp.ErrEggReady):
+		response.Error(c, response.CodeConflict, err.Error())
+	case errors.Is(err, petApp.ErrPetNotEgg):
+		response.Error(c, response.CodePetNotEgg, err.Error())
+	case errors.Is(err, petApp.ErrWarmCooldown):
+		response.Error(c, response.CodeWarmCooldown, err.Error())
+	default:
+		response.Error(c, response.CodeInternalError, err.Error())
+	}
+}
+
+// ============================================================
+// 繁殖相关接口
+// ============================================================
+
+// Breed 双亲繁殖
+// @Summary      双亲繁殖
+// @Description  使用自己的两只宠物进行繁殖，后代继承双亲基因，跨物种时有概率触发隐藏物种融合；血缘越近后代越容易突变、抗性越低，超过阈值时禁止繁殖
+// @Tags         pet
+// @Accept       json
+// @Produce      json
+// @Security     Bearer
+// @Param        request body petApp.BreedPetsRequest true "繁殖请求"
+// @Success      200 {object} response.Response{data=petApp.BreedPetsResponse} "繁殖成功"
+// @Failure      400 {object} response.Response "请求参数错误"
+// @Failure      403 {object} response.Response "非宠物主人"
+// @Failure      404 {object} response.Response "宠物不存在"
+// @Failure      500 {object} response.Response "繁殖条件不满足（冷却中、性别不兼容、未成年等）或服务器错误"
+// @Router       /pet/breed [post]
+func (h *PetHandler) Breed(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+
+	var req petApp.BreedPetsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Error(c, response.CodeBadRequest, err.Error())
+		return
+	}
+
+	result, err := h.petService.BreedPets(c.Request.Context(), userID, req)
+	if err != nil {
+		h.handleBreedError(c, err)
+		return
+	}
+
+	response.Success(c, result)
+}
+
+// SelfBreed 分裂繁殖
+// @Summary      分裂繁殖
+// @Description  支持无性繁殖的物种可由单只宠物分裂出后代，基因自我复制并有概率突变
+// @Tags         pet
+// @Accept       json
+// @Produce      json
+// @Security     Bearer
+// @Param        request body petApp.SelfBreedRequest true "分裂繁殖请求"
+// @Success      200 {object} response.Response{data=petApp.BreedPetsResponse} "繁殖成功"
+// @Failure      400 {object} response.Response "请求参数错误"
+// @Failure      403 {object} response.Response "非宠物主人"
+// @Failure      404 {object} response.Response "宠物不存在"
+// @Failure      500 {object} response.Response "繁殖条件不满足（冷却中、物种不支持分裂等）或服务器错误"
+// @Router       /pet/self-breed [post]
+func (h *PetHandler) SelfBreed(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+
+	var req petApp.SelfBreedRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Error(c, response.CodeBadRequest, err.Error())
+		return
+	}
+
+	result, err := h.petService.SelfBreedPet(c.Request.Context(), userID, req)
+	if err != nil {
+		h.handleBreedError(c, err)
+		return
+	}
+
+	response.Success(c, result)
+}
+
+// CheckBreed 检查是否可繁殖
+// @Summary      检查是否可繁殖
+// @Description  检查两只宠物能否繁殖（不传 parent2Id 时检查分裂繁殖），返回不可繁殖原因和冷却剩余时间
+// @Tags         pet
+// @Accept       json
+// @Produce      json
+// @Security     Bearer
+// @Param        parent1Id query int true "父母1 ID"
+// @Param        parent2Id query int false "父母2 ID"
+// @Success      200 {object} response.Response{data=petApp.CanBreedResponse} "检查成功"
+// @Failure      400 {object} response.Response "请求参数错误"
+// @Failure      403 {object} response.Response "非宠物主人"
+// @Failure      404 {object} response.Response "宠物不存在"
+// @Failure      500 {object} response.Response "服务器错误"
+// @Router       /pet/breed/check [get]
+func (h *PetHandler) CheckBreed(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+
+	var req petApp.CanBreedRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		response.Error(c, response.CodeBadRequest, err.Error())
+		return
+	}
+
+	result, err := h.petService.CanBreed(c.Request.Context(), userID, req.Parent1ID, req.Parent2ID)
+	if err != nil {
+		h.handleBreedError(c, err)
+		return
+	}
+
+	response.Success(c, result)
+}
+
+// PredictOffspring 预测后代物种
+// @Summary      预测后代物种
+// @Description  预测两只宠物（或单只分裂繁殖）后代可能的物种及概率，包括隐藏物种，并给出双亲的近交系数供客户端提醒
+// @Tags         pet
+// @Accept       json
+// @Produce      json
+// @Security     Bearer
+// @Param        request body petApp.PredictOffspringRequest true "预测请求"
+// @Success      200 {object} response.Response{data=petApp.PredictOffspringResponse} "预测成功"
+// @Failure      400 {object} response.Response "请求参数错误"
+// @Failure      403 {object} response.Response "非宠物主人"
+// @Failure      404 {object} response.Response "宠物不存在"
+// @Failure      500 {object} response.Response "服务器错误"
+// @Router       /pet/breed/predict [post]
+func (h *PetHandler) PredictOffspring(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+
+	var req petApp.PredictOffspringRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Error(c, response.CodeBadRequest, err.Error())
+		return
+	}
+
+	result, err := h.petService.PredictOffspring(c.Request.Context(), userID, req)
+	if err != nil {
+		h.handleBreedError(c, err)
+		return
+	}
+
+	response.Success(c, result)
+}
+
+// ListBreedingContracts 我的繁殖契约
+// @Summary      我的繁殖契约
+// @Description  获取我发起和收到的跨主人繁殖契约，已超时的待确认契约会被标记为过期
+// @Tags         pet
+// @Accept       json
+// @Produce      json
+// @Security     Bearer
+// @Success      200 {object} response.Response{data=petApp.BreedingContractListResponse} "获取成功"
+// @Failure      500 {object} response.Response "服务器错误"
+// @Router       /pet/breed/contracts [get]
+func (h *PetHandler) ListBreedingContracts(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+
+	result, err := h.petService.ListBreedingContracts(c.Request.Context(), userID)
+	if err != nil {
+		response.Error(c, response.CodeInternalError, err.Error())
+		return
+	}
+
+	response.Success(c, result)
+}
+
+// ProposeBreedingContract 发起繁殖契约
+// @Summary      发起繁殖契约
+// @Description  用自己的宠物向好友的宠物发起繁殖邀约，需要双方为好友且亲密度达到"朋友"，双方宠物都满足繁殖条件
+// @Tags         pet
+// @Accept       json
+// @Produce      json
+// @Security     Bearer
+// @Param        request body petApp.ProposeBreedingContractRequest true "发起契约请求"
+// @Success      200 {object} response.Response{data=petApp.BreedingContractDTO} "发起成功"
+// @Failure      400 {object} response.Response "请求参数错误"
+// @Failure      403 {object} response.Response "非宠物主人或非好友"
+// @Failure      404 {object} response.Response "宠物不存在"
+// @Failure      409 {object} response.Response "宠物已有待处理契约"
+// @Failure      500 {object} response.Response "亲密度不足、繁殖条件不满足或服务器错误"
+// @Router       /pet/breed/contracts [post]
+func (h *PetHandler) ProposeBreedingContract(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+
+	var req petApp.ProposeBreedingContractRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Error(c, response.CodeBadRequest, err.Error())
+		return
+	}
+
+	result, err := h.petService.ProposeBreedingContract(c.Request.Context(), userID, req)
+	if err != nil {
+		h.handleBreedError(c, err)
+		return
+	}
+
+	response.Success(c, result)
+}
+
+// AcceptBreedingContract 接受繁殖契约
+// @Summary      接受繁殖契约
+// @Description  契约接收方同意后立即繁殖，后代按契约约定归属（可一窝双胎双方各得一只）
+// @Tags         pet
+// @Accept       json
+// @Produce      json
+// @Security     Bearer
+// @Param        id path int true "契约ID"
+// @Param        request body petApp.AcceptBreedingContractRequest false "接受契约请求"
+// @Success      200 {object} response.Response{data=petApp.AcceptBreedingContractResponse} "繁殖成功"
+// @Failure      400 {object} response.Response "请求参数错误"
+// @Failure      403 {object} response.Response "非契约接收方"
+// @Failure      404 {object} response.Response "契约不存在"
+// @Failure      409 {object} response.Response "契约状态无效或宠物已易主"
+// @Failure      500 {object} response.Response "契约已过期、繁殖条件不满足或服务器错误"
+// @Router       /pet/breed/contracts/{id}/accept [post]
+func (h *PetHandler) AcceptBreedingContract(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+
+	contractID, err := strconv.Atoi(c.Param("id"))
+	if err != nil || contractID <= 0 {
+		response.Error(c, response.CodeBadRequest, "契约ID无效")
+		return
+	}
+
+	var req petApp.AcceptBreedingContractRequest
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			response.Error(c, response.CodeBadRequest, err.Error())
+			return
+		}
+	}
+
+	result, err := h.petService.AcceptBreedingContract(c.Request.Context(), userID, contractID, req)
+	if err != nil {
+		h.handleBreedError(c, err)
+		return
+	}
+
+	response.Success(c, result)
+}
+
+// RejectBreedingContract 拒绝或撤回繁殖契约
+// @Summary      拒绝或撤回繁殖契约
+// @Description  接收方拒绝或发起方撤回待确认的繁殖契约，双方都会收到通知
+// @Tags         pet
+// @Accept       json
+// @Produce      json
+// @Security     Bearer
+// @Param        id path int true "契约ID"
+// @Success      200 {object} response.Response{data=petApp.BreedingContractDTO} "操作成功"
+// @Failure      400 {object} response.Response "请求参数错误"
+// @Failure      403 {object} response.Response "非契约当事人"
+// @Failure      404 {object} response.Response "契约不存在"
+// @Failure      409 {object} response.Response "契约状态无效"
+// @Failure      500 {object} response.Response "服务器错误"
+// @Router       /pet/breed/contracts/{id}/reject [post]
+func (h *PetHandler) RejectBreedingContract(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+
+	contractID, err := strconv.Atoi(c.Param("id"))
+	if err != nil || contractID <= 0 {
+		response.Error(c, response.CodeBadRequest, "契约ID无效")
+		return
+	}
+
+	result, err := h.petService.RejectBreedingContract(c.Request.Context(), userID, contractID)
+	if err != nil {
+		h.handleBreedError(c, err)
+		return
+	}
+
+	response.Success(c, result)
+}
+
+// breedErrorCodes 繁殖错误到业务响应码的映射
+var breedErrorCodes = []struct {
+	err  error
+	code response.CustomCode
+}{
+	{petApp.ErrSameParent, response.CodeBadRequest},
+	{petApp.ErrNotPetOwner, response.CodeForbidden},
+	{petApp.ErrBreedCooldown, response.CodeBreedCooldown},
+	{petApp.ErrIncompatibleGender, response.CodeIncompatibleGender},
+	{petApp.ErrPetNotMature, response.CodePetNotMature},
+	{petApp.ErrPetLevelTooLow, response.CodePetLevelTooLow},
+	{petApp.ErrPetUnhappy, response.CodePetUnhappy},
+	{petApp.ErrPetIsSick, response.CodePetIsSick},
+	{petApp.ErrRosterFull, response.CodeRosterFull},
+	{petApp.ErrTooInbred, response.CodeTooInbred},
+	{petApp.ErrCannotSelfBreed, response.CodeCannotSelfBreed},
+	{petApp.ErrContractSelf, response.CodeBadRequest},
+	{petApp.ErrNotFriends, response.CodeForbidden},
+	{petApp.ErrNotContractParty, response.CodeForbidden},
+	{petApp.ErrIntimacyTooLow, response.CodeIntimacyTooLow},
+	{petApp.ErrContractExpired, response.CodeContractExpired},
+	{petApp.ErrContractNotFound, response.CodeNotFound},
+	{petApp.ErrContractPending, response.CodeConflict},
+	{petApp.ErrContractPetChanged, response.CodeConflict},
+	{petApp.ErrInvalidContractStatus, response.CodeConflict},
+}
+
+// handleBreedError 处理繁殖相关错误
+func (h *PetHandler) handleBreedError(c *gin.Context, err error) {
+	if errors.Is(err, petApp.ErrPetNotFound) {
+		response.SuccessWithMessageAndCode(c, response.CodePetNotFound, "宠物不存在", nil)
+		return
+	}
+	for _, m := range breedErrorCodes {
+		if errors.Is(err, m.err) {
+			response.Error(c, m.code, err.Error())
+			return
+		}
+	}
+	response.Error(c, response.CodeInternalError, err.Error())
+}
+
+// GetAdoptionMarket 领养市场
+// @Summary      领养市场
+// @Description  获取可领养的宠物挂单，包括公开出售的和好友指定赠送给我的
+// @Tags         pet
+// @Accept       json
+// @Produce      json
+// @Security     Bearer
+// @Param        page query int false "页码" default(1)
+// @Param        pageSize query int false "每页数量" default(20)
+// @Success      200 {object} response.Response{data=petApp.AdoptionListResponse} "获取成功"
+// @Failure      400 {object} response.Response "请求参数错误"
+// @Failure      500 {object} response.Response "服务器错误"
+// @Router       /pet/adoptions [get]
+func (h *PetHandler) GetAdoptionMarket(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+
+	var req petApp.AdoptionMarketRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		response.Error(c, response.CodeBadRequest, err.Error())
+		return
+	}
+
+	result, err := h.petService.GetAdoptionMarket(c.Request.Context(), userID, req)
+	if err != nil {
+		response.Error(c, response.CodeInternalError, err.Error())
+		return
+	}
+
+	response.Success(c, result)
+}
+
+// GetMyAdoptions 我发布的挂单
+// @Summary      我发布的挂单
+// @Description  获取我发布的全部领养挂单（含已领养和已撤回的）
+// @Tags         pet
+// @Accept       json
+// @Produce      json
+// @Security     Bearer
+// @Success      200 {object} response.Response{data=petApp.AdoptionListResponse} "获取成功"
+// @Failure      500 {object} response.Response "服务器错误"
+// @Router       /pet/adoptions/mine [get]
+func (h *PetHandler) GetMyAdoptions(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+
+	result, err := h.petService.GetMyAdoptions(c.Request.Context(), userID)
+	if err != nil {
+		response.Error(c, response.CodeInternalError, err.Error())
+		return
+	}
+
+	response.Success(c, result)
+}
+
+// ListForAdoption 挂单领养
+// @Summary      挂单领养
+// @Description  把自己的宠物以金币或钻石标价挂到领养市场；填写 recipientId 则免费赠送给该好友
+// @Tags         pet
+// @Accept       json
+// @Produce      json
+// @Security     Bearer
+// @Param        request body petApp.ListPetForAdoptionRequest true "挂单请求"
+// @Success      200 {object} response.Response{data=petApp.AdoptionListingDTO} "挂单成功"
+// @Failure      400 {object} response.Response "请求参数错误或标价无效"
+// @Failure      403 {object} response.Response "非宠物主人或非好友"
+// @Failure      409 {object} response.Response "宠物已在挂单中"
+// @Failure      500 {object} response.Response "服务器错误"
+// @Router       /pet/adoptions [post]
+func (h *PetHandler) ListForAdoption(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+
+	var req petApp.ListPetForAdoptionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Error(c, response.CodeBadRequest, err.Error())
+		return
+	}
+
+	result, err := h.petService.ListPetForAdoption(c.Request.Context(), userID, req)
+	if err != nil {
+		h.handleAdoptionError(c, err)
+		return
+	}
+
+	response.Success(c, result)
+}
+
+// AdoptPet 领养宠物
+// @Summary      领养宠物
+// @Description  支付标价领养挂单中的宠物，宠物穿戴的装饰退回原主人，原主人的主宠物随之清空
+// @Tags         pet
+// @Accept       json
+// @Produce      json
+// @Security     Bearer
+// @Param        id path int true "挂单ID"
+// @Success      200 {object} response.Response{data=petApp.AdoptPetResponse} "领养成功"
+// @Failure      400 {object} response.Response "请求参数错误或领养自己的宠物"
+// @Failure      403 {object} response.Response "非指定的赠送对象"
+// @Failure      404 {object} response.Response "挂单不存在"
+// @Failure      409 {object} response.Response "挂单状态无效或宠物已易主"
+// @Failure      500 {object} response.Response "金币/钻石不足或服务器错误"
+// @Router       /pet/adoptions/{id}/adopt [post]
+func (h *PetHandler) AdoptPet(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+
+	listingID, err := strconv.Atoi(c.Param("id"))
+	if err != nil || listingID <= 0 {
+		response.Error(c, response.CodeBadRequest, "挂单ID无效")
+		return
+	}
+
+	result, err := h.petService.AdoptPet(c.Request.Context(), userID, listingID)
+	if err != nil {
+		h.handleAdoptionError(c, err)
+		return
+	}
+
+	response.Success(c, result)
+}
+
+// CancelAdoption 撤回领养挂单
+// @Summary      撤回领养挂单
+// @Description  原主人撤回尚未被领养的挂单
+// @Tags         pet
+// @Accept       json
+// @Produce      json
+// @Security     Bearer
+// @Param        id path int true "挂单ID"
+// @Success      200 {object} response.Response{data=petApp.AdoptionListingDTO} "撤回成功"
+// @Failure      400 {object} response.Response "请求参数错误"
+// @Failure      403 {object} response.Response "非挂单主人"
+// @Failure      404 {object} response.Response "挂单不存在"
+// @Failure      409 {object} response.Response "挂单状态无效"
+// @Failure      500 {object} response.Response "服务器错误"
+// @Router       /pet/adoptions/{id}/cancel [post]
+func (h *PetHandler) CancelAdoption(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+
+	listingID, err := strconv.Atoi(c.Param("id"))
+	if err != nil || listingID <= 0 {
+		response.Error(c, response.CodeBadRequest, "挂单ID无效")
+		return
+	}
+
+	result, err := h.petService.CancelAdoption(c.Request.Context(), userID, listingID)
+	if err != nil {
+		h.handleAdoptionError(c, err)
+		return
+	}
+
+	response.Success(c, result)
+}
+
+// GetOwnershipHistory 宠物历任主人
+// @Summary      宠物历任主人
+// @Description  按时间先后列出宠物的每一次易主（出售或赠送），只有现任主人可查看
+// @Tags         pet
+// @Accept       json
+// @Produce      json
+// @Security     Bearer
+// @Param        id path int true "宠物ID"
+// @Success      200 {object} response.Response{data=petApp.OwnershipHistoryResponse} "获取成功"
+// @Failure      400 {object} response.Response "请求参数错误"
+// @Failure      403 {object} response.Response "非宠物主人"
+// @Failure      500 {object} response.Response "服务器错误"
+// @Router       /pet/ownership/{id} [get]
+func (h *PetHandler) GetOwnershipHistory(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+
+	petID, err := strconv.Atoi(c.Param("id"))
+	if err != nil || petID <= 0 {
+		response.Error(c, response.CodeBadRequest, "宠物ID无效")
+		return
+	}
+
+	result, err := h.petService.GetOwnershipHistory(c.Request.Context(), userID, petID)
+	if err != nil {
+		h.handleAdoptionError(c, err)
+		return
+	}
+
+	response.Success(c, result)
+}
+
+// GetMemorials 纪念馆
+// @Summary      纪念馆
+// @Description  获取我已离世宠物的纪念记录，保留生平数据、血统和生平成就
+// @Tags         pet
+// @Accept       json
+// @Produce      json
+// @Security     Bearer
+// @Success      200 {object} response.Response{data=petApp.MemorialListResponse} "获取成功"
+// @Failure      500 {object} response.Response "服务器错误"
+// @Router       /pet/memorials [get]
+func (h *PetHandler) GetMemorials(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+
+	result, err := h.petService.GetMemorials(c.Request.Context(), userID)
+	if err != nil {
+		response.Error(c, response.CodeInternalError, err.Error())
+		return
+	}
+
+	response.Success(c, result)
+}
+
+// adoptionErrorCodes 领养错误到业务响应码的映射
+var adoptionErrorCodes = []struct {
+	err  error
+	code response.CustomCode
+}{
+	{petApp.ErrNotPetOwner, response.CodeForbidden},
+	{petApp.ErrNotFriends, response.CodeForbidden},
+	{petApp.ErrNotAdoptionRecipient, response.CodeForbidden},
+	{petApp.ErrNotAdoptionSeller, response.CodeForbidden},
+	{petApp.ErrAdoptSelf, response.CodeBadRequest},
+	{petApp.ErrInvalidAdoptionPrice, response.CodeBadRequest},
+	{petApp.ErrAdoptionNotFound, response.CodeNotFound},
+	{petApp.ErrPetAlreadyListed, response.CodeConflict},
+	{petApp.ErrAdoptionPetChanged, response.CodeConflict},
+	{petApp.ErrInvalidAdoptionStatus, response.CodeConflict},
+	{petApp.ErrRosterFull, response.CodeRosterFull},
+	{petApp.ErrInsufficientCoins, response.CodeInsufficientCoins},
+	{petApp.ErrInsufficientDiamonds, response.CodeInsufficientDiamonds},
+}
+
+// handleAdoptionError 处理领养相关错误
+func (h *PetHandler) handleAdoptionError(c *gin.Context, err error) {
+	if errors.Is(err, petApp.ErrPetNotFound) {
+		response.SuccessWithMessageAndCode(c, response.CodePetNotFound, "宠物不存在", nil)
+		return
+	}
+	for _, m := range adoptionErrorCodes {
+		if errors.Is(err, m.err) {
+			response.Error(c, m.code, err.Error())
+			return
+		}
+	}
+	response.Error(c, response.CodeInternalError, err.Error())
+}
+
+// ============================================================
+// 宠物栏
+// ============================================================
+
+// GetRoster 我的宠物栏
+// @Summary      我的宠物栏
+// @Description  按排序获取我拥有的全部宠物及其实时状态，以及栏位上限和扩充价格
+// @Tags         pet
+// @Accept       json
+// @Produce      json
+// @Security     Bearer
+// @Success      200 {object} response.Response{data=petApp.RosterResponse} "获取成功"
+// @Failure      500 {object} response.Response "服务器错误"
+// @Router       /pet/roster [get]
+func (h *PetHandler) GetRoster(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+
+	result, err := h.petService.GetRoster(c.Request.Context(), userID)
+	if err != nil {
+		response.Error(c, response.CodeInternalError, err.Error())
+		return
+	}
+
+	response.Success(c, result)
+}
+
+// ReorderRoster 宠物栏排序
+// @Summary      宠物栏排序
+// @Description  按给定的宠物ID顺序重排宠物栏，需包含全部宠物且不能重复
+// @Tags         pet
+// @Accept       json
+// @Produce      json
+// @Security     Bearer
+// @Param        request body petApp.ReorderRosterRequest true "排序请求"
+// @Success      200 {object} response.Response{data=petApp.RosterResponse} "排序成功"
+// @Failure      400 {object} response.Response "请求参数错误或排序不完整"
+// @Failure      500 {object} response.Response "服务器错误"
+// @Router       /pet/roster/order [put]
+func (h *PetHandler) ReorderRoster(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+
+	var req petApp.ReorderRosterRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Error(c, response.CodeBadRequest, err.Error())
+		return
+	}
+
+	result, err := h.petService.ReorderRoster(c.Request.Context(), userID, req)
+	if err != nil {
+		h.handleRosterError(c, err)
+		return
+	}
+
+	response.Success(c, result)
+}
+
+// ExpandRoster 扩充宠物栏
+// @Summary      扩充宠物栏
+// @Description  花费钻石扩充一个宠物栏位，最多扩充到栏位上限
+// @Tags         pet
+// @Accept       json
+// @Produce      json
+// @Security     Bearer
+// @Success      200 {object} response.Response{data=petApp.ExpandRosterResponse} "扩充成功"
+// @Failure      409 {object} response.Response "已扩充到上限"
+// @Failure      500 {object} response.Response "钻石不足或服务器错误"
+// @Router       /pet/roster/expand [post]
+func (h *PetHandler) ExpandRoster(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+
+	result, err := h.petService.ExpandRoster(c.Request.Context(), userID)
+	if err != nil {
+		h.handleRosterError(c, err)
+		return
+	}
+
+	response.Success(c, result)
+}
+
+// RenamePet 宠物改名
+// @Summary      宠物改名
+// @Description  给自己的任意一只宠物改名，名称长度为 1-20 个字符
+// @Tags         pet
+// @Accept       json
+// @Produce      json
+// @Security     Bearer
+// @Param        id path int true "宠物ID"
+// @Param        request body petApp.RenamePetRequest true "改名请求"
+// @Success      200 {object} response.Response{data=petApp.RenamePetResponse} "改名成功"
+// @Failure      400 {object} response.Response "请求参数错误或与原名相同"
+// @Failure      403 {object} response.Response "非宠物主人"
+// @Failure      500 {object} response.Response "服务器错误"
+// @Router       /pet/roster/{id}/name [put]
+func (h *PetHandler) RenamePet(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+
+	petID, err := strconv.Atoi(c.Param("id"))
+	if err != nil || petID <= 0 {
+		response.Error(c, response.CodeBadRequest, "宠物ID无效")
+		return
+	}
+
+	var req petApp.RenamePetRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Error(c, response.CodeBadRequest, err.Error())
+		return
+	}
+
+	result, err := h.petService.RenamePet(c.Request.Context(), userID, petID, req)
+	if err != nil {
+		h.handleRosterError(c, err)
+		return
+	}
+
+	response.Success(c, result)
+}
+
+// ReleasePet 放生宠物
+// @Summary      放生宠物
+// @Description  输入宠物名字确认后放生，宠物转入纪念馆（保留生平与血统），装饰退回背包，挂单和待处理的繁殖契约一并撤回
+// @Tags         pet
+// @Accept       json
+// @Produce      json
+// @Security     Bearer
+// @Param        id path int true "宠物ID"
+// @Param        request body petApp.ReleasePetRequest true "放生请求"
+// @Success      200 {object} response.Response{data=petApp.ReleasePetResponse} "放生成功"
+// @Failure      400 {object} response.Response "请求参数错误或名字不匹配"
+// @Failure      403 {object} response.Response "非宠物主人"
+// @Failure      500 {object} response.Response "服务器错误"
+// @Router       /pet/roster/{id}/release [post]
+func (h *PetHandler) ReleasePet(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+
+	petID, err := strconv.Atoi(c.Param("id"))
+	if err != nil || petID <= 0 {
+		response.Error(c, response.CodeBadRequest, "宠物ID无效")
+		return
+	}
+
+	var req petApp.ReleasePetRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Error(c, response.CodeBadRequest, err.Error())
+		return
+	}
+
+	result, err := h.petService.ReleasePet(c.Request.Context(), userID, petID, req)
+	if err != nil {
+		h.handleRosterError(c, err)
+		return
+	}
+
+	response.Success(c, result)
+}
+
+// rosterErrorCodes 宠物栏错误到业务响应码的映射
+var rosterErrorCodes = []struct {
+	err  error
+	code response.CustomCode
+}{
+	{petApp.ErrNotPetOwner, response.CodeForbidden},
+	{petApp.ErrInvalidPetName, response.CodeBadRequest},
+	{petApp.ErrSamePetName, response.CodeBadRequest},
+	{petApp.ErrReleaseNotConfirmed, response.CodeBadRequest},
+	{petApp.ErrInvalidRosterOrder, response.CodeBadRequest},
+	{petApp.ErrRosterMaxCapacity, response.CodeConflict},
+	{petApp.ErrInsufficientDiamonds, response.CodeInsufficientDiamonds},
+}
+
+// handleRosterError 处理宠物栏相关错误
+func (h *PetHandler) handleRosterError(c *gin.Context, err error) {
+	if errors.Is(err, petApp.ErrPetNotFound) {
+		response.SuccessWithMessageAndCode(c, response.CodePetNotFound, "宠物不存在", nil)
+		return
+	}
+	for _, m := range rosterErrorCodes {
+		if errors.Is(err, m.err) {
+			response.Error(c, m.code, err.Error())
+			return
+		}
+	}
+	response.Error(c, response.CodeInternalError, err.Error())
+}
+
+// ============================================================
+// 血统图谱
+// ============================================================
+
+// GetLineage 血统图谱
+// @Summary      血统图谱
+// @Description  获取宠物向上若干代的祖先和全部后代（含已离开的宠物），每只宠物附带物种、性别和主人；分裂繁殖的后代只有一位亲本
+// @Tags         pet
+// @Accept       json
+// @Produce      json
+// @Security     Bearer
+// @Param        id path int true "宠物ID（在世的或纪念馆中的）"
+// @Param        generations query int false "向上追溯的祖先代数，最多 10" default(3)
+// @Success      200 {object} response.Response{data=petApp.LineageResponse} "获取成功"
+// @Failure      400 {object} response.Response "请求参数错误"
+// @Failure      403 {object} response.Response "非宠物主人"
+// @Failure      500 {object} response.Response "服务器错误"
+// @Router       /pet/lineage/{id} [get]
+func (h *PetHandler) GetLineage(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+
+	petID, generations, ok := parseLineageParams(c)
+	if !ok {
+		return
+	}
+
+	result, err := h.petService.GetLineage(c.Request.Context(), userID, petID, generations)
+	if err != nil {
+		h.handleLineageError(c, err)
+		return
+	}
+
+	response.Success(c, result)
+}
+
+// GetLineageDOT 血统图谱（Graphviz）
+// @Summary      血统图谱（Graphviz）
+// @Description  以 Graphviz DOT 格式返回血统图谱，可直接用 dot -Tsvg 渲染；已离开的宠物为虚线框
+// @Tags         pet
+// @Produce      plain
+// @Security     Bearer
+// @Param        id path int true "宠物ID（在世的或纪念馆中的）"
+// @Param        generations query int false "向上追溯的祖先代数，最多 10" default(3)
+// @Success      200 {string} string "DOT 文本"
+// @Failure      400 {object} response.Response "请求参数错误"
+// @Failure      403 {object} response.Response "非宠物主人"
+// @Failure      500 {object} response.Response "服务器错误"
+// @Router       /pet/lineage/{id}/dot [get]
+func (h *PetHandler) GetLineageDOT(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+
+	petID, generations, ok := parseLineageParams(c)
+	if !ok {
+		return
+	}
+
+	dot, err := h.petService.GetLineageDOT(c.Request.Context(), userID, petID, generations)
+	if err != nil {
+		h.handleLineageError(c, err)
+		return
+	}
+
+	c.Data(http.StatusOK, "text/vnd.graphviz; charset=utf-8", []byte(dot))
+}
+
+// parseLineageParams 解析宠物ID和追溯代数，参数无效时直接响应
+func parseLineageParams(c *gin.Context) (petID, generations int, ok bool) {
+	petID, err := strconv.Atoi(c.Param("id"))
+	if err != nil || petID <= 0 {
+		response.Error(c, response.CodeBadRequest, "宠物ID无效")
+		return 0, 0, false
+	}
+
+	if v := c.Query("generations"); v != "" {
+		generations, err = strconv.Atoi(v)
+		if err != nil || generations <= 0 {
+			response.Error(c, response.CodeBadRequest, "generations 参数无效")
+			return 0, 0, false
+		}
+	}
+	return petID, generations, true
+}
+
+// handleLineageError 处理血统图谱相关错误
+func (h *PetHandler) handleLineageError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, petApp.ErrPetNotFound):
+		response.SuccessWithMessageAndCode(c, response.CodePetNotFound, "宠物不存在", nil)
+	case errors.Is(err, petApp.ErrNotPetOwner):
+		response.Error(c, response.CodeForbidden, err.Error())
+	default:
+		response.Error(c, response.CodeInternalError, err.Error())
+	}
+}
+
+// ============================================================
+// 基因组报告
+// ============================================================
+
+// GetGenome 基因组报告
+// @Summary      基因组报告
+// @Description  把宠物的 40 位基因码拆成通用外观、物种特征、性格、技能/能力、遗传隐藏五个片段，逐位给出原始值、解读（物种特征由物种解释器解读）和在全部宠物中的百分位；遗传隐藏片段需要背包中有基因扫描仪才能查看
+// @Tags         pet
+// @Accept       json
+// @Produce      json
+// @Security     Bearer
+// @Param        id path int true "宠物ID"
+// @Success      200 {object} response.Response{data=petApp.GenomeResponse} "获取成功"
+// @Failure      400 {object} response.Response "请求参数错误"
+// @Failure      403 {object} response.Response "非宠物主人"
+// @Failure      500 {object} response.Response "服务器错误"
+// @Router       /pet/genome/{id} [get]
+func (h *PetHandler) GetGenome(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+
+	petID, err := strconv.Atoi(c.Param("id"))
+	if err != nil || petID <= 0 {
+		response.Error(c, response.CodeBadRequest, "宠物ID无效")
+		return
+	}
+
+	result, err := h.petService.GetGenome(c.Request.Context(), userID, petID)
+	if err != nil {
+		switch {
+		case errors.Is(err, petApp.ErrPetNotFound):
+			response.SuccessWithMessageAndCode(c, response.CodePetNotFound, "宠物不存在", nil)
+		case errors.Is(err, petApp.ErrNotPetOwner):
+			response.Error(c, response.CodeForbidden, err.Error())
+		default:
+			response.Error(c, response.CodeInternalError, err.Error())
+		}
+		return
+	}
+
+	response.Success(c, result)
+}
+
+// ============================================================
+// 照顾好友宠物
+// ============================================================
+
+// FeedFriendPet 喂食好友宠物
+// @Summary      喂食好友宠物
+// @Description  拜访时用自己背包里的食物喂好友的主宠物，增加双方亲密度（好友宠物越忠诚增加越多），每天对同一好友的照顾次数有上限
+// @Tags         pet
+// @Accept       json
+// @Produce      json
+// @Security     Bearer
+// @Param        userId path int true "好友用户ID"
+// @Param        request body petApp.FeedPetRequest true "喂食请求"
+// @Success      200 {object} response.Response{data=petApp.FriendCareResponse} "喂食成功"
+// @Failure      400 {object} response.Response "请求参数错误、非好友或宠物状态不允许"
+// @Failure      500 {object} response.Response "服务器错误"
+// @Router       /pet/friends/{userId}/feed [post]
+func (h *PetHandler) FeedFriendPet(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+
+	friendID, err := strconv.Atoi(c.Param("userId"))
+	if err != nil || friendID <= 0 {
+		response.Error(c, response.CodeBadRequest, "好友ID无效")
+		return
+	}
+
+	var req petApp.FeedPetRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Error(c, response.CodeBadRequest, err.Error())
+		return
+	}
+
+	result, err := h.petService.FeedFriendPet(c.Request.Context(), userID, friendID, req)
+	if err != nil {
+		h.handleFriendCareError(c, err)
+		return
+	}
+
+	response.Success(c, result)
+}
+
+// PlayWithFriendPet 陪好友宠物玩耍
+// @Summary      陪好友宠物玩耍
+// @Description  拜访时陪好友的主宠物玩耍，增加双方亲密度，每天对同一好友的照顾次数有上限
+// @Tags         pet
+// @Accept       json
+// @Produce      json
+// @Security     Bearer
+// @Param        userId path int true "好友用户ID"
+// @Success      200 {object} response.Response{data=petApp.FriendCareResponse} "玩耍成功"
+// @Failure      400 {object} response.Response "请求参数错误、非好友或宠物状态不允许"
+// @Failure      500 {object} response.Response "服务器错误"
+// @Router       /pet/friends/{userId}/play [post]
+func (h *PetHandler) PlayWithFriendPet(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+
+	friendID, err := strconv.Atoi(c.Param("userId"))
+	if err != nil || friendID <= 0 {
+		response.Error(c, response.CodeBadRequest, "好友ID无效")
+		return
+	}
+
+	result, err := h.petService.PlayWithFriendPet(c.Request.Context(), userID, friendID)
+	if err != nil {
+		h.handleFriendCareError(c, err)
+		return
+	}
+
+	response.Success(c, result)
+}
+
+// CleanFriendPet 清洁好友宠物
+// @Summary      清洁好友宠物
+// @Description  拜访时帮好友的主宠物清洁，增加双方亲密度，每天对同一好友的照顾次数有上限
+// @Tags         pet
+// @Accept       json
+// @Produce      json
+// @Security     Bearer
+// @Param        userId path int true "好友用户ID"
+// @Success      200 {object} response.Response{data=petApp.FriendCareResponse} "清洁成功"
+// @Failure      400 {object} response.Response "请求参数错误、非好友或宠物状态不允许"
+// @Failure      500 {object} response.Response "服务器错误"
+// @Router       /pet/friends/{userId}/clean [post]
+func (h *PetHandler) CleanFriendPet(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+
+	friendID, err := strconv.Atoi(c.Param("userId"))
+	if err != nil || friendID <= 0 {
+		response.Error(c, response.CodeBadRequest, "好友ID无效")
+		return
+	}
+
+	result, err := h.petService.CleanFriendPet(c.Request.Context(), userID, friendID)
+	if err != nil {
+		h.handleFriendCareError(c, err)
+		return
+	}
+
+	response.Success(c, result)
+}
+
+// handleFriendCareError 处理照顾好友宠物相关错误
+func (h *PetHandler) handleFriendCareError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, petApp.ErrPetNotFound):
+		response.SuccessWithMessageAndCode(c, response.CodePetNotFound, "好友还没有宠物", nil)
+	case errors.Is(err, petApp.ErrNotFriends):
+		response.Error(c, response.CodeNotFriends, err.Error())
+	case errors.Is(err, petApp.ErrInteractionLimitReached):
+		response.Error(c, response.CodeInteractionLimit, err.Error())
+	default:
+		response.Error(c, response.CodeBadRequest, err.Error())
+	}
+}
@@ -2,7 +2,9 @@
 package handler
 
 import (
+	"errors"
 	"net/http"
+	"strconv"
 
 	"github.com/gin-gonic/gin"
 
@@ -206,86 +208,188 @@ func (h *SocialHandler) SendGift(c *gin.Context) {
 
 // VisitFriend 拜访好友
 // @Summary      拜访好友
-// @Description  拜访好友的宠物，可获得奖励
+// @Description  查看好友主宠物的实时状态；每天首次拜访某位好友时双方获得金币（按宠物社交性加成）并增加亲密度，每位主人每天接待的访客有上限
 // @Tags         social
 // @Accept       json
 // @Produce      json
 // @Security     Bearer
 // @Param        userId path int true "好友用户ID"
-// @Success      200 {object} response.Response "拜访成功"
-// @Failure      400 {object} response.Response "请求参数错误"
+// @Success      200 {object} response.Response{data=social.VisitResponse} "拜访成功"
+// @Failure      400 {object} response.Response "请求参数错误、非好友或拜访自己"
+// @Failure      409 {object} response.Response "对方今天接待的访客已满"
 // @Failure      500 {object} response.Response "服务器错误"
 // @Router       /social/visit/{userId} [get]
 func (h *SocialHandler) VisitFriend(c *gin.Context) {
-	// TODO: 实现拜访好友逻辑
-	response.Success(c, gin.H{"message": "拜访成功"})
+	userID := middleware.GetUserID(c)
+
+	hostID, err := strconv.Atoi(c.Param("userId"))
+	if err != nil || hostID <= 0 {
+		response.Error(c, response.CodeBadRequest, "invalid user id")
+		return
+	}
+
+	result, err := h.socialService.VisitFriend(c.Request.Context(), userID, hostID)
+	if err != nil {
+		h.handleVisitError(c, err)
+		return
+	}
+
+	response.Success(c, result)
+}
+
+// handleVisitError 处理拜访相关错误
+func (h *SocialHandler) handleVisitError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, social.ErrNotFriends):
+		response.Error(c, response.CodeNotFriends, err.Error())
+	case errors.Is(err, social.ErrVisitSelf):
+		response.Error(c, response.CodeBadRequest, err.Error())
+	case errors.Is(err, social.ErrVisitLimitReached):
+		response.Error(c, response.CodeVisitLimitReached, err.Error())
+	case errors.Is(err, social.ErrPetNotFound):
+		response.Error(c, response.CodePetNotFound, err.Error())
+	default:
+		response.Error(c, response.CodeInternalError, err.Error())
+	}
 }
 
 // --- 交易相关 ---
 
 // GetTrades 获取交易列表
 // @Summary      获取交易列表
-// @Description  获取当前用户的交易记录
+// @Description  获取当前用户发起和收到的交易记录，已过期的交易会自动退还托管道具
 // @Tags         social
 // @Accept       json
 // @Produce      json
 // @Security     Bearer
-// @Success      200 {object} response.Response{data=object} "获取成功"
+// @Success      200 {object} response.Response{data=social.TradeListResponse} "获取成功"
 // @Failure      500 {object} response.Response "服务器错误"
 // @Router       /social/trades [get]
 func (h *SocialHandler) GetTrades(c *gin.Context) {
-	// TODO: 实现获取交易列表
-	response.Success(c, gin.H{"trades": []interface{}{}})
+	userID := middleware.GetUserID(c)
+
+	result, err := h.socialService.GetTrades(c.Request.Context(), userID)
+	if err != nil {
+		response.Error(c, response.CodeInternalError, err.Error())
+		return
+	}
+
+	response.Success(c, result)
 }
 
 // CreateTrade 创建交易
 // @Summary      创建交易
-// @Description  创建一个新的道具交易请求
+// @Description  向好友发起以物易物的交易，提供的道具立即从背包扣除并托管，直到交易完成、取消或过期
 // @Tags         social
 // @Accept       json
 // @Produce      json
 // @Security     Bearer
 // @Param        request body social.CreateTradeRequest true "交易请求"
-// @Success      200 {object} response.Response "创建成功"
-// @Failure      400 {object} response.Response "请求参数错误"
+// @Success      200 {object} response.Response{data=social.TradeDTO} "创建成功"
+// @Failure      400 {object} response.Response "请求参数错误、非好友或道具不足"
 // @Failure      500 {object} response.Response "服务器错误"
 // @Router       /social/trades [post]
 func (h *SocialHandler) CreateTrade(c *gin.Context) {
-	// TODO: 实现创建交易
-	response.Success(c, gin.H{"message": "交易已创建"})
+	userID := middleware.GetUserID(c)
+
+	var req social.CreateTradeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Error(c, response.CodeBadRequest, err.Error())
+		return
+	}
+
+	result, err := h.socialService.CreateTrade(c.Request.Context(), userID, req)
+	if err != nil {
+		h.handleTradeError(c, err)
+		return
+	}
+
+	response.Success(c, result)
 }
 
 // AcceptTrade 接受交易
 // @Summary      接受交易
-// @Description  接受指定的交易请求
+// @Description  接收方接受交易，双方道具在同一事务中交换
 // @Tags         social
 // @Accept       json
 // @Produce      json
 // @Security     Bearer
 // @Param        id path int true "交易ID"
-// @Success      200 {object} response.Response "交易完成"
-// @Failure      400 {object} response.Response "请求参数错误"
+// @Success      200 {object} response.Response{data=social.TradeDTO} "交易完成"
+// @Failure      400 {object} response.Response "请求参数错误、交易已过期或道具不足"
+// @Failure      403 {object} response.Response "非交易接收方"
 // @Failure      500 {object} response.Response "服务器错误"
 // @Router       /social/trades/accept/{id} [post]
 func (h *SocialHandler) AcceptTrade(c *gin.Context) {
-	// TODO: 实现接受交易
-	response.Success(c, gin.H{"message": "交易完成"})
+	userID := middleware.GetUserID(c)
+
+	tradeID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		response.Error(c, response.CodeBadRequest, "invalid trade id")
+		return
+	}
+
+	result, err := h.socialService.AcceptTrade(c.Request.Context(), userID, tradeID)
+	if err != nil {
+		h.handleTradeError(c, err)
+		return
+	}
+
+	response.Success(c, result)
 }
 
 // CancelTrade 取消交易
 // @Summary      取消交易
-// @Description  取消指定的交易请求
+// @Description  发起方撤回或接收方拒绝交易，托管道具退还发起方
 // @Tags         social
 // @Accept       json
 // @Produce      json
 // @Security     Bearer
 // @Param        id path int true "交易ID"
-// @Success      200 {object} response.Response "交易已取消"
+// @Success      200 {object} response.Response{data=social.TradeDTO} "交易已取消"
 // @Failure      400 {object} response.Response "请求参数错误"
+// @Failure      403 {object} response.Response "非交易参与方"
 // @Failure      500 {object} response.Response "服务器错误"
 // @Router       /social/trades/cancel/{id} [post]
 func (h *SocialHandler) CancelTrade(c *gin.Context) {
-	// TODO: 实现取消交易
-	response.Success(c, gin.H{"message": "交易已取消"})
+	userID := middleware.GetUserID(c)
+
+	tradeID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		response.Error(c, response.CodeBadRequest, "invalid trade id")
+		return
+	}
+
+	result, err := h.socialService.CancelTrade(c.Request.Context(), userID, tradeID)
+	if err != nil {
+		h.handleTradeError(c, err)
+		return
+	}
+
+	response.Success(c, result)
 }
 
+// handleTradeError 处理交易相关错误
+func (h *SocialHandler) handleTradeError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, social.ErrNotFriends):
+		response.Error(c, response.CodeNotFriends, err.Error())
+	case errors.Is(err, social.ErrNotAllowed):
+		response.Error(c, response.CodeForbidden, err.Error())
+	case errors.Is(err, social.ErrTradeNotFound):
+		response.Error(c, response.CodeTradeNotFound, err.Error())
+	case errors.Is(err, social.ErrTradeExpired):
+		response.Error(c, response.CodeTradeExpired, err.Error())
+	case errors.Is(err, social.ErrInvalidTradeStatus):
+		response.Error(c, response.CodeInvalidTradeStatus, err.Error())
+	case errors.Is(err, social.ErrItemNotFound):
+		response.Error(c, response.CodeItemNotFound, err.Error())
+	case errors.Is(err, social.ErrInsufficientItem):
+		response.Error(c, response.CodeInsufficientItem, err.Error())
+	case errors.Is(err, social.ErrTradeSelf),
+		errors.Is(err, social.ErrInvalidTradeQuantity):
+		response.Error(c, response.CodeBadRequest, err.Error())
+	default:
+		response.Error(c, response.CodeInternalError, err.Error())
+	}
+}
@@ -3,6 +3,7 @@ package config
 
 import (
 	"log"
+	"time"
 
 	"github.com/spf13/viper"
 )
@@ -25,6 +26,8 @@ type Config struct {
 	JWT      JWTConfig      `mapstructure:"jwt"`
 	Wechat   WechatConfig   `mapstructure:"wechat"`
 	Log      LogConfig      `mapstructure:"log"`
+	Cron     CronConfig     `mapstructure:"cron"`
+	Game     GameConfig     `mapstructure:"game"`
 }
 
 // ServerConfig 服务器配置
@@ -77,6 +80,116 @@ type WechatConfig struct {
 	AppSecret string `mapstructure:"app_secret"`
 }
 
+// CronConfig 定时任务配置
+type CronConfig struct {
+	TradeTTLHours         int `mapstructure:"trade_ttl_hours"`          // 交易有效期（小时），默认24
+	TradeSweepIntervalSec int `mapstructure:"trade_sweep_interval_sec"` // 过期交易扫描间隔（秒），默认60
+	TradeSweepBatchSize   int `mapstructure:"trade_sweep_batch_size"`   // 每批处理的交易数，默认100
+
+	StatusScanIntervalSec  int `mapstructure:"status_scan_interval_sec"`  // 宠物状态计算间隔（秒），默认300
+	StatusFlushIntervalSec int `mapstructure:"status_flush_interval_sec"` // 状态缓冲区最长落库间隔（秒），默认30
+	StatusFlushBatchSize   int `mapstructure:"status_flush_batch_size"`   // 缓冲区达到该条数立即落库，也是每批写入条数，默认500
+
+	LifespanSweepIntervalSec int `mapstructure:"lifespan_sweep_interval_sec"` // 寿终宠物扫描间隔（秒），默认300
+	LifespanSweepBatchSize   int `mapstructure:"lifespan_sweep_batch_size"`   // 每批处理的宠物数，默认100
+}
+
+// GameConfig 玩法配置
+type GameConfig struct {
+	Timezone          string           `mapstructure:"timezone"`             // 游戏时区（如 Asia/Shanghai），每日次数与昼夜按此计算，默认服务器本地时区
+	KeepsakeItemID    int              `mapstructure:"keepsake_item_id"`     // 宠物寿终后发给主人的纪念品道具ID，0 表示不发放
+	GeneScannerItemID int              `mapstructure:"gene_scanner_item_id"` // 基因扫描仪道具ID，背包中有它才能查看基因组的隐藏片段，0 表示不开放
+	Roster            RosterConfig     `mapstructure:"roster"`               // 宠物栏
+	Inbreeding        InbreedingConfig `mapstructure:"inbreeding"`           // 近亲繁殖
+}
+
+// RosterConfig 宠物栏配置（未配置的字段使用默认值）
+type RosterConfig struct {
+	BaseCapacity      int `mapstructure:"base_capacity"`       // 初始栏位数，默认3
+	MaxCapacity       int `mapstructure:"max_capacity"`        // 扩充后的最大栏位数，默认10
+	SlotPriceDiamonds int `mapstructure:"slot_price_diamonds"` // 扩充一个栏位的钻石价格，默认50
+}
+
+// InbreedingConfig 近亲繁殖配置（未配置的字段使用默认值）
+type InbreedingConfig struct {
+	WarnThreshold     float64 `mapstructure:"warn_threshold"`     // 提醒玩家的近交系数，默认0.125
+	BlockThreshold    float64 `mapstructure:"block_threshold"`    // 禁止繁殖的近交系数，0 表示不禁止
+	MutationBonus     int     `mapstructure:"mutation_bonus"`     // 近交系数为1时额外的突变率（百分比），默认20
+	ResistancePenalty int     `mapstructure:"resistance_penalty"` // 近交系数为1时抗性基因降低的值，默认16
+}
+
+// Location 游戏时区
+func (c GameConfig) Location() (*time.Location, error) {
+	if c.Timezone == "" {
+		return time.Local, nil
+	}
+	return time.LoadLocation(c.Timezone)
+}
+
+// TradeTTL 交易有效期
+func (c CronConfig) TradeTTL() time.Duration {
+	if c.TradeTTLHours <= 0 {
+		return 24 * time.Hour
+	}
+	return time.Duration(c.TradeTTLHours) * time.Hour
+}
+
+// TradeSweepInterval 过期交易扫描间隔
+func (c CronConfig) TradeSweepInterval() time.Duration {
+	if c.TradeSweepIntervalSec <= 0 {
+		return time.Minute
+	}
+	return time.Duration(c.TradeSweepIntervalSec) * time.Second
+}
+
+// TradeSweepBatch 每批处理的交易数
+func (c CronConfig) TradeSweepBatch() int {
+	if c.TradeSweepBatchSize <= 0 {
+		return 100
+	}
+	return c.TradeSweepBatchSize
+}
+
+// StatusScanInterval 宠物状态计算间隔
+func (c CronConfig) StatusScanInterval() time.Duration {
+	if c.StatusScanIntervalSec <= 0 {
+		return 5 * time.Minute
+	}
+	return time.Duration(c.StatusScanIntervalSec) * time.Second
+}
+
+// StatusFlushInterval 状态缓冲区最长落库间隔
+func (c CronConfig) StatusFlushInterval() time.Duration {
+	if c.StatusFlushIntervalSec <= 0 {
+		return 30 * time.Second
+	}
+	return time.Duration(c.StatusFlushIntervalSec) * time.Second
+}
+
+// StatusFlushBatch 状态缓冲区落库批量
+func (c CronConfig) StatusFlushBatch() int {
+	if c.StatusFlushBatchSize <= 0 {
+		return 500
+	}
+	return c.StatusFlushBatchSize
+}
+
+// LifespanSweepInterval 寿终宠物扫描间隔
+func (c CronConfig) LifespanSweepInterval() time.Duration {
+	if c.LifespanSweepIntervalSec <= 0 {
+		return 5 * time.Minute
+	}
+	return time.Duration(c.LifespanSweepIntervalSec) * time.Second
+}
+
+// LifespanSweepBatch 每批处理的寿终宠物数
+func (c CronConfig) LifespanSweepBatch() int {
+	if c.LifespanSweepBatchSize <= 0 {
+		return 100
+	}
+	return c.LifespanSweepBatchSize
+}
+
 // LogLevel 日志级别
 type LogLevel string
 
@@ -0,0 +1,45 @@
+// Package config 配置管理
+package config
+
+import (
+	"github.com/spf13/viper"
+)
+
+// RandomEventConfig 随机事件配置
+type RandomEventConfig struct {
+	BaseChance int                `mapstructure:"base_chance"` // 每次照顾触发随机事件的基础概率（百分比）
+	Events     []RandomEventEntry `mapstructure:"events"`
+}
+
+// RandomEventEntry 随机事件配置条目
+type RandomEventEntry struct {
+	ID              string   `mapstructure:"id"`
+	Name            string   `mapstructure:"name"`
+	Message         string   `mapstructure:"message"`          // 推送给主人的描述
+	Weight          int      `mapstructure:"weight"`           // 权重
+	Stages          []string `mapstructure:"stages"`           // child, teen, adult, elderly，不填表示不限
+	Species         []int    `mapstructure:"species"`          // 物种ID，不填表示不限
+	CooldownMinutes int      `mapstructure:"cooldown_minutes"` // 同一宠物再次触发的最短间隔（分钟）
+	Effect          string   `mapstructure:"effect"`           // coins, item, status
+	ItemID          int      `mapstructure:"item_id"`          // item 效果的道具ID
+	Status          string   `mapstructure:"status"`           // status 效果的目标状态：hunger, happiness, cleanliness, energy
+	MinAmount       int      `mapstructure:"min_amount"`       // 金币数/道具数/状态变化量下限
+	MaxAmount       int      `mapstructure:"max_amount"`       // 上限，不填时固定为下限
+}
+
+// LoadRandomEvents 加载随机事件配置
+func LoadRandomEvents(configPath string) (*RandomEventConfig, error) {
+	v := viper.New()
+	v.SetConfigFile(configPath)
+
+	if err := v.ReadInConfig(); err != nil {
+		return nil, err
+	}
+
+	var cfg RandomEventConfig
+	if err := v.Unmarshal(&cfg); err != nil {
+		return nil, err
+	}
+
+	return &cfg, nil
+}
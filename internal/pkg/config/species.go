@@ -23,6 +23,11 @@ type SpeciesEntry struct {
 	SpecialParts    []string        `mapstructure:"special_parts"`
 	GenderRule      GenderRuleCfg   `mapstructure:"gender_rule"`
 	BreedRules      BreedRulesCfg   `mapstructure:"breed_rules"`
+	IncubationHours int             `mapstructure:"incubation_hours"` // 孵化时间（小时），0 使用默认值
+	LifespanDays    int             `mapstructure:"lifespan_days"`    // 老年期寿命（天），0 使用默认值
+	Growth          GrowthCfg       `mapstructure:"growth"`           // 成长曲线，未配置的字段使用默认值
+	EvolutionForms  []EvolutionFormCfg `mapstructure:"evolution_forms"` // 成熟期进化形态，按顺序匹配第一个满足条件的形态
+	Genetics        []AlleleTraitCfg   `mapstructure:"genetics"`        // 孟德尔遗传的性状组（可选，不配置时逐位随机遗传）
 }
 
 // GenderRuleCfg 性别规则配置
@@ -43,6 +48,40 @@ type BreedRulesCfg struct {
 	SelfBreedCooldownHours int    `mapstructure:"self_breed_cooldown_hours"`
 }
 
+// GrowthCfg 成长曲线配置
+type GrowthCfg struct {
+	BaseExp      int     `mapstructure:"base_exp"`      // 每级所需经验基数
+	ExpExponent  float64 `mapstructure:"exp_exponent"`  // 经验曲线指数：每级所需经验 = base_exp × 等级^exp_exponent
+	TeenLevel    int     `mapstructure:"teen_level"`    // 进入成长期的等级
+	AdultLevel   int     `mapstructure:"adult_level"`   // 进入成熟期的等级
+	ElderlyLevel int     `mapstructure:"elderly_level"` // 进入老年期的等级
+}
+
+// EvolutionFormCfg 进化形态配置
+// 条件上限为 0 表示不限，不设条件的形态可作为默认形态放在最后
+type EvolutionFormCfg struct {
+	ID             string            `mapstructure:"id"`
+	Name           string            `mapstructure:"name"`
+	MinTendency    int               `mapstructure:"min_tendency"`    // 基因进化倾向下限（0-255）
+	MaxTendency    int               `mapstructure:"max_tendency"`    // 基因进化倾向上限
+	MinHappiness   int               `mapstructure:"min_happiness"`   // 平均快乐度下限
+	MaxHappiness   int               `mapstructure:"max_happiness"`   // 平均快乐度上限
+	MinCleanliness int               `mapstructure:"min_cleanliness"` // 平均清洁度下限
+	MaxCleanliness int               `mapstructure:"max_cleanliness"` // 平均清洁度上限
+	MinFeeds       int               `mapstructure:"min_feeds"`       // 喂食次数下限
+	MinPlays       int               `mapstructure:"min_plays"`       // 玩耍次数下限
+	MinCleans      int               `mapstructure:"min_cleans"`      // 清洁次数下限
+	Features       map[string]string `mapstructure:"features"`        // 部位 → 样式，样式须在物种解释器的样式表中
+}
+
+// AlleleTraitCfg 孟德尔性状组配置
+// 显现位保存表现出来的等位基因，隐性槽位保存另一个，隐性性状可隔代重现
+type AlleleTraitCfg struct {
+	Trait     string `mapstructure:"trait"`     // 性状组：color_primary, color_secondary, eye_shape, eye_color, pattern, special_a-d
+	Slot      string `mapstructure:"slot"`      // 第二个等位基因所在的隐性槽位：a, b, c（基因位 37-39）
+	Recessive []int  `mapstructure:"recessive"` // 隐性等位基因（十六进制值 0-15），其余为显性
+}
+
 // FusionEntry 物种融合配置条目
 type FusionEntry struct {
 	SpeciesA         int `mapstructure:"species_a"`
@@ -13,6 +13,37 @@ const (
 	CodeInternalError      CustomCode = 50000
 	CodeServiceUnavailable CustomCode = 50300
 	CodePetNotFound        CustomCode = 300100
+	CodeBreedCooldown      CustomCode = 300101
+	CodeIncompatibleGender CustomCode = 300102
+	CodePetNotMature       CustomCode = 300103
+	CodePetLevelTooLow     CustomCode = 300104
+	CodePetUnhappy         CustomCode = 300105
+	CodeCannotSelfBreed    CustomCode = 300106
+	CodeIntimacyTooLow     CustomCode = 300107
+	CodeContractExpired    CustomCode = 300108
+	CodeEggNotReady        CustomCode = 300109
+	CodePetNotEgg          CustomCode = 300110
+	CodeWarmCooldown       CustomCode = 300111
+	CodePetIsSick          CustomCode = 300112
+	CodeRosterFull         CustomCode = 300113
+	CodeTooInbred          CustomCode = 300114
+
+	CodeItemNotFound         CustomCode = 300200
+	CodeInsufficientItem     CustomCode = 300201
+	CodeInsufficientCoins    CustomCode = 300202
+	CodeInsufficientDiamonds CustomCode = 300203
+	CodeItemNotForSale       CustomCode = 300204
+	CodeItemNotUsable        CustomCode = 300205
+	CodeItemStageNotAllowed  CustomCode = 300206
+	CodeSlotOccupied         CustomCode = 300207
+	CodeDecorationNotFound   CustomCode = 300208
+
+	CodeNotFriends         CustomCode = 300300
+	CodeTradeNotFound      CustomCode = 300301
+	CodeTradeExpired       CustomCode = 300302
+	CodeInvalidTradeStatus CustomCode = 300303
+	CodeVisitLimitReached  CustomCode = 300304
+	CodeInteractionLimit   CustomCode = 300305
 )
 
 func (c CustomCode) Name() string {
@@ -26,6 +57,37 @@ func (c CustomCode) Name() string {
 		CodeInternalError:      "internal error",
 		CodeServiceUnavailable: "service unavailable",
 		CodePetNotFound:        "pet not found",
+		CodeBreedCooldown:      "breed cooldown",
+		CodeIncompatibleGender: "incompatible gender",
+		CodePetNotMature:       "pet not mature",
+		CodePetLevelTooLow:     "pet level too low",
+		CodePetUnhappy:         "pet unhappy",
+		CodeCannotSelfBreed:    "cannot self breed",
+		CodeIntimacyTooLow:     "intimacy too low",
+		CodeContractExpired:    "contract expired",
+		CodeEggNotReady:        "egg not ready",
+		CodePetNotEgg:          "pet not egg",
+		CodeWarmCooldown:       "warm cooldown",
+		CodePetIsSick:          "pet is sick",
+		CodeRosterFull:         "roster full",
+		CodeTooInbred:          "too inbred",
+
+		CodeItemNotFound:         "item not found",
+		CodeInsufficientItem:     "insufficient item",
+		CodeInsufficientCoins:    "insufficient coins",
+		CodeInsufficientDiamonds: "insufficient diamonds",
+		CodeItemNotForSale:       "item not for sale",
+		CodeItemNotUsable:        "item not usable",
+		CodeItemStageNotAllowed:  "item stage not allowed",
+		CodeSlotOccupied:         "slot occupied",
+		CodeDecorationNotFound:   "decoration not found",
+
+		CodeNotFriends:         "not friends",
+		CodeTradeNotFound:      "trade not found",
+		CodeTradeExpired:       "trade expired",
+		CodeInvalidTradeStatus: "invalid trade status",
+		CodeVisitLimitReached:  "visit limit reached",
+		CodeInteractionLimit:   "interaction limit reached",
 	}
 	if name, ok := names[c]; ok {
 		return name